@@ -63,7 +63,8 @@ type (
 		//
 		TargetOptions any
 
-		// Wait runs an event watcher to wait for target state or global expect reached
+		// Wait follows the action jobs until they end, and prints the output
+		// of the commands they executed
 		Wait bool
 
 		// WaitDuration is the maximum duration allowed for the Wait
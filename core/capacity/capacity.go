@@ -0,0 +1,165 @@
+// Package capacity implements the capacity-aware placement of the
+// instances.
+//
+// Objects declare the memory, cpu and custom countable resources an
+// instance needs to run. Nodes advertise their memory and cpu via their
+// stats, and their custom countable resources via integer-valued labels.
+// The node monitor accounts the requirements of the instances running or
+// starting on its node, so the instance monitors can skip the candidate
+// nodes without enough headroom.
+package capacity
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/opensvc/om3/util/sizeconv"
+	"github.com/opensvc/om3/util/xmap"
+)
+
+type (
+	// T describes an amount of node resources. It is used for the object
+	// requirements, the node capacities and the node allocations.
+	T struct {
+		// Mem is the memory size in bytes.
+		Mem int64 `json:"mem,omitempty"`
+
+		// CPU is the number of cpus.
+		CPU float64 `json:"cpu,omitempty"`
+
+		// Labels is the count of custom countable resources, indexed by
+		// the name of the node label advertising them.
+		Labels map[string]int64 `json:"labels,omitempty"`
+	}
+)
+
+// ParseLabels returns the custom countable resources map from a list of
+// <label>=<count> elements. A <label> element without count requires one
+// unit.
+func ParseLabels(l []string) (map[string]int64, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]int64)
+	for _, s := range l {
+		name, count, ok := strings.Cut(s, "=")
+		if name == "" {
+			return nil, fmt.Errorf("invalid resource request %s: empty label name", s)
+		}
+		if !ok {
+			m[name] += 1
+			continue
+		}
+		i, err := strconv.ParseInt(count, 10, 64)
+		if err != nil || i < 0 {
+			return nil, fmt.Errorf("invalid resource request %s: count must be a positive integer", s)
+		}
+		m[name] += i
+	}
+	return m, nil
+}
+
+// NodeCapacity returns the capacity of a node from its memory size in
+// bytes, its number of cpus and its labels. Only the labels with an
+// integer value are considered custom countable resources.
+func NodeCapacity(mem int64, cpu int, labels map[string]string) T {
+	t := T{
+		Mem: mem,
+		CPU: float64(cpu),
+	}
+	for name, value := range labels {
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		if t.Labels == nil {
+			t.Labels = make(map[string]int64)
+		}
+		t.Labels[name] = i
+	}
+	return t
+}
+
+// IsZero returns true if no resource amount is set.
+func (t T) IsZero() bool {
+	if t.Mem != 0 || t.CPU != 0 {
+		return false
+	}
+	for _, i := range t.Labels {
+		if i != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Add returns the sum of the resource amounts of t and other.
+func (t T) Add(other T) T {
+	result := T{
+		Mem: t.Mem + other.Mem,
+		CPU: t.CPU + other.CPU,
+	}
+	for _, m := range []map[string]int64{t.Labels, other.Labels} {
+		for name, i := range m {
+			if result.Labels == nil {
+				result.Labels = make(map[string]int64)
+			}
+			result.Labels[name] += i
+		}
+	}
+	return result
+}
+
+// Equal returns true if t and other have the same resource amounts.
+func (t T) Equal(other T) bool {
+	if t.Mem != other.Mem || t.CPU != other.CPU || len(t.Labels) != len(other.Labels) {
+		return false
+	}
+	for name, i := range t.Labels {
+		if j, ok := other.Labels[name]; !ok || i != j {
+			return false
+		}
+	}
+	return true
+}
+
+// Shortages returns the list of the resources requested by t that don't
+// fit in the headroom left by <allocated> on a node of capacity <capacity>.
+// The memory and cpu requests are not verified against an unknown, zero,
+// node capacity. The custom countable resources requests are always
+// verified: a node not advertising the resource has no headroom.
+func (t T) Shortages(capacity, allocated T) []string {
+	var l []string
+	if t.Mem > 0 && capacity.Mem > 0 {
+		if free := capacity.Mem - allocated.Mem; t.Mem > free {
+			l = append(l, fmt.Sprintf("mem %s requested, %s free", sizeconv.BSizeCompact(float64(t.Mem)), sizeconv.BSizeCompact(float64(max(free, 0)))))
+		}
+	}
+	if t.CPU > 0 && capacity.CPU > 0 {
+		if free := capacity.CPU - allocated.CPU; t.CPU > free {
+			l = append(l, fmt.Sprintf("cpu %g requested, %g free", t.CPU, max(free, 0)))
+		}
+	}
+	names := xmap.Keys(t.Labels)
+	sort.Strings(names)
+	for _, name := range names {
+		i := t.Labels[name]
+		if i <= 0 {
+			continue
+		}
+		if free := capacity.Labels[name] - allocated.Labels[name]; i > free {
+			l = append(l, fmt.Sprintf("%s %d requested, %d free", name, i, max(free, 0)))
+		}
+	}
+	return l
+}
+
+func (t *T) DeepCopy() *T {
+	data := *t
+	if t.Labels != nil {
+		data.Labels = xmap.Copy(t.Labels)
+	}
+	return &data
+}
@@ -0,0 +1,71 @@
+package capacity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabels(t *testing.T) {
+	m, err := ParseLabels([]string{"gpu=2", "license", "license"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"gpu": 2, "license": 2}, m)
+
+	_, err = ParseLabels([]string{"gpu=two"})
+	assert.Error(t, err)
+
+	_, err = ParseLabels([]string{"=1"})
+	assert.Error(t, err)
+}
+
+func TestShortages(t *testing.T) {
+	capacity := NodeCapacity(16*1024*1024*1024, 8, map[string]string{"gpu": "2", "zone": "a"})
+	assert.Equal(t, map[string]int64{"gpu": 2}, capacity.Labels)
+
+	allocated := T{Mem: 8 * 1024 * 1024 * 1024, CPU: 4}.Add(T{Labels: map[string]int64{"gpu": 1}})
+
+	cases := map[string]struct {
+		request  T
+		expected int
+	}{
+		"fits": {
+			request: T{Mem: 4 * 1024 * 1024 * 1024, CPU: 4, Labels: map[string]int64{"gpu": 1}},
+		},
+		"no mem headroom": {
+			request:  T{Mem: 64 * 1024 * 1024 * 1024},
+			expected: 1,
+		},
+		"no cpu headroom": {
+			request:  T{CPU: 4.5},
+			expected: 1,
+		},
+		"no gpu headroom": {
+			request:  T{Labels: map[string]int64{"gpu": 2}},
+			expected: 1,
+		},
+		"label not advertised": {
+			request:  T{Labels: map[string]int64{"license": 1}},
+			expected: 1,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Len(t, c.request.Shortages(capacity, allocated), c.expected)
+		})
+	}
+
+	t.Run("unknown node mem and cpu", func(t *testing.T) {
+		assert.Empty(t, T{Mem: 1, CPU: 1}.Shortages(T{}, T{}))
+	})
+}
+
+func TestEqual(t *testing.T) {
+	v := T{Mem: 1, Labels: map[string]int64{"gpu": 1}}
+	assert.True(t, v.Equal(*v.DeepCopy()))
+	assert.False(t, v.Equal(T{Mem: 1}))
+	empty := T{}
+	assert.True(t, empty.Equal(*empty.DeepCopy()))
+	assert.True(t, T{}.IsZero())
+	assert.False(t, v.IsZero())
+}
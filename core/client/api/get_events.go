@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -17,16 +18,21 @@ import (
 
 // GetEvents describes the events request options.
 type GetEvents struct {
-	client    api.ClientInterface
-	nodename  string
-	namespace *string
-	selector  *string
-	relatives *bool
-	Limit     *uint64
-	Filters   []string
-	Duration  *time.Duration
+	client      api.ClientInterface
+	nodename    string
+	namespace   *string
+	selector    *string
+	relatives   *bool
+	lastEventID *uint64
+	Limit       *uint64
+	Filters     []string
+	Duration    *time.Duration
 }
 
+// ErrEventsEvicted is returned when the events following the last event id
+// are no longer in the daemon event journal.
+var ErrEventsEvicted = errors.New("events evicted from the daemon journal")
+
 func (t *GetEvents) SetDuration(duration time.Duration) *GetEvents {
 	t.Duration = &duration
 	return t
@@ -42,6 +48,13 @@ func (t *GetEvents) SetFilters(filters []string) *GetEvents {
 	return t
 }
 
+// SetLastEventID sets the id of the last event received, to resume the
+// stream after this event.
+func (t *GetEvents) SetLastEventID(id uint64) *GetEvents {
+	t.lastEventID = &id
+	return t
+}
+
 func (t *GetEvents) SetNamespace(s string) *GetEvents {
 	t.namespace = &s
 	return t
@@ -177,8 +190,9 @@ func marshalMessages(q chan []byte, out chan event.Event) {
 
 func (t GetEvents) eventsBase() (*http.Response, error) {
 	params := api.GetDaemonEventsParams{
-		Filter:   &t.Filters,
-		Selector: t.selector,
+		Filter:      &t.Filters,
+		Selector:    t.selector,
+		LastEventID: t.lastEventID,
 	}
 	if t.Limit != nil {
 		i := int64(*t.Limit)
@@ -195,6 +209,9 @@ func (t GetEvents) eventsBase() (*http.Response, error) {
 	switch resp.StatusCode {
 	case http.StatusOK:
 		return resp, nil
+	case http.StatusGone:
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%w: after event id %d", ErrEventsEvicted, *t.lastEventID)
 	case http.StatusBadRequest:
 	case http.StatusUnauthorized:
 	case http.StatusForbidden:
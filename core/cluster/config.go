@@ -1,5 +1,7 @@
 package cluster
 
+import "time"
+
 type (
 	Nodes []string
 
@@ -16,17 +18,90 @@ type (
 		Quorum     bool           `json:"quorum"`
 		Vip        Vip            `json:"vip"`
 
+		// Roles is the custom rbac roles, indexed by name.
+		Roles map[string]ConfigRole `json:"roles"`
+
+		// Notify is the webhook notifiers, indexed by name. They are not
+		// exposed in daemon data, as the urls may embed credentials.
+		Notify map[string]ConfigNotify `json:"-"`
+
 		// fields private, no exposed in daemon data
 		// json nor events
-		secret string
+		secret     string
+		nextSecret string
+
+		// secretSwitched is true when the local node encrypts with
+		// nextSecret during a cluster secret rotation.
+		secretSwitched bool
 	}
 	ConfigListener struct {
 		CRL             string `json:"crl"`
 		Addr            string `json:"addr"`
 		Port            int    `json:"port"`
 		OpenIDWellKnown string `json:"openid_well_known"`
-		DNSSockGID      string `json:"dns_sock_gid"`
-		DNSSockUID      string `json:"dns_sock_uid"`
+
+		// OpenIDClientID is the audience expected in the openid tokens.
+		// The cluster name is used if empty.
+		OpenIDClientID string `json:"openid_client_id"`
+
+		// OpenIDGrantClaim is the openid token claim holding the user
+		// grants.
+		OpenIDGrantClaim string `json:"openid_grant_claim"`
+
+		DNSSockGID string `json:"dns_sock_gid"`
+		DNSSockUID string `json:"dns_sock_uid"`
+
+		// UxGrants maps the local users and groups connecting to the
+		// unix socket listener to grants, with "user:<name>=<grant>" or
+		// "group:<name>=<grant>" entries.
+		UxGrants []string `json:"ux_grants"`
+	}
+
+	// ConfigRole is a custom rbac role defined in a role#<name> section.
+	ConfigRole struct {
+		// Allow is the allowed api operation patterns.
+		Allow []string `json:"allow"`
+
+		// Namespaces is the namespace patterns of the objects the role
+		// applies to. All namespaces if empty.
+		Namespaces []string `json:"namespaces"`
+
+		// Selector is the path patterns of the objects the role applies
+		// to. All objects if empty.
+		Selector []string `json:"selector"`
+	}
+
+	// ConfigNotify is a webhook notifier defined in a notify#<name> section.
+	ConfigNotify struct {
+		// URL is the http or https url the events are posted to.
+		URL string
+
+		// Events is the event filters, with the "<kind>[,<label>=<value>]*"
+		// format. All events are posted if empty.
+		Events []string
+
+		// Selector is the object selector expression. The events of the
+		// objects not selected are not posted.
+		Selector string
+
+		// Template is the text/template of the posted payload. The json
+		// event is posted if empty.
+		Template string
+
+		// ContentType is the Content-Type header of the posted payload.
+		// It defaults to application/json if Template is empty, and is not
+		// set otherwise.
+		ContentType string
+
+		// Retries is the number of delivery retries after a failure.
+		Retries int
+
+		// RetryDelay is the delay before the first retry. The delay
+		// doubles on each retry.
+		RetryDelay time.Duration
+
+		// Timeout is the timeout of a delivery attempt.
+		Timeout time.Duration
 	}
 
 	// Vip struct describes cluster vip settings
@@ -46,14 +121,62 @@ type (
 	}
 )
 
+// Secret returns the cluster secret used to encrypt the heartbeat and
+// inter-node payloads, and to authenticate the node requests.
+//
+// During a cluster secret rotation, it is the next secret once the local
+// node has switched to it.
 func (t Config) Secret() string {
+	if t.secretSwitched && t.nextSecret != "" {
+		return t.nextSecret
+	}
+	return t.secret
+}
+
+// CommittedSecret returns the cluster secret of the cluster config, ignoring
+// the local node switch to the next secret during a cluster secret rotation.
+func (t Config) CommittedSecret() string {
 	return t.secret
 }
 
+// AltSecrets returns the secrets accepted to decrypt payloads and to
+// authenticate node requests, in addition to Secret(). It is not empty only
+// during a cluster secret rotation.
+func (t Config) AltSecrets() []string {
+	switch {
+	case t.nextSecret == "" || t.nextSecret == t.secret:
+		return nil
+	case t.secretSwitched:
+		return []string{t.secret}
+	default:
+		return []string{t.nextSecret}
+	}
+}
+
+// NextSecret returns the secret the cluster secret rotation in progress
+// rotates to, or "" if no rotation is in progress.
+func (t Config) NextSecret() string {
+	return t.nextSecret
+}
+
+// IsSecretSwitched returns true if the local node encrypts with the next
+// secret of the cluster secret rotation in progress.
+func (t Config) IsSecretSwitched() bool {
+	return t.secretSwitched && t.nextSecret != ""
+}
+
 func (t *Config) SetSecret(s string) {
 	t.secret = s
 }
 
+func (t *Config) SetNextSecret(s string) {
+	t.nextSecret = s
+}
+
+func (t *Config) SetSecretSwitched(v bool) {
+	t.secretSwitched = v
+}
+
 func (t Nodes) Contains(s string) bool {
 	for _, nodename := range t {
 		if nodename == s {
@@ -70,11 +193,49 @@ func (t *Config) DeepCopy() *Config {
 		Nodes:      append(Nodes{}, t.Nodes...),
 		DNS:        append([]string{}, t.DNS...),
 		CASecPaths: append([]string{}, t.CASecPaths...),
-		Listener:   t.Listener,
+		Listener:   *t.Listener.DeepCopy(),
 		Quorum:     t.Quorum,
 		Vip:        *t.Vip.DeepCopy(),
+		Roles:      t.deepCopyRoles(),
+		Notify:     t.deepCopyNotify(),
 		secret:     t.secret,
+
+		nextSecret:     t.nextSecret,
+		secretSwitched: t.secretSwitched,
+	}
+}
+
+func (t *Config) deepCopyRoles() map[string]ConfigRole {
+	if t.Roles == nil {
+		return nil
+	}
+	roles := make(map[string]ConfigRole, len(t.Roles))
+	for name, role := range t.Roles {
+		roles[name] = ConfigRole{
+			Allow:      append([]string{}, role.Allow...),
+			Namespaces: append([]string{}, role.Namespaces...),
+			Selector:   append([]string{}, role.Selector...),
+		}
 	}
+	return roles
+}
+
+func (t *Config) deepCopyNotify() map[string]ConfigNotify {
+	if t.Notify == nil {
+		return nil
+	}
+	notify := make(map[string]ConfigNotify, len(t.Notify))
+	for name, n := range t.Notify {
+		n.Events = append([]string{}, n.Events...)
+		notify[name] = n
+	}
+	return notify
+}
+
+func (t *ConfigListener) DeepCopy() *ConfigListener {
+	newT := *t
+	newT.UxGrants = append([]string{}, t.UxGrants...)
+	return &newT
 }
 
 func (v *Vip) DeepCopy() *Vip {
@@ -3,6 +3,7 @@ package instance
 import (
 	"time"
 
+	"github.com/opensvc/om3/core/capacity"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/placement"
 	"github.com/opensvc/om3/core/priority"
@@ -23,6 +24,8 @@ type (
 		FlexMax          int              `json:"flex_max,omitempty"`
 		FlexMin          int              `json:"flex_min,omitempty"`
 		FlexTarget       int              `json:"flex_target,omitempty"`
+		HardAffinity     []string         `json:"hard_affinity,omitempty"`
+		HardAntiAffinity []string         `json:"hard_anti_affinity,omitempty"`
 		MonitorAction    []MonitorAction  `json:"monitor_action,omitempty"`
 		PreMonitorAction string           `json:"pre_monitor_action,omitempty"`
 		Orchestrate      string           `json:"orchestrate"`
@@ -32,10 +35,30 @@ type (
 		Priority         priority.T       `json:"priority,omitempty"`
 		Resources        ResourceConfigs  `json:"resources"`
 		Scope            []string         `json:"scope"`
+		SoftAffinity     []string         `json:"soft_affinity,omitempty"`
+		SoftAntiAffinity []string         `json:"soft_anti_affinity,omitempty"`
+		Stonith          bool             `json:"stonith,omitempty"`
 		Subsets          SubsetConfigs    `json:"subsets"`
 		Topology         topology.T       `json:"topology"`
 		UpdatedAt        time.Time        `json:"updated_at"`
 
+		// Scale is the number of slices of a scaler object. It is nil if
+		// the object is not a scaler.
+		Scale *int `json:"scale,omitempty"`
+
+		// MaintenanceWindow is the schedule of the object maintenance
+		// windows suspending the HA orchestration.
+		MaintenanceWindow string `json:"maintenance_window,omitempty"`
+
+		// MaintenanceWindowAllow is the list of HA orchestration action
+		// kinds allowed during the object maintenance windows.
+		MaintenanceWindowAllow []string `json:"maintenance_window_allow,omitempty"`
+
+		// Requests is the amount of node resources an instance needs to
+		// run. The HA orchestration does not start an instance on a node
+		// without enough headroom.
+		Requests capacity.T `json:"requests"`
+
 		// Volume specific
 		Pool *string `json:"pool,omitempty"`
 		Size *int64  `json:"size,omitempty"`
@@ -47,6 +70,14 @@ type (
 		IsStandby    bool           `json:"is_standby"`
 		Restart      int            `json:"restart"`
 		RestartDelay *time.Duration `json:"restart_delay"`
+
+		// RestartDelayMax enables the restart delay exponential backoff,
+		// capped to this value.
+		RestartDelayMax *time.Duration `json:"restart_delay_max,omitempty"`
+
+		// RestartWindow is the duration a restarted resource must stay up
+		// before its restart counters are reset.
+		RestartWindow *time.Duration `json:"restart_window,omitempty"`
 	}
 	SubsetConfig struct {
 		Parallel bool `json:"parallel,omitempty"`
@@ -65,8 +96,18 @@ func (m SubsetConfigs) DeepCopy() SubsetConfigs {
 func (cfg Config) DeepCopy() *Config {
 	newCfg := cfg
 	newCfg.Scope = append([]string{}, cfg.Scope...)
+	newCfg.HardAffinity = append([]string{}, cfg.HardAffinity...)
+	newCfg.HardAntiAffinity = append([]string{}, cfg.HardAntiAffinity...)
+	newCfg.SoftAffinity = append([]string{}, cfg.SoftAffinity...)
+	newCfg.SoftAntiAffinity = append([]string{}, cfg.SoftAntiAffinity...)
+	newCfg.MaintenanceWindowAllow = append([]string{}, cfg.MaintenanceWindowAllow...)
 	newCfg.Subsets = cfg.Subsets.DeepCopy()
 	newCfg.Resources = cfg.Resources.DeepCopy()
+	newCfg.Requests = *cfg.Requests.DeepCopy()
+	if cfg.Scale != nil {
+		scale := *cfg.Scale
+		newCfg.Scale = &scale
+	}
 	return &newCfg
 }
 
@@ -117,6 +158,33 @@ func (t Config) Unstructured() map[string]any {
 		"topology":           t.Topology,
 		"updated_at":         t.UpdatedAt,
 	}
+	if len(t.HardAffinity) > 0 {
+		m["hard_affinity"] = t.HardAffinity
+	}
+	if len(t.HardAntiAffinity) > 0 {
+		m["hard_anti_affinity"] = t.HardAntiAffinity
+	}
+	if len(t.SoftAffinity) > 0 {
+		m["soft_affinity"] = t.SoftAffinity
+	}
+	if len(t.SoftAntiAffinity) > 0 {
+		m["soft_anti_affinity"] = t.SoftAntiAffinity
+	}
+	if t.Stonith {
+		m["stonith"] = t.Stonith
+	}
+	if t.Scale != nil {
+		m["scale"] = *t.Scale
+	}
+	if t.MaintenanceWindow != "" {
+		m["maintenance_window"] = t.MaintenanceWindow
+	}
+	if len(t.MaintenanceWindowAllow) > 0 {
+		m["maintenance_window_allow"] = t.MaintenanceWindowAllow
+	}
+	if !t.Requests.IsZero() {
+		m["requests"] = t.Requests
+	}
 	if t.Pool != nil {
 		m["pool"] = t.Pool
 	}
@@ -134,9 +202,38 @@ func (t ResourceConfig) Unstructured() map[string]any {
 		"restart":       t.Restart,
 		"restart_delay": t.RestartDelay,
 	}
+	if t.RestartDelayMax != nil {
+		m["restart_delay_max"] = t.RestartDelayMax
+	}
+	if t.RestartWindow != nil {
+		m["restart_window"] = t.RestartWindow
+	}
 	return m
 }
 
+// NextRestartDelay returns the minimum delay between the last restart and
+// the next restart of a resource already restarted count times since it was
+// last stable.
+//
+// The delay is restart_delay. If restart_delay_max is set, the delay doubles
+// at each restart, up to restart_delay_max.
+func (t ResourceConfig) NextRestartDelay(count int) time.Duration {
+	var delay time.Duration
+	if t.RestartDelay != nil {
+		delay = *t.RestartDelay
+	}
+	if t.RestartDelayMax == nil || delay <= 0 {
+		return delay
+	}
+	for i := 1; i < count; i++ {
+		delay *= 2
+		if delay >= *t.RestartDelayMax {
+			return *t.RestartDelayMax
+		}
+	}
+	return delay
+}
+
 func (t ResourceConfigs) Unstructured() map[string]map[string]any {
 	m := make(map[string]map[string]any)
 	for k, v := range t {
@@ -0,0 +1,49 @@
+package instance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ResourceConfig_NextRestartDelay(t *testing.T) {
+	delay := 10 * time.Second
+	delayMax := time.Minute
+	cases := map[string]struct {
+		cfg      ResourceConfig
+		count    int
+		expected time.Duration
+	}{
+		"no delay": {
+			cfg:      ResourceConfig{},
+			count:    3,
+			expected: 0,
+		},
+		"fixed delay": {
+			cfg:      ResourceConfig{RestartDelay: &delay},
+			count:    3,
+			expected: delay,
+		},
+		"backoff first restart": {
+			cfg:      ResourceConfig{RestartDelay: &delay, RestartDelayMax: &delayMax},
+			count:    1,
+			expected: delay,
+		},
+		"backoff third restart": {
+			cfg:      ResourceConfig{RestartDelay: &delay, RestartDelayMax: &delayMax},
+			count:    3,
+			expected: 40 * time.Second,
+		},
+		"backoff capped": {
+			cfg:      ResourceConfig{RestartDelay: &delay, RestartDelayMax: &delayMax},
+			count:    10,
+			expected: delayMax,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, c.expected, c.cfg.NextRestartDelay(c.count))
+		})
+	}
+}
@@ -1,5 +1,9 @@
 package instance
 
+import (
+	"github.com/opensvc/om3/core/status"
+)
+
 type (
 	Instance struct {
 		Config  *Config  `json:"config"`
@@ -7,3 +11,22 @@ type (
 		Status  *Status  `json:"status"`
 	}
 )
+
+// IsAllocating returns true if the instance resources requests are accounted
+// in its node allocations: the instance is up or warn, or its monitor is
+// preparing or running a start.
+func IsAllocating(instStatus *Status, instMonitor *Monitor) bool {
+	if instStatus != nil {
+		switch instStatus.Avail {
+		case status.Up, status.Warn:
+			return true
+		}
+	}
+	if instMonitor != nil {
+		switch instMonitor.State {
+		case MonitorStateReady, MonitorStateStarting:
+			return true
+		}
+	}
+	return false
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/opensvc/om3/core/maintenance"
 	"github.com/opensvc/om3/core/rawconfig"
 	"github.com/opensvc/om3/core/resource"
 	"github.com/opensvc/om3/core/resourceid"
@@ -54,6 +55,23 @@ type (
 
 		Parents  map[string]status.T `json:"parents,omitempty"`
 		Children map[string]status.T `json:"children,omitempty"`
+
+		// PlacementViolations is the list of hard and soft affinity
+		// constraints not satisfied by the instance node.
+		PlacementViolations []string `json:"placement_violations,omitempty"`
+
+		// StonithPending is the list of lost peer nodes that must be fenced
+		// before the instance is allowed to take over the object.
+		StonithPending []string `json:"stonith_pending,omitempty"`
+
+		// StonithRequests is the stonith request id of each StonithPending
+		// peer. A stonith record fulfilling the request id ends the wait.
+		StonithRequests map[string]uuid.UUID `json:"stonith_requests,omitempty"`
+
+		// MaintenanceWindow is the active object maintenance window. It is
+		// nil when the HA orchestration is not suspended by an object
+		// maintenance window.
+		MaintenanceWindow *maintenance.Window `json:"maintenance_window,omitempty"`
 	}
 
 	ResourceMonitors map[string]ResourceMonitor
@@ -80,6 +98,19 @@ type (
 		Remaining int         `json:"remaining"`
 		LastAt    time.Time   `json:"last_at"`
 		Timer     *time.Timer `json:"-"`
+
+		// Count is the number of restarts since the resource was last
+		// stable. It drives the restart delay backoff.
+		Count int `json:"count"`
+
+		// UpAt is the first time the resource was seen up after its last
+		// restart. The restart counters are reset when the resource is up
+		// since more than the restart window.
+		UpAt time.Time `json:"up_at"`
+
+		// IsFlapping is true when the resource has been restarted more than
+		// once without being stable.
+		IsFlapping bool `json:"is_flapping"`
 	}
 
 	MonitorState        int
@@ -413,6 +444,29 @@ func (rmon *ResourceMonitor) DecRestartRemaining() {
 	}
 }
 
+// IncRestartCount increments the number of restarts since the resource was
+// last stable, and returns true if the resource starts flapping.
+func (rmon *ResourceMonitor) IncRestartCount() bool {
+	rmon.Restart.Count += 1
+	rmon.Restart.UpAt = time.Time{}
+	if rmon.Restart.Count > 1 && !rmon.Restart.IsFlapping {
+		rmon.Restart.IsFlapping = true
+		return true
+	}
+	return false
+}
+
+// ResetRestart resets the restart counters of a stable resource, and
+// returns true if the resource was flapping.
+func (rmon *ResourceMonitor) ResetRestart(remaining int) bool {
+	wasFlapping := rmon.Restart.IsFlapping
+	rmon.Restart.Remaining = remaining
+	rmon.Restart.Count = 0
+	rmon.Restart.UpAt = time.Time{}
+	rmon.Restart.IsFlapping = false
+	return wasFlapping
+}
+
 func (rmon *ResourceMonitor) StopRestartTimer() bool {
 	if rmon.Restart.Timer == nil {
 		return false
@@ -458,6 +512,16 @@ func (mon Monitor) ResourceFlagRestartString(rid resourceid.T, r resource.Status
 func (mon Monitor) DeepCopy() *Monitor {
 	v := mon
 	v.Resources = v.Resources.DeepCopy()
+	if mon.PlacementViolations != nil {
+		v.PlacementViolations = append([]string{}, mon.PlacementViolations...)
+	}
+	if mon.StonithPending != nil {
+		v.StonithPending = append([]string{}, mon.StonithPending...)
+	}
+	if mon.StonithRequests != nil {
+		v.StonithRequests = xmap.Copy(mon.StonithRequests)
+	}
+	v.MaintenanceWindow = mon.MaintenanceWindow.DeepCopy()
 	if mon.GlobalExpectOptions != nil {
 		switch mon.GlobalExpect {
 		case MonitorGlobalExpectPlacedAt:
@@ -484,8 +548,11 @@ func (t ResourceMonitor) Unstructured() map[string]any {
 
 func (t ResourceMonitorRestart) Unstructured() map[string]any {
 	return map[string]any{
-		"remaining": t.Remaining,
-		"last_at":   t.LastAt,
+		"remaining":   t.Remaining,
+		"last_at":     t.LastAt,
+		"count":       t.Count,
+		"up_at":       t.UpAt,
+		"is_flapping": t.IsFlapping,
 	}
 }
 
@@ -516,6 +583,18 @@ func (t Monitor) Unstructured() map[string]any {
 	if len(t.Children) > 0 {
 		m["children"] = t.Children
 	}
+	if len(t.PlacementViolations) > 0 {
+		m["placement_violations"] = t.PlacementViolations
+	}
+	if len(t.StonithPending) > 0 {
+		m["stonith_pending"] = t.StonithPending
+	}
+	if len(t.StonithRequests) > 0 {
+		m["stonith_requests"] = t.StonithRequests
+	}
+	if t.MaintenanceWindow != nil {
+		m["maintenance_window"] = t.MaintenanceWindow
+	}
 	return m
 }
 
@@ -1,6 +1,7 @@
 package instance
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/opensvc/om3/core/colorstatus"
@@ -80,6 +81,9 @@ func (t States) LoadTreeNode(head *tree.Node) {
 		n.AddColumn().AddText(colorstatus.Sprint(r.Status, rawconfig.Colorize))
 		desc := n.AddColumn()
 		desc.AddText(r.Label)
+		if rmon := t.Monitor.Resources.Get(r.ResourceID.Name); rmon != nil && rmon.Restart.IsFlapping {
+			desc.AddText(fmt.Sprintf("flapping: %d restarts without being stable", rmon.Restart.Count)).SetColor(rawconfig.Color.Warning)
+		}
 		for _, entry := range r.Log {
 			t := desc.AddText(entry.String())
 			switch entry.Level {
@@ -92,6 +96,7 @@ func (t States) LoadTreeNode(head *tree.Node) {
 	}
 	t.loadTreeNodeParents(head)
 	t.loadTreeNodeChildren(head)
+	t.loadTreeNodePlacementViolations(head)
 }
 
 func (t States) descString() string {
@@ -156,6 +161,16 @@ func (t States) descString() string {
 		default:
 			l = append(l, t.Monitor.LocalExpect.String())
 		}
+
+		// Takeover blocked by pending stonith
+		if len(t.Monitor.StonithPending) > 0 {
+			l = append(l, rawconfig.Colorize.Warning("wait-stonith"))
+		}
+
+		// HA orchestration suspended by a maintenance window
+		if t.Monitor.MaintenanceWindow != nil {
+			l = append(l, rawconfig.Colorize.Frozen("maintenance-window"))
+		}
 	}
 
 	return strings.Join(l, " ")
@@ -188,3 +203,15 @@ func (t States) loadTreeNodeChildren(head *tree.Node) {
 		pNode.AddColumn().AddText(colorstatus.Sprint(availStatus, rawconfig.Colorize))
 	}
 }
+
+func (t States) loadTreeNodePlacementViolations(head *tree.Node) {
+	if len(t.Monitor.PlacementViolations) == 0 {
+		return
+	}
+	n := head.AddNode()
+	n.AddColumn().AddText("placement violations")
+	for _, violation := range t.Monitor.PlacementViolations {
+		pNode := n.AddNode()
+		pNode.AddColumn().AddText(violation).SetColor(rawconfig.Color.Warning)
+	}
+}
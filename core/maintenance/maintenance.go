@@ -0,0 +1,97 @@
+// Package maintenance implements the orchestration maintenance windows.
+//
+// A maintenance window is declared by a schedule expression, at the object
+// or node level. While a window is active, the daemon suspends the HA
+// orchestration, except for the explicitly allowed action kinds. The HA
+// orchestration resumes automatically at the end of the window.
+package maintenance
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/opensvc/om3/util/schedule"
+)
+
+type (
+	// Window describes an active maintenance window.
+	Window struct {
+		// Schedule is the schedule expression declaring the windows.
+		Schedule string `json:"schedule"`
+
+		// Allow is the list of action kinds the HA orchestration is
+		// still allowed to run during the window.
+		Allow []string `json:"allow,omitempty"`
+
+		// Until is the end of the active window.
+		Until time.Time `json:"until"`
+	}
+)
+
+const (
+	// Restart is the kind of the resource restarts and monitor actions.
+	Restart = "restart"
+
+	// Start is the kind of the HA instance starts, including the failovers.
+	Start = "start"
+
+	// Stop is the kind of the HA stops of the flex instances above target.
+	Stop = "stop"
+)
+
+// Kinds returns the list of action kinds that can be allowed during a
+// maintenance window.
+func Kinds() []string {
+	return []string{Restart, Start, Stop}
+}
+
+// Active returns the maintenance window including <tm>, or nil if the
+// schedule is empty or not including <tm>.
+func Active(s string, allow []string, tm time.Time) (*Window, error) {
+	if s == "" {
+		return nil, nil
+	}
+	until, err := schedule.New(s).Window(tm)
+	switch {
+	case errors.Is(err, schedule.ErrNotAllowed), errors.Is(err, schedule.ErrExcluded):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	return &Window{
+		Schedule: s,
+		Allow:    append([]string{}, allow...),
+		Until:    until,
+	}, nil
+}
+
+// IsAllowed returns true if the HA orchestration is allowed to run the
+// <kind> actions. A nil window allows all kinds.
+func (t *Window) IsAllowed(kind string) bool {
+	if t == nil {
+		return true
+	}
+	return slices.Contains(t.Allow, kind)
+}
+
+// Equal returns true if the windows are both nil, or have the same
+// schedule, allowed kinds and end.
+func (t *Window) Equal(other *Window) bool {
+	switch {
+	case t == nil && other == nil:
+		return true
+	case t == nil || other == nil:
+		return false
+	}
+	return t.Schedule == other.Schedule && t.Until.Equal(other.Until) && slices.Equal(t.Allow, other.Allow)
+}
+
+func (t *Window) DeepCopy() *Window {
+	if t == nil {
+		return nil
+	}
+	data := *t
+	data.Allow = append([]string{}, t.Allow...)
+	return &data
+}
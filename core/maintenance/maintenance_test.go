@@ -0,0 +1,43 @@
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActive(t *testing.T) {
+	tm := time.Date(2015, time.February, 27, 3, 0, 0, 0, time.UTC)
+
+	t.Run("empty schedule", func(t *testing.T) {
+		w, err := Active("", nil, tm)
+		require.NoError(t, err)
+		assert.Nil(t, w)
+		assert.True(t, w.IsAllowed(Start))
+	})
+
+	t.Run("out of window", func(t *testing.T) {
+		w, err := Active("04:00-06:00", nil, tm)
+		require.NoError(t, err)
+		assert.Nil(t, w)
+	})
+
+	t.Run("in window", func(t *testing.T) {
+		w, err := Active("02:00-04:00", []string{Restart}, tm)
+		require.NoError(t, err)
+		require.NotNil(t, w)
+		assert.Equal(t, time.Date(2015, time.February, 27, 4, 0, 0, 0, time.UTC), w.Until)
+		assert.True(t, w.IsAllowed(Restart))
+		assert.False(t, w.IsAllowed(Start))
+		assert.False(t, w.IsAllowed(Stop))
+		assert.True(t, w.Equal(w.DeepCopy()))
+		assert.False(t, w.Equal(nil))
+	})
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		_, err := Active("02:00-04:00 foo", nil, tm)
+		assert.Error(t, err)
+	})
+}
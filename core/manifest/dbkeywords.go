@@ -224,6 +224,22 @@ var (
 		Text:      keywords.NewText(fs, "text/kw/post_provision"),
 	}
 
+	KWRestartDelayMax = keywords.Keyword{
+		Attr:      "RestartDelayMax",
+		Converter: converters.Duration,
+		Option:    "restart_delay_max",
+		Scopable:  true,
+		Text:      keywords.NewText(fs, "text/kw/restart_delay_max"),
+	}
+
+	KWRestartWindow = keywords.Keyword{
+		Attr:      "RestartWindow",
+		Converter: converters.Duration,
+		Option:    "restart_window",
+		Scopable:  true,
+		Text:      keywords.NewText(fs, "text/kw/restart_window"),
+	}
+
 	KWRunRequires = keywords.Keyword{
 		Attr:    "RunRequires",
 		Example: "ip#0 fs#0(down,stdby down)",
@@ -325,6 +341,8 @@ var (
 		KWPreStart,
 		KWRestart,
 		KWRestartDelay,
+		KWRestartDelayMax,
+		KWRestartWindow,
 		KWStartRequires,
 	}
 
@@ -26,8 +26,8 @@ var (
 		"objects":     sectionObjects,
 		"services":    sectionObjects,
 	}
-	green, yellow, hired, red, blue, hiblue, hiblack, bold                                                                                                                                                                 func(a ...interface{}) string
-	iconUp, iconWarning, iconDownIssue, iconPlacementAlert, iconProvisionAlert, iconStandbyDown, iconStandbyUpIssue, iconUndef, iconFrozen, iconDown, iconDRP, iconLeader, iconNotApplicable, iconPreserved, iconStandbyUp string
+	green, yellow, hired, red, blue, hiblue, hiblack, bold                                                                                                                                                                                  func(a ...interface{}) string
+	iconUp, iconWarning, iconDownIssue, iconPlacementAlert, iconProvisionAlert, iconStandbyDown, iconStandbyUpIssue, iconUndef, iconFrozen, iconMaintenance, iconDown, iconDRP, iconLeader, iconNotApplicable, iconPreserved, iconStandbyUp string
 )
 
 func InitColor() {
@@ -49,6 +49,7 @@ func InitColor() {
 	iconStandbyUpIssue = hired("o")
 	iconUndef = hired("?")
 	iconFrozen = bold(hiblue("*"))
+	iconMaintenance = bold(hiblue("M"))
 	iconDown = hiblack("X")
 	iconDRP = hiblack("#")
 	iconLeader = hiblack("^")
@@ -31,6 +31,7 @@ func (f Frame) StrObjectInstance(path string, node string, scope []string) strin
 		s += sObjectInstanceDRP(instanceConfig)
 		s += sObjectInstanceHALeader(instanceMonitor)
 		s += sObjectInstanceFrozen(instanceStatus)
+		s += sObjectInstanceMaintenance(instanceMonitor)
 		s += sObjectInstanceUnprovisioned(instanceStatus)
 		s += sObjectInstanceMonitorState(instanceMonitor)
 		s += sObjectInstanceMonitorGlobalExpect(instanceMonitor)
@@ -97,6 +98,13 @@ func sObjectInstanceFrozen(instance instance.Status) string {
 	return ""
 }
 
+func sObjectInstanceMaintenance(instanceMonitor instance.Monitor) string {
+	if instanceMonitor.MaintenanceWindow != nil {
+		return iconMaintenance
+	}
+	return ""
+}
+
 func sObjectInstanceUnprovisioned(instance instance.Status) string {
 	switch instance.Provisioned {
 	case provisioned.False:
@@ -44,6 +44,7 @@ func (f Frame) sNodeSwapLine() string {
 func (f Frame) StrNodeStates(n string) string {
 	s := f.sNodeMonState(n)
 	s += f.sNodeFrozen(n)
+	s += f.sNodeMaintenance(n)
 	s += f.sNodeMonTarget(n)
 	return s
 }
@@ -164,6 +165,15 @@ func (f Frame) sNodeFrozen(n string) string {
 	return ""
 }
 
+func (f Frame) sNodeMaintenance(n string) string {
+	if val, ok := f.Current.Cluster.Node[n]; ok {
+		if val.Monitor.MaintenanceWindow != nil {
+			return iconMaintenance
+		}
+	}
+	return ""
+}
+
 func (f Frame) sNodeMonTarget(n string) string {
 	if val, ok := f.Current.Cluster.Node[n]; ok {
 		s := ""
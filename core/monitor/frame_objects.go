@@ -2,9 +2,9 @@ package monitor
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/opensvc/om3/core/colorstatus"
+	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/core/placement"
 	"github.com/opensvc/om3/core/rawconfig"
@@ -41,13 +41,20 @@ func sObjectWarning(d object.Status) string {
 
 func (f Frame) scalerInstancesUp(path string) int {
 	var actual int
+	scalerPath, err := naming.ParsePath(path)
+	if err != nil {
+		return 0
+	}
 	for _, node := range f.Current.Cluster.Node {
 		for p, inst := range node.Instance {
 			if inst.Status == nil {
 				continue
 			}
-			l := strings.SplitN(p, ".", 2)
-			if len(l) == 2 && l[1] == path && inst.Status.Avail == status.Up {
+			slicePath, err := naming.ParsePath(p)
+			if err != nil {
+				continue
+			}
+			if slicePath.IsScalerSliceOf(scalerPath) && inst.Status.Avail == status.Up {
 				actual++
 			}
 		}
@@ -71,6 +78,15 @@ func (f Frame) StrObjectRunning(path string) string {
 		avail = s.Avail
 	}
 
+	if s.Scale != nil {
+		actual = f.scalerInstancesUp(path)
+		expected = *s.Scale
+		if actual == 0 && expected == 0 {
+			return ""
+		}
+		return fmt.Sprintf("%d/%d", actual, expected)
+	}
+
 	for _, node := range f.Current.Cluster.Node {
 		if inst, ok := node.Instance[path]; ok {
 			if inst.Status == nil {
@@ -82,8 +98,6 @@ func (f Frame) StrObjectRunning(path string) string {
 			}
 			if expected == 0 {
 				switch {
-				//case !instanceStatus.Scale.IsZero():
-				//	expected = int(instanceStatus.Scale.ValueOrZero())
 				case s.Topology == topology.Flex:
 					expected = s.FlexTarget
 				case s.Topology == topology.Failover:
@@ -2,6 +2,7 @@ package monitor
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/opensvc/om3/daemon/daemonsubsystem"
 )
@@ -108,6 +109,10 @@ func (f Frame) wThreadHeartbeats() string {
 		default:
 			s += hired("unknown") + StrThreadAlerts(hbStatus.Alerts)
 		}
+		if len(hbStatus.Faults) > 0 {
+			// fault injections are active
+			s += " " + yellow("fault")
+		}
 		s += "\t" + hbStatus.Type + "\t"
 		s += f.info.separator + "\t"
 		for _, peer := range f.Current.Cluster.Config.Nodes {
@@ -131,12 +136,26 @@ func (f Frame) StrNodeHbStatus(hbStatus daemonsubsystem.HeartbeatStream, peer st
 	}
 	if peerData.IsBeating {
 		s += iconUp
+		if peerData.AvgDelay > 0 {
+			s += " " + strHbDelay(peerData.AvgDelay)
+		}
 	} else {
 		s += iconDownIssue
 	}
 	return s
 }
 
+// strHbDelay returns the short string representation of a heartbeat delay,
+// rounded to the millisecond when above one millisecond.
+func strHbDelay(d time.Duration) string {
+	if d >= time.Millisecond {
+		d = d.Round(time.Millisecond)
+	} else {
+		d = d.Round(time.Microsecond)
+	}
+	return d.String()
+}
+
 func StrThreadAlerts(data []daemonsubsystem.Alert) string {
 	if len(data) > 0 {
 		return yellow("!")
@@ -49,6 +49,7 @@ Instance Flags:
   !       Warning
   P       Unprovisioned
   *       Frozen
+  M       HA orchestration suspended by a maintenance window
   ^       Placement leader
   #       DRP instance
 `
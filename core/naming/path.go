@@ -131,6 +131,25 @@ func (t Path) ScalerSliceIndex() int {
 	}
 }
 
+// ScalerSlice returns the path of the <i> slice of the scaler object, named
+// <i>.<scalerName>
+func (t Path) ScalerSlice(i int) Path {
+	return Path{
+		Namespace: t.Namespace,
+		Kind:      t.Kind,
+		Name:      fmt.Sprintf("%d.%s", i, t.Name),
+	}
+}
+
+// IsScalerSliceOf returns true if the path is a slice of the scaler object
+// path p.
+func (t Path) IsScalerSliceOf(p Path) bool {
+	if t.Namespace != p.Namespace || t.Kind != p.Kind || t.ScalerSliceIndex() < 0 {
+		return false
+	}
+	return strings.SplitN(t.Name, ".", 2)[1] == p.Name
+}
+
 func (t Path) FQN() string {
 	var s string
 	if t.Kind == KindInvalid {
@@ -235,6 +254,21 @@ func ParsePath(s string) (Path, error) {
 	return NewPathFromStrings(namespace, kind, name)
 }
 
+// ParsePathRel returns a new path struct from a path string representation
+// relative to the namespace: a path string without namespace designates an
+// object of the <namespace> namespace instead of the root namespace.
+func ParsePathRel(s, namespace string) (Path, error) {
+	p, err := ParsePath(s)
+	if err != nil || namespace == "" || p.Kind == KindCcfg {
+		return p, err
+	}
+	if l := strings.Split(s, Separator); len(l) == 3 || (len(l) == 2 && l[1] == "") {
+		// the path string has a namespace
+		return p, nil
+	}
+	return NewPathFromStrings(namespace, p.Kind.String(), p.Name)
+}
+
 // MarshalText implements the json interface
 func (t Path) MarshalText() ([]byte, error) {
 	return []byte(t.String()), nil
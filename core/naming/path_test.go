@@ -228,6 +228,74 @@ func TestParsePath(t *testing.T) {
 
 }
 
+func TestParsePathRel(t *testing.T) {
+	tests := map[string]struct {
+		input     string
+		namespace string
+		expected  string
+		ok        bool
+	}{
+		"name in namespace": {
+			input:     "svc1",
+			namespace: "ns1",
+			expected:  "ns1/svc/svc1",
+			ok:        true,
+		},
+		"kind and name in namespace": {
+			input:     "vol/vol1",
+			namespace: "ns1",
+			expected:  "ns1/vol/vol1",
+			ok:        true,
+		},
+		"explicit namespace": {
+			input:     "ns2/svc/svc1",
+			namespace: "ns1",
+			expected:  "ns2/svc/svc1",
+			ok:        true,
+		},
+		"explicit root namespace": {
+			input:     "root/svc/svc1",
+			namespace: "ns1",
+			expected:  "svc1",
+			ok:        true,
+		},
+		"name in root namespace": {
+			input:     "svc1",
+			namespace: "root",
+			expected:  "svc1",
+			ok:        true,
+		},
+		"name without namespace": {
+			input:     "svc1",
+			namespace: "",
+			expected:  "svc1",
+			ok:        true,
+		},
+		"cluster": {
+			input:     "cluster",
+			namespace: "ns1",
+			expected:  "cluster",
+			ok:        true,
+		},
+		"invalid": {
+			input:     "ns1/foo/name",
+			namespace: "ns1",
+			ok:        false,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := ParsePathRel(test.input, test.namespace)
+			if !test.ok {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, p.String())
+		})
+	}
+}
+
 func TestPathMarshalJSON(t *testing.T) {
 	path, _ := ParsePath("ns1/svc/svc1")
 	b, err := json.Marshal(path)
@@ -491,3 +559,16 @@ func TestPathEqual(t *testing.T) {
 	assert.False(t, p.Equal(Path{Name: "foo", Namespace: "ns1", Kind: KindCfg}))
 	assert.False(t, p.Equal(Path{Name: "bar", Namespace: "ns1", Kind: KindSvc}))
 }
+
+func TestPathScalerSlice(t *testing.T) {
+	p := Path{Name: "foo", Namespace: "ns1", Kind: KindSvc}
+
+	slice := p.ScalerSlice(2)
+	assert.Equal(t, "ns1/svc/2.foo", slice.String())
+	assert.Equal(t, 2, slice.ScalerSliceIndex())
+
+	assert.True(t, slice.IsScalerSliceOf(p))
+	assert.False(t, p.IsScalerSliceOf(p))
+	assert.False(t, slice.IsScalerSliceOf(Path{Name: "foo", Namespace: "ns2", Kind: KindSvc}))
+	assert.False(t, Path{Name: "2.foobar", Namespace: "ns1", Kind: KindSvc}.IsScalerSliceOf(p))
+}
@@ -1,11 +1,16 @@
 package node
 
-import "time"
+import (
+	"slices"
+	"time"
+)
 
 type (
 	Config struct {
 		Env                    string        `json:"env"`
 		MaintenanceGracePeriod time.Duration `json:"maintenance_grace_period"`
+		MaintenanceWindow      string        `json:"maintenance_window,omitempty"`
+		MaintenanceWindowAllow []string      `json:"maintenance_window_allow,omitempty"`
 		MaxParallel            int           `json:"max_parallel"`
 		ReadyPeriod            time.Duration `json:"ready_period"`
 		RejoinGracePeriod      time.Duration `json:"rejoin_grace_period"`
@@ -15,6 +20,7 @@ type (
 
 func (t *Config) DeepCopy() *Config {
 	var data Config = *t
+	data.MaintenanceWindowAllow = slices.Clone(t.MaintenanceWindowAllow)
 	return &data
 
 }
@@ -23,6 +29,8 @@ func (t *Config) Unstructured() map[string]any {
 	return map[string]any{
 		"env":                      t.Env,
 		"maintenance_grace_period": t.MaintenanceGracePeriod,
+		"maintenance_window":       t.MaintenanceWindow,
+		"maintenance_window_allow": t.MaintenanceWindowAllow,
 		"max_parallel":             t.MaxParallel,
 		"ready_period":             t.ReadyPeriod,
 		"rejoin_grace_period":      t.RejoinGracePeriod,
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/maintenance"
 )
 
 type (
@@ -26,6 +28,39 @@ type (
 		SessionID           uuid.UUID `json:"session_id"`
 
 		IsPreserved bool `json:"preserved"`
+
+		// RollingRestart describes the last cluster rolling restart
+		// requested via the "restarted" global expect.
+		RollingRestart RollingRestart `json:"rolling_restart"`
+
+		// RollingRestartDoneID is the id of the last rolling restart the
+		// node daemon was restarted by.
+		RollingRestartDoneID uuid.UUID `json:"rolling_restart_done_id"`
+
+		// RollingRestartGiveBack is true when the node restarted by the
+		// rolling restart RollingRestartDoneID is still to be unfrozen.
+		RollingRestartGiveBack bool `json:"rolling_restart_give_back"`
+
+		// MaintenanceWindow is the active node maintenance window. It is
+		// nil when the HA orchestration is not suspended by a node
+		// maintenance window.
+		MaintenanceWindow *maintenance.Window `json:"maintenance_window,omitempty"`
+	}
+
+	// RollingRestart describes a cluster rolling restart: the nodes are
+	// drained, restarted and given their objects back one at a time.
+	RollingRestart struct {
+		// Binary is the path of the om binary to install on each node
+		// before its daemon restart. The node daemons are restarted with
+		// their current binary if empty.
+		Binary string `json:"binary,omitempty"`
+
+		// ID identifies the rolling restart. It is preserved on resume, so
+		// the nodes reporting this id as done are skipped.
+		ID uuid.UUID `json:"id"`
+
+		// Since is the rolling restart request time.
+		Since time.Time `json:"since"`
 	}
 
 	// MonitorUpdate is embedded in the SetNodeMonitor message to
@@ -36,6 +71,10 @@ type (
 		LocalExpect  *MonitorLocalExpect  `json:"local_expect"`
 		GlobalExpect *MonitorGlobalExpect `json:"global_expect"`
 
+		// RollingRestart is the rolling restart description, used with
+		// the "restarted" global expect.
+		RollingRestart *RollingRestart `json:"rolling_restart,omitempty"`
+
 		// CandidateOrchestrationID is a candidate orchestration id for a new imon orchestration.
 		CandidateOrchestrationID uuid.UUID `json:"orchestration_id"`
 	}
@@ -69,6 +108,10 @@ const (
 	MonitorStateMaintenance
 	MonitorStateUpgrade
 	MonitorStateRejoin
+
+	// MonitorStateUpgradeFailed is the node monitor state when the binary
+	// install or the daemon restart of a rolling restart failed
+	MonitorStateUpgradeFailed
 )
 
 const (
@@ -83,6 +126,10 @@ const (
 	MonitorGlobalExpectFrozen
 	MonitorGlobalExpectNone
 	MonitorGlobalExpectThawed
+
+	// MonitorGlobalExpectRestarted is the global expect of a cluster rolling
+	// restart
+	MonitorGlobalExpectRestarted
 )
 
 var (
@@ -102,6 +149,7 @@ var (
 		MonitorStateMaintenance:    "maintenance",
 		MonitorStateInit:           "init",
 		MonitorStateUpgrade:        "upgrade",
+		MonitorStateUpgradeFailed:  "upgrade failed",
 		MonitorStateRejoin:         "rejoin",
 	}
 
@@ -121,6 +169,7 @@ var (
 		"maintenance":     MonitorStateMaintenance,
 		"init":            MonitorStateInit,
 		"upgrade":         MonitorStateUpgrade,
+		"upgrade failed":  MonitorStateUpgradeFailed,
 		"rejoin":          MonitorStateRejoin,
 	}
 
@@ -137,19 +186,21 @@ var (
 	}
 
 	MonitorGlobalExpectStrings = map[MonitorGlobalExpect]string{
-		MonitorGlobalExpectAborted: "aborted",
-		MonitorGlobalExpectFrozen:  "frozen",
-		MonitorGlobalExpectNone:    "none",
-		MonitorGlobalExpectThawed:  "thawed",
-		MonitorGlobalExpectInit:    "init",
+		MonitorGlobalExpectAborted:   "aborted",
+		MonitorGlobalExpectFrozen:    "frozen",
+		MonitorGlobalExpectNone:      "none",
+		MonitorGlobalExpectRestarted: "restarted",
+		MonitorGlobalExpectThawed:    "thawed",
+		MonitorGlobalExpectInit:      "init",
 	}
 
 	MonitorGlobalExpectValues = map[string]MonitorGlobalExpect{
-		"aborted": MonitorGlobalExpectAborted,
-		"frozen":  MonitorGlobalExpectFrozen,
-		"none":    MonitorGlobalExpectNone,
-		"thawed":  MonitorGlobalExpectThawed,
-		"init":    MonitorGlobalExpectInit,
+		"aborted":   MonitorGlobalExpectAborted,
+		"frozen":    MonitorGlobalExpectFrozen,
+		"none":      MonitorGlobalExpectNone,
+		"restarted": MonitorGlobalExpectRestarted,
+		"thawed":    MonitorGlobalExpectThawed,
+		"init":      MonitorGlobalExpectInit,
 	}
 
 	// MonitorStateUnrankable is the node monitor states evicting a node from ranking algorithms
@@ -183,6 +234,7 @@ func (t MonitorState) IsRankable() bool {
 func (n *Monitor) DeepCopy() *Monitor {
 	var d Monitor
 	d = *n
+	d.MaintenanceWindow = n.MaintenanceWindow.DeepCopy()
 	return &d
 }
 
@@ -253,18 +305,25 @@ func (t *MonitorGlobalExpect) UnmarshalText(b []byte) error {
 }
 
 func (t *Monitor) Unstructured() map[string]any {
-	return map[string]any{
-		"global_expect":            t.GlobalExpect,
-		"local_expect":             t.LocalExpect,
-		"state":                    t.State,
-		"global_expect_updated_at": t.GlobalExpectUpdatedAt,
-		"local_expect_updated_at":  t.LocalExpectUpdatedAt,
-		"state_updated_at":         t.StateUpdatedAt,
-		"updated_at":               t.UpdatedAt,
-		"orchestration_id":         t.OrchestrationID,
-		"orchestration_is_done":    t.OrchestrationIsDone,
-		"session_id":               t.SessionID,
+	m := map[string]any{
+		"global_expect":             t.GlobalExpect,
+		"local_expect":              t.LocalExpect,
+		"state":                     t.State,
+		"global_expect_updated_at":  t.GlobalExpectUpdatedAt,
+		"local_expect_updated_at":   t.LocalExpectUpdatedAt,
+		"state_updated_at":          t.StateUpdatedAt,
+		"updated_at":                t.UpdatedAt,
+		"orchestration_id":          t.OrchestrationID,
+		"orchestration_is_done":     t.OrchestrationIsDone,
+		"session_id":                t.SessionID,
+		"rolling_restart":           t.RollingRestart,
+		"rolling_restart_done_id":   t.RollingRestartDoneID,
+		"rolling_restart_give_back": t.RollingRestartGiveBack,
+	}
+	if t.MaintenanceWindow != nil {
+		m["maintenance_window"] = t.MaintenanceWindow
 	}
+	return m
 }
 
 func (t MonitorUpdate) String() string {
@@ -278,5 +337,8 @@ func (t MonitorUpdate) String() string {
 	if t.GlobalExpect != nil {
 		s += fmt.Sprintf(" GlobalExpect=%s", t.GlobalExpect)
 	}
+	if t.RollingRestart != nil {
+		s += fmt.Sprintf(" RollingRestart=%+v", *t.RollingRestart)
+	}
 	return fmt.Sprintf("node.MonitorUpdate{%s}", s)
 }
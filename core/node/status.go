@@ -1,8 +1,12 @@
 package node
 
 import (
+	"slices"
 	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/capacity"
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/status"
 	"github.com/opensvc/om3/daemon/daemonsubsystem"
@@ -21,6 +25,14 @@ type (
 		MinAvailSwapPct uint64                      `json:"min_avail_swap"`
 		IsLeader        bool                        `json:"is_leader"`
 		Labels          Labels                      `json:"labels"`
+
+		// Stonith is the history of the stonith commands executed by the
+		// node while it was the cluster speaker, the most recent last.
+		Stonith []StonithRecord `json:"stonith,omitempty"`
+
+		// Allocated is the sum of the resources requests of the instances
+		// running or starting on the node.
+		Allocated capacity.T `json:"allocated"`
 	}
 
 	// Instances groups instances configuration digest and status
@@ -30,11 +42,28 @@ type (
 		Monitor map[string]instance.Monitor `json:"monitor"`
 	}
 
-	// ArbitratorStatus describes the internet name of an arbitrator and
-	// if it is join-able.
+	// ArbitratorStatus describes the internet name or device path of an
+	// arbitrator and if it is join-able.
 	ArbitratorStatus struct {
 		URL    string   `json:"url"`
 		Status status.T `json:"status"`
+
+		// Holder is the node holding the claim of a disk arbitrator.
+		Holder string `json:"holder,omitempty"`
+	}
+
+	// StonithRecord describes a stonith command execution against a lost
+	// peer node.
+	StonithRecord struct {
+		Peer      string    `json:"peer"`
+		StartedAt time.Time `json:"started_at"`
+		EndedAt   time.Time `json:"ended_at"`
+		Success   bool      `json:"success"`
+		Error     string    `json:"error,omitempty"`
+
+		// Requests is the list of stonith request ids fulfilled by a
+		// successful stonith.
+		Requests []uuid.UUID `json:"requests,omitempty"`
 	}
 
 	// NodesInfo is the dataset exposed via the GET /nodes_info handler,
@@ -74,6 +103,14 @@ func (t *Status) DeepCopy() *Status {
 	}
 	result.Gen = newGen
 	result.Labels = t.Labels.DeepCopy()
+	result.Allocated = *t.Allocated.DeepCopy()
+	if t.Stonith != nil {
+		result.Stonith = make([]StonithRecord, len(t.Stonith))
+		for i, record := range t.Stonith {
+			record.Requests = slices.Clone(record.Requests)
+			result.Stonith[i] = record
+		}
+	}
 
 	return &result
 }
@@ -99,8 +136,19 @@ func (t NodesInfo) Keys() []string {
 	return l
 }
 
+// IsStonithDone returns true if the node has successfully fenced the peer
+// for the stonith request id.
+func (t Status) IsStonithDone(peer string, id uuid.UUID) bool {
+	for _, record := range t.Stonith {
+		if record.Peer == peer && record.Success && slices.Contains(record.Requests, id) {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *Status) Unstructured() map[string]any {
-	return map[string]any{
+	m := map[string]any{
 		"agent":          t.Agent,
 		"api":            t.API,
 		"arbitrators":    t.Arbitrators,
@@ -112,4 +160,11 @@ func (t *Status) Unstructured() map[string]any {
 		"is_leader":      t.IsLeader,
 		"labels":         t.Labels,
 	}
+	if len(t.Stonith) > 0 {
+		m["stonith"] = t.Stonith
+	}
+	if !t.Allocated.IsZero() {
+		m["allocated"] = t.Allocated
+	}
+	return m
 }
@@ -275,6 +275,8 @@ func (t T) DoAsync() error {
 			expectation = node.MonitorGlobalExpectFrozen
 		case node.MonitorGlobalExpectThawed.String():
 			expectation = node.MonitorGlobalExpectThawed
+		case node.MonitorGlobalExpectRestarted.String():
+			expectation = node.MonitorGlobalExpectRestarted
 		default:
 			return fmt.Errorf("unexpected target: %s", t.Target)
 		}
@@ -4,11 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/keywords"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/xconfig"
+	"github.com/opensvc/om3/daemon/rbac"
 	"github.com/opensvc/om3/util/funcopt"
 	"github.com/opensvc/om3/util/key"
 )
@@ -98,18 +100,22 @@ func getClusterConfig() (*cluster.Config, error) {
 	var (
 		keyID         = key.New("cluster", "id")
 		keySecret     = key.New("cluster", "secret")
+		keyNextSecret = key.New("cluster", "next_secret")
 		keyName       = key.New("cluster", "name")
 		keyNodes      = key.New("cluster", "nodes")
 		keyDNS        = key.New("cluster", "dns")
 		keyCASecPaths = key.New("cluster", "ca")
 		keyQuorum     = key.New("cluster", "quorum")
 
-		keyListenerCRL             = key.New("listener", "crl")
-		keyListenerAddr            = key.New("listener", "addr")
-		keyListenerPort            = key.New("listener", "port")
-		keyListenerOpenIDWellKnown = key.New("listener", "openid_well_known")
-		keyListenerDNSSockUID      = key.New("listener", "dns_sock_uid")
-		keyListenerDNSSockGID      = key.New("listener", "dns_sock_gid")
+		keyListenerCRL              = key.New("listener", "crl")
+		keyListenerAddr             = key.New("listener", "addr")
+		keyListenerPort             = key.New("listener", "port")
+		keyListenerOpenIDWellKnown  = key.New("listener", "openid_well_known")
+		keyListenerOpenIDClientID   = key.New("listener", "openid_client_id")
+		keyListenerOpenIDGrantClaim = key.New("listener", "openid_grant_claim")
+		keyListenerDNSSockUID       = key.New("listener", "dns_sock_uid")
+		keyListenerUxGrants         = key.New("listener", "ux_grants")
+		keyListenerDNSSockGID       = key.New("listener", "dns_sock_gid")
 	)
 
 	cfg := &cluster.Config{}
@@ -124,6 +130,7 @@ func getClusterConfig() (*cluster.Config, error) {
 	cfg.Name = c.GetString(keyName)
 	cfg.CASecPaths = c.GetStrings(keyCASecPaths)
 	cfg.SetSecret(c.GetString(keySecret))
+	cfg.SetNextSecret(c.GetString(keyNextSecret))
 	cfg.Quorum = c.GetBool(keyQuorum)
 	var errs error
 	if vip, err := getVip(c, cfg.Nodes); err != nil {
@@ -143,11 +150,104 @@ func getClusterConfig() (*cluster.Config, error) {
 		cfg.Listener.Port = v.(int)
 	}
 	cfg.Listener.OpenIDWellKnown = c.GetString(keyListenerOpenIDWellKnown)
+	cfg.Listener.OpenIDClientID = c.GetString(keyListenerOpenIDClientID)
+	cfg.Listener.OpenIDGrantClaim = c.GetString(keyListenerOpenIDGrantClaim)
 	cfg.Listener.DNSSockGID = c.GetString(keyListenerDNSSockGID)
 	cfg.Listener.DNSSockUID = c.GetString(keyListenerDNSSockUID)
+	if v, err := c.Eval(keyListenerUxGrants); err != nil {
+		errs = errors.Join(errs, fmt.Errorf("eval listener ux_grants: %s", err))
+	} else {
+		cfg.Listener.UxGrants = v.([]string)
+	}
+	if roles, err := getRoles(c); err != nil {
+		errs = errors.Join(errs, err)
+	} else {
+		cfg.Roles = roles
+	}
+	if notify, err := getNotify(c); err != nil {
+		errs = errors.Join(errs, err)
+	} else {
+		cfg.Notify = notify
+	}
 	return cfg, errs
 }
 
+// getRoles returns the custom rbac roles defined in the role#<name>
+// sections. The sections named after a builtin role are ignored.
+func getRoles(c *xconfig.T) (map[string]cluster.ConfigRole, error) {
+	var errs error
+	roles := make(map[string]cluster.ConfigRole)
+	for _, section := range c.SectionStrings() {
+		name, ok := strings.CutPrefix(section, "role#")
+		if !ok {
+			continue
+		}
+		if rbac.IsBuiltinRole(name) {
+			errs = errors.Join(errs, fmt.Errorf("section %s: can't redefine the builtin role %s", section, name))
+			continue
+		}
+		var role cluster.ConfigRole
+		for option, p := range map[string]*[]string{
+			"allow":      &role.Allow,
+			"namespaces": &role.Namespaces,
+			"selector":   &role.Selector,
+		} {
+			if v, err := c.Eval(key.New(section, option)); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("eval %s.%s: %s", section, option, err))
+			} else {
+				*p = v.([]string)
+			}
+		}
+		roles[name] = role
+	}
+	return roles, errs
+}
+
+// getNotify returns the webhook notifiers defined in the notify#<name>
+// sections. The sections without url are ignored.
+func getNotify(c *xconfig.T) (map[string]cluster.ConfigNotify, error) {
+	var errs error
+	notify := make(map[string]cluster.ConfigNotify)
+	for _, section := range c.SectionStrings() {
+		name, ok := strings.CutPrefix(section, "notify#")
+		if !ok {
+			continue
+		}
+		n := cluster.ConfigNotify{
+			URL:         c.GetString(key.New(section, "url")),
+			Selector:    c.GetString(key.New(section, "selector")),
+			Template:    c.GetString(key.New(section, "template")),
+			ContentType: c.GetString(key.New(section, "content_type")),
+		}
+		if n.URL == "" {
+			errs = errors.Join(errs, fmt.Errorf("section %s: url is not set", section))
+			continue
+		}
+		if v, err := c.Eval(key.New(section, "events")); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("eval %s.events: %s", section, err))
+		} else {
+			n.Events = v.([]string)
+		}
+		if v, err := c.Eval(key.New(section, "retries")); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("eval %s.retries: %s", section, err))
+		} else {
+			n.Retries = v.(int)
+		}
+		for option, p := range map[string]*time.Duration{
+			"retry_delay": &n.RetryDelay,
+			"timeout":     &n.Timeout,
+		} {
+			if v, err := c.Eval(key.New(section, option)); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("eval %s.%s: %s", section, option, err))
+			} else if d := v.(*time.Duration); d != nil {
+				*p = *d
+			}
+		}
+		notify[name] = n
+	}
+	return notify, errs
+}
+
 // VIP returns the VIP from cluster config
 var (
 	ErrVIPScope = errors.New("vip scope")
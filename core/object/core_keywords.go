@@ -7,6 +7,7 @@ import (
 	"github.com/opensvc/om3/core/driver"
 	"github.com/opensvc/om3/core/keyop"
 	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/maintenance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/placement"
 	"github.com/opensvc/om3/core/priority"
@@ -296,6 +297,14 @@ var keywordStore = keywords.Store{
 		Section:     "DEFAULT",
 		Text:        keywords.NewText(fs, "text/kw/core/flex_primary"),
 	},
+	{
+		Converter: converters.Int,
+		Inherit:   keywords.InheritHead,
+		Kind:      naming.NewKinds(naming.KindSvc),
+		Option:    "scale",
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/scale"),
+	},
 	{
 		Converter: converters.Bool,
 		Default:   "true",
@@ -364,6 +373,56 @@ var keywordStore = keywords.Store{
 		Section:    "DEFAULT",
 		Text:       keywords.NewText(fs, "text/kw/core/orchestrate"),
 	},
+	{
+		Example:  "02:00-04:00 sat,sun",
+		Inherit:  keywords.InheritHead,
+		Kind:     naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:   "maintenance_window",
+		Scopable: true,
+		Section:  "DEFAULT",
+		Text:     keywords.NewText(fs, "text/kw/core/maintenance_window"),
+	},
+	{
+		Candidates: maintenance.Kinds(),
+		Converter:  converters.List,
+		Example:    "restart",
+		Inherit:    keywords.InheritHead,
+		Kind:       naming.NewKinds(naming.KindSvc, naming.KindVol),
+		Option:     "maintenance_window_allow",
+		Scopable:   true,
+		Section:    "DEFAULT",
+		Text:       keywords.NewText(fs, "text/kw/core/maintenance_window_allow"),
+	},
+	{
+		Converter: converters.Float64,
+		Example:   "2",
+		Inherit:   keywords.InheritHead,
+		Kind:      naming.NewKinds(naming.KindSvc),
+		Option:    "cpu_request",
+		Scopable:  true,
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/cpu_request"),
+	},
+	{
+		Converter: converters.List,
+		Example:   "gpu=1 license",
+		Inherit:   keywords.InheritHead,
+		Kind:      naming.NewKinds(naming.KindSvc),
+		Option:    "label_request",
+		Scopable:  true,
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/label_request"),
+	},
+	{
+		Converter: converters.Size,
+		Example:   "64g",
+		Inherit:   keywords.InheritHead,
+		Kind:      naming.NewKinds(naming.KindSvc),
+		Option:    "mem_request",
+		Scopable:  true,
+		Section:   "DEFAULT",
+		Text:      keywords.NewText(fs, "text/kw/core/mem_request"),
+	},
 	{
 		Converter: converters.Int,
 		Default:   fmt.Sprint(priority.Default),
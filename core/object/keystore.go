@@ -39,6 +39,8 @@ type (
 		AllKeys() ([]string, error)
 		MatchingKeys(string) ([]string, error)
 
+		Reencrypt() ([]string, error)
+
 		TransactionAddKey(name string, b []byte) error
 		TransactionChangeKey(name string, b []byte) error
 		TransactionRemoveKey(name string) error
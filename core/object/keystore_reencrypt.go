@@ -0,0 +1,63 @@
+package object
+
+import (
+	"fmt"
+
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/omcrypto"
+)
+
+// Reencrypt encrypts again with the next cluster secret the keys values not
+// decodable with it, and commits. It returns the names of the re-encrypted
+// keys.
+//
+// During a cluster secret rotation, it keeps the values decodable after the
+// next secret replaces the committed secret. Out of a rotation, and for
+// unencrypted keystores, there is nothing to re-encrypt.
+func (t *keystore) Reencrypt() ([]string, error) {
+	encodeDecoder, ok := t.encodeDecoder.(*secEncodeDecode)
+	if !ok {
+		return nil, nil
+	}
+	factory, ok := encodeDecoder.encryptDecrypter.(*omcrypto.Factory)
+	if !ok || len(factory.AltKeys) == 0 {
+		return nil, nil
+	}
+	next := &secEncodeDecode{
+		encryptDecrypter: &omcrypto.Factory{
+			NodeName:    factory.NodeName,
+			ClusterName: factory.ClusterName,
+			Key:         factory.AltKeys[0],
+		},
+	}
+	names, err := t.AllKeys()
+	if err != nil {
+		return nil, err
+	}
+	var changed []string
+	for _, name := range names {
+		k := keyFromName(name)
+		s, err := t.config.GetStrict(k)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := next.Decode(s); err == nil {
+			continue
+		}
+		b, err := t.decode(name)
+		if err != nil {
+			return nil, fmt.Errorf("decode key %s: %w", name, err)
+		}
+		if s, err = next.Encode(b); err != nil {
+			return nil, fmt.Errorf("encode key %s: %w", name, err)
+		}
+		if err := t.config.PrepareSet(keyop.T{Key: k, Op: keyop.Set, Value: s}); err != nil {
+			return nil, err
+		}
+		changed = append(changed, name)
+	}
+	if len(changed) == 0 {
+		return nil, nil
+	}
+	return changed, t.config.Commit()
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/opensvc/om3/core/keywords"
+	"github.com/opensvc/om3/core/maintenance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/rawconfig"
 	"github.com/opensvc/om3/daemon/daemonenv"
@@ -360,6 +361,20 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section:   "node",
 		Text:      keywords.NewText(fs, "text/kw/node/node.maintenance_grace_period"),
 	},
+	{
+		Example: "02:00-04:00 sat,sun",
+		Option:  "maintenance_window",
+		Section: "node",
+		Text:    keywords.NewText(fs, "text/kw/node/node.maintenance_window"),
+	},
+	{
+		Candidates: maintenance.Kinds(),
+		Converter:  converters.List,
+		Example:    "restart",
+		Option:     "maintenance_window_allow",
+		Section:    "node",
+		Text:       keywords.NewText(fs, "text/kw/node/node.maintenance_window_allow"),
+	},
 	{
 		Converter: converters.Duration,
 		Default:   "90s",
@@ -471,6 +486,27 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section: "listener",
 		Text:    keywords.NewText(fs, "text/kw/node/listener.openid_well_known"),
 	},
+	{
+		Example: "cluster1",
+		Option:  "openid_client_id",
+		Section: "listener",
+		Text:    keywords.NewText(fs, "text/kw/node/listener.openid_client_id"),
+	},
+	{
+		Default: "grant",
+		Example: "entitlements",
+		Option:  "openid_grant_claim",
+		Section: "listener",
+		Text:    keywords.NewText(fs, "text/kw/node/listener.openid_grant_claim"),
+	},
+	{
+		Converter: converters.List,
+		Example:   "group:ops=operator:ns1 user:alice=admin:ns2",
+		Option:    "ux_grants",
+		Scopable:  true,
+		Section:   "listener",
+		Text:      keywords.NewText(fs, "text/kw/node/listener.ux_grants"),
+	},
 	{
 		Default: "daemon",
 		Option:  "facility",
@@ -543,6 +579,11 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section:     "cluster",
 		Text:        keywords.NewText(fs, "text/kw/node/cluster.secret"),
 	},
+	{
+		Option:  "next_secret",
+		Section: "cluster",
+		Text:    keywords.NewText(fs, "text/kw/node/cluster.next_secret"),
+	},
 	{
 		Converter: converters.List,
 		Option:    "nodes",
@@ -578,12 +619,17 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Text:       keywords.NewText(fs, "text/kw/node/node.split_action"),
 	},
 	{
-		Aliases:  []string{"name"},
-		Example:  "http://www.opensvc.com",
-		Option:   "uri",
-		Required: true,
-		Section:  "arbitrator",
-		Text:     keywords.NewText(fs, "text/kw/node/arbitrator.uri"),
+		Aliases: []string{"name"},
+		Example: "http://www.opensvc.com",
+		Option:  "uri",
+		Section: "arbitrator",
+		Text:    keywords.NewText(fs, "text/kw/node/arbitrator.uri"),
+	},
+	{
+		Example: "/dev/mapper/36589cfc000000e03957c51dabab8373a",
+		Option:  "dev",
+		Section: "arbitrator",
+		Text:    keywords.NewText(fs, "text/kw/node/arbitrator.dev"),
 	},
 	{
 		Converter: converters.Bool,
@@ -592,6 +638,12 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Section:   "arbitrator",
 		Text:      keywords.NewText(fs, "text/kw/node/arbitrator.insecure"),
 	},
+	{
+		Example: "3dc6e3c0ba6f4d5aa41d34dc0a5b3bd2",
+		Option:  "secret",
+		Section: "arbitrator",
+		Text:    keywords.NewText(fs, "text/kw/node/arbitrator.secret"),
+	},
 	{
 		Converter: converters.Shlex,
 		Example:   "/bin/true",
@@ -602,7 +654,81 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Text:      keywords.NewText(fs, "text/kw/node/stonith.cmd"),
 	},
 	{
-		Candidates: []string{"unicast", "multicast", "disk", "relay"},
+		Converter: converters.List,
+		Example:   "instance.start instance.stop instance.freeze instance.unfreeze",
+		Option:    "allow",
+		Section:   "role",
+		Text:      keywords.NewText(fs, "text/kw/node/role.allow"),
+	},
+	{
+		Converter: converters.List,
+		Example:   "prod* test",
+		Option:    "namespaces",
+		Section:   "role",
+		Text:      keywords.NewText(fs, "text/kw/node/role.namespaces"),
+	},
+	{
+		Converter: converters.List,
+		Example:   "*/svc/web* prod/vol/*",
+		Option:    "selector",
+		Section:   "role",
+		Text:      keywords.NewText(fs, "text/kw/node/role.selector"),
+	},
+	{
+		Example:  "https://hooks.example.com/opensvc",
+		Option:   "url",
+		Required: true,
+		Section:  "notify",
+		Text:     keywords.NewText(fs, "text/kw/node/notify.url"),
+	},
+	{
+		Converter: converters.List,
+		Example:   "InstanceMonitorAction NodeSplitAction ExecFailed,path=prod/svc/web",
+		Option:    "events",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.events"),
+	},
+	{
+		Example: "prod/svc/*",
+		Option:  "selector",
+		Section: "notify",
+		Text:    keywords.NewText(fs, "text/kw/node/notify.selector"),
+	},
+	{
+		Example: `{"text": "{{.kind}} on {{.data.node}}"}`,
+		Option:  "template",
+		Section: "notify",
+		Text:    keywords.NewText(fs, "text/kw/node/notify.template"),
+	},
+	{
+		Example: "text/plain",
+		Option:  "content_type",
+		Section: "notify",
+		Text:    keywords.NewText(fs, "text/kw/node/notify.content_type"),
+	},
+	{
+		Converter: converters.Int,
+		Default:   "5",
+		Option:    "retries",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.retries"),
+	},
+	{
+		Converter: converters.Duration,
+		Default:   "1s",
+		Option:    "retry_delay",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.retry_delay"),
+	},
+	{
+		Converter: converters.Duration,
+		Default:   "10s",
+		Option:    "timeout",
+		Section:   "notify",
+		Text:      keywords.NewText(fs, "text/kw/node/notify.timeout"),
+	},
+	{
+		Candidates: []string{"unicast", "multicast", "disk", "file", "relay"},
 		Option:     "type",
 		Required:   true,
 		Section:    "hb",
@@ -695,6 +821,15 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Text:     keywords.NewText(fs, "text/kw/node/hb.disk.dev"),
 		Types:    []string{"disk"},
 	},
+	{
+		Example:  "/mnt/shared/opensvc/hb1",
+		Option:   "dir",
+		Required: true,
+		Scopable: true,
+		Section:  "hb",
+		Text:     keywords.NewText(fs, "text/kw/node/hb.file.dir"),
+		Types:    []string{"file"},
+	},
 	{
 		Converter: converters.Bool,
 		Default:   "false",
@@ -704,12 +839,13 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Types:     []string{"relay"},
 	},
 	{
-		Example:  "relaynode1",
-		Option:   "relay",
-		Required: true,
-		Section:  "hb",
-		Text:     keywords.NewText(fs, "text/kw/node/hb.relay.relay"),
-		Types:    []string{"relay"},
+		Converter: converters.List,
+		Example:   "relaynode1 relaynode2",
+		Option:    "relay",
+		Required:  true,
+		Section:   "hb",
+		Text:      keywords.NewText(fs, "text/kw/node/hb.relay.relay"),
+		Types:     []string{"relay"},
 	},
 	{
 		Default: "relay",
@@ -725,6 +861,32 @@ var nodeCommonKeywords = []keywords.Keyword{
 		Text:    keywords.NewText(fs, "text/kw/node/hb.relay.password"),
 		Types:   []string{"relay"},
 	},
+	{
+		Converter: converters.List,
+		Example:   "https://relaynode2:1215",
+		Option:    "peers",
+		Section:   "relay",
+		Text:      keywords.NewText(fs, "text/kw/node/relay.peers"),
+	},
+	{
+		Default: "root",
+		Option:  "username",
+		Section: "relay",
+		Text:    keywords.NewText(fs, "text/kw/node/relay.username"),
+	},
+	{
+		Default: "system/sec/relay",
+		Option:  "password",
+		Section: "relay",
+		Text:    keywords.NewText(fs, "text/kw/node/relay.password"),
+	},
+	{
+		Converter: converters.Bool,
+		Default:   "false",
+		Option:    "insecure",
+		Section:   "relay",
+		Text:      keywords.NewText(fs, "text/kw/node/relay.insecure"),
+	},
 	{
 		Default: "/opt/cni/bin",
 		Example: "/var/lib/opensvc/cni/bin",
@@ -15,6 +15,30 @@ func pgNameObject(p naming.Path) string {
 	return fmt.Sprintf("%s.%s", p.Kind, p.Name)
 }
 
+// pgNamesObject returns the names of the object pg and its parents, from the
+// farthest to the closest.
+func pgNamesObject(p naming.Path) []string {
+	s := pgNameObject(p)
+	if p.Namespace == "root" {
+		return []string{"opensvc", s}
+	}
+	return []string{"opensvc", p.Namespace, s}
+}
+
+// pgID returns the pg id of a list of pg names.
+func pgID(l []string) string {
+	l = stringslice.Map(l, func(s string) string {
+		return s + ".slice"
+	})
+	return "/" + strings.Join(l, "/")
+}
+
+// PGID returns the id of the pg of the object <p>, parent of the pgs of its
+// subsets and resources.
+func PGID(p naming.Path) string {
+	return pgID(pgNamesObject(p))
+}
+
 func pgNameSubset(s string) string {
 	return fmt.Sprintf("subset.%s", strings.ReplaceAll(s, ":", "."))
 }
@@ -58,11 +82,7 @@ func (t *core) pgConfig(section string) *pg.Config {
 		}
 	}
 	svcPGName := func() []string {
-		s := pgNameObject(t.path)
-		if t.path.Namespace == "root" {
-			return []string{"opensvc", s}
-		}
-		return []string{"opensvc", t.path.Namespace, s}
+		return pgNamesObject(t.path)
 	}
 	subsetPGName := func(s string) []string {
 		name := subsetName(s)
@@ -85,10 +105,7 @@ func (t *core) pgConfig(section string) *pg.Config {
 		default:
 			l = resPGName(s)
 		}
-		l = stringslice.Map(l, func(s string) string {
-			return s + ".slice"
-		})
-		return "/" + strings.Join(l, "/")
+		return pgID(l)
 	}
 	data.ID = pgName(section)
 	return &data
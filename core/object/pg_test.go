@@ -0,0 +1,22 @@
+package object
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+)
+
+func TestPGID(t *testing.T) {
+	cases := map[string]string{
+		"svc1":        "/opensvc.slice/svc.svc1.slice",
+		"ns1/vol/v1":  "/opensvc.slice/ns1.slice/vol.v1.slice",
+		"ns1/svc/foo": "/opensvc.slice/ns1.slice/svc.foo.slice",
+	}
+	for s, expected := range cases {
+		p, err := naming.ParsePath(s)
+		require.NoError(t, err)
+		require.Equal(t, expected, PGID(p), s)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 
@@ -51,20 +52,33 @@ type (
 
 var (
 	secEncryptDecrypterMutex sync.Mutex
-	secEncryptDecrypterCache encryptDecrypter
+	secEncryptDecrypterCache *omcrypto.Factory
 )
 
+// GetSecEncryptDecrypter returns the encrypter and decrypter of the sec and
+// usr keys values.
+//
+// During a cluster secret rotation, the values are still encrypted with the
+// committed secret, so the peers not yet aware of the next secret can decrypt
+// them, and decrypted with the committed or the next secret. The encryption
+// switches to the next secret when the rotation commits it.
 func GetSecEncryptDecrypter() (encryptDecrypter, error) {
 	secEncryptDecrypterMutex.Lock()
 	defer secEncryptDecrypterMutex.Unlock()
-	if secEncryptDecrypterCache != nil {
-		return secEncryptDecrypterCache, nil
-	}
 	clusterConfig := cluster.ConfigData.Get()
+	key := clusterConfig.CommittedSecret()
+	var altKeys []string
+	if nextSecret := clusterConfig.NextSecret(); nextSecret != "" && nextSecret != key {
+		altKeys = []string{nextSecret}
+	}
+	if c := secEncryptDecrypterCache; c != nil && c.Key == key && slices.Equal(c.AltKeys, altKeys) {
+		return c, nil
+	}
 	secEncryptDecrypterCache = &omcrypto.Factory{
 		NodeName:    hostname.Hostname(),
 		ClusterName: clusterConfig.Name,
-		Key:         clusterConfig.Secret(),
+		Key:         key,
+		AltKeys:     altKeys,
 	}
 	return secEncryptDecrypterCache, nil
 }
@@ -47,6 +47,17 @@ type (
 		Topology         topology.T       `json:"topology"`
 		UpInstancesCount int              `json:"up_instances_count"`
 
+		// PlacementViolations is the map of affinity constraints not
+		// satisfied by the instances, indexed by node name.
+		PlacementViolations map[string][]string `json:"placement_violations,omitempty"`
+
+		// Scaler specific
+		Scale *int `json:"scale,omitempty"`
+
+		// ScalerSlices is the avail status of the scaler slices, indexed by
+		// slice path.
+		ScalerSlices map[string]status.T `json:"scaler_slices,omitempty"`
+
 		// Volume specific
 		Pool *string `json:"pool,omitempty"`
 		Size *int64  `json:"size,omitempty"`
@@ -120,6 +131,9 @@ func (t Digest) ObjectWarningsString() string {
 	if t.Object.PlacementState == placement.NonOptimal {
 		l = append(l, rawconfig.Colorize.Warning(fmt.Sprintf("%s placement", t.Object.PlacementState)))
 	}
+	if len(t.Object.PlacementViolations) > 0 {
+		l = append(l, rawconfig.Colorize.Warning("constrained placement"))
+	}
 
 	// Agent compatibility
 	if !t.IsCompat {
@@ -137,6 +151,22 @@ func NewStatus() *Digest {
 }
 
 func (s *Status) DeepCopy() *Status {
+	var placementViolations map[string][]string
+	if s.PlacementViolations != nil {
+		placementViolations = make(map[string][]string, len(s.PlacementViolations))
+		for k, v := range s.PlacementViolations {
+			placementViolations[k] = append([]string{}, v...)
+		}
+	}
+	var scale *int
+	if s.Scale != nil {
+		i := *s.Scale
+		scale = &i
+	}
+	var scalerSlices map[string]status.T
+	if s.ScalerSlices != nil {
+		scalerSlices = xmap.Copy(s.ScalerSlices)
+	}
 	return &Status{
 		Avail:            s.Avail,
 		Overall:          s.Overall,
@@ -155,5 +185,10 @@ func (s *Status) DeepCopy() *Status {
 		Size:             s.Size,
 		Scope:            append([]string{}, s.Scope...),
 		UpdatedAt:        s.UpdatedAt,
+
+		PlacementViolations: placementViolations,
+
+		Scale:        scale,
+		ScalerSlices: scalerSlices,
 	}
 }
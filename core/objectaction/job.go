@@ -0,0 +1,112 @@
+package objectaction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	// jobResult is the outcome of a job follow.
+	jobResult struct {
+		job api.Job
+		err error
+	}
+)
+
+var (
+	// jobPollInterval is the interval between two job state requests.
+	jobPollInterval = time.Second
+)
+
+// followJobs follows the jobs <ids> until they end, prints the output of the
+// commands they executed as they end, and returns the errors of the failed
+// jobs.
+func (t T) followJobs(ctx context.Context, c *client.T, ids []uuid.UUID) error {
+	var errs error
+	resultC := make(chan jobResult, len(ids))
+	for _, id := range ids {
+		go func(id uuid.UUID) {
+			job, err := followJob(ctx, c, id)
+			resultC <- jobResult{job: job, err: err}
+		}(id)
+	}
+	for range ids {
+		r := <-resultC
+		if r.err != nil {
+			errs = errors.Join(errs, r.err)
+			continue
+		}
+		output.Renderer{
+			DefaultOutput: "human",
+			Output:        t.Output,
+			Color:         t.Color,
+			Data:          r.job,
+			HumanRenderer: func() string { return jobHumanRender(r.job) },
+			Colorize:      rawconfig.Colorize,
+		}.Print()
+		if r.job.State != api.Succeeded {
+			errs = errors.Join(errs, fmt.Errorf("%s: job %s %s", r.job.Path, r.job.Id, r.job.State))
+		}
+	}
+	return errs
+}
+
+// followJob requests the state of the job <id> until it ends.
+func followJob(ctx context.Context, c *client.T, id uuid.UUID) (api.Job, error) {
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+	for {
+		resp, err := c.GetJobWithResponse(ctx, id, &api.GetJobParams{})
+		switch {
+		case err != nil:
+			return api.Job{}, fmt.Errorf("follow job %s: %w", id, err)
+		case resp.JSON200 != nil:
+			if resp.JSON200.EndedAt != nil {
+				return *resp.JSON200, nil
+			}
+		case resp.StatusCode() == http.StatusNotFound:
+			return api.Job{}, fmt.Errorf("follow job %s: not found", id)
+		default:
+			return api.Job{}, fmt.Errorf("follow job %s: unexpected status %s", id, resp.Status())
+		}
+		select {
+		case <-ctx.Done():
+			return api.Job{}, fmt.Errorf("follow job %s: %w", id, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func jobHumanRender(job api.Job) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: job %s %s\n", job.Path, job.Id, job.State)
+	for _, inst := range job.Instances {
+		for _, exec := range inst.Execs {
+			exitCode := "-"
+			if exec.ExitCode != nil {
+				exitCode = fmt.Sprint(*exec.ExitCode)
+			}
+			fmt.Fprintf(&sb, "  %s: %s: exit code %s\n", inst.Node, exec.Command, exitCode)
+			for _, s := range []string{exec.Stdout, exec.Stderr} {
+				if s == "" {
+					continue
+				}
+				for _, line := range strings.Split(strings.TrimRight(s, "\n"), "\n") {
+					fmt.Fprintf(&sb, "    %s\n", line)
+				}
+			}
+		}
+	}
+	return sb.String()
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"os"
 	"reflect"
@@ -19,7 +18,6 @@ import (
 	"github.com/opensvc/om3/core/actioncontext"
 	"github.com/opensvc/om3/core/actionrouter"
 	"github.com/opensvc/om3/core/client"
-	"github.com/opensvc/om3/core/event"
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/nodeselector"
@@ -29,11 +27,9 @@ import (
 	"github.com/opensvc/om3/core/rawconfig"
 	"github.com/opensvc/om3/core/topology"
 	"github.com/opensvc/om3/daemon/api"
-	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/funcopt"
 	"github.com/opensvc/om3/util/hostname"
 	"github.com/opensvc/om3/util/progress"
-	"github.com/opensvc/om3/util/pubsub"
 	"github.com/opensvc/om3/util/render/tree"
 	"github.com/opensvc/om3/util/xsession"
 )
@@ -50,6 +46,7 @@ type (
 	asyncResult struct {
 		Path            string    `json:"path"`
 		OrchestrationID uuid.UUID `json:"orchestration_id,omitempty"`
+		JobID           uuid.UUID `json:"job_id,omitempty"`
 		Error           error     `json:"error,omitempty"`
 	}
 
@@ -418,8 +415,7 @@ func (t T) DoAsync() error {
 		ctx    context.Context
 		cancel context.CancelFunc
 		errs   error
-		waitC  chan error
-		toWait int
+		jobIDs []uuid.UUID
 	)
 	if t.WaitDuration > 0 {
 		ctx, cancel = context.WithTimeout(context.Background(), t.WaitDuration)
@@ -429,18 +425,12 @@ func (t T) DoAsync() error {
 		defer cancel()
 	}
 	rs := make(asyncResults, 0)
-	if t.Wait {
-		waitC = make(chan error, len(paths))
-	}
 
 	for _, p := range paths {
 		var (
 			err error
 			b   []byte
 		)
-		if t.Wait {
-			t.waitExpectation(ctx, c, t.Target, p, waitC)
-		}
 		switch target {
 		case instance.MonitorGlobalExpectAborted:
 			if resp, e := c.PostObjectActionAbortWithResponse(ctx, p.Namespace, p.Kind, p.Name); e != nil {
@@ -741,13 +731,19 @@ func (t T) DoAsync() error {
 				Path:  p.String(),
 			}
 		} else {
-			toWait++
 			var orchestrationQueued api.OrchestrationQueued
 			if err := json.Unmarshal(b, &orchestrationQueued); err == nil {
 				r = asyncResult{
 					OrchestrationID: orchestrationQueued.OrchestrationID,
 					Path:            p.String(),
 				}
+				if orchestrationQueued.JobID != nil {
+					r.JobID = *orchestrationQueued.JobID
+					jobIDs = append(jobIDs, r.JobID)
+				} else if t.Wait {
+					// a daemon not tracking the orchestrations as jobs
+					errs = errors.Join(errs, fmt.Errorf("%s: can't wait: the daemon returned no job id", p))
+				}
 			} else {
 				r = asyncResult{
 					Error: err,
@@ -764,18 +760,8 @@ func (t T) DoAsync() error {
 		Data:          rs,
 		Colorize:      rawconfig.Colorize,
 	}.Print()
-	if t.Wait && toWait > 0 {
-		for i := 0; i < toWait; i++ {
-			select {
-			case <-ctx.Done():
-				errs = errors.Join(errs, ctx.Err())
-				return errs
-			case err := <-waitC:
-				if err != nil {
-					errs = errors.Join(errs, err)
-				}
-			}
-		}
+	if t.Wait && len(jobIDs) > 0 {
+		errs = errors.Join(errs, t.followJobs(ctx, c, jobIDs))
 	}
 	return errs
 }
@@ -813,13 +799,10 @@ func (t T) DoRemote() error {
 	resultQ := make(chan actionrouter.Result)
 	done := 0
 	todo := 0
-	requesterSid := xsession.ID
 
 	var (
 		cancel context.CancelFunc
 		errs   error
-		waitC  chan error
-		count  int
 	)
 
 	ctx := context.Background()
@@ -843,11 +826,6 @@ func (t T) DoRemote() error {
 			return fmt.Errorf("%s: cowardly refusing to start multiple instances: topology is failover", item.Meta.Object)
 		}
 		resp.JSON200.Items[i].Data.Instances = selectedInstances
-		count += len(selectedInstances)
-	}
-
-	if t.Wait {
-		waitC = make(chan error, count)
 	}
 
 	for _, item := range resp.JSON200.Items {
@@ -856,9 +834,6 @@ func (t T) DoRemote() error {
 			if err != nil {
 				return err
 			}
-			if t.Wait {
-				t.waitRequesterSessionEnd(ctx, c, requesterSid, p, waitC)
-			}
 			t.instanceDo(ctx, resultQ, n, p, func(ctx context.Context, n string, p naming.Path) (any, error) {
 				return t.RemoteFunc(ctx, p, n)
 			})
@@ -892,18 +867,14 @@ func (t T) DoRemote() error {
 		HumanRenderer: func() string { return rsHumanRender(results) },
 		Colorize:      rawconfig.Colorize,
 	}.Print()
-	if t.Wait && todo > 0 {
-		for i := 0; i < todo; i++ {
-			select {
-			case <-ctx.Done():
-				errs = errors.Join(errs, ctx.Err())
-				return errs
-			case err := <-waitC:
-				if err != nil {
-					errs = errors.Join(errs, err)
-				}
+	if t.Wait {
+		var jobIDs []uuid.UUID
+		for _, result := range results {
+			if accepted, ok := result.Data.(api.InstanceActionAccepted); ok {
+				jobIDs = append(jobIDs, accepted.JobID)
 			}
 		}
+		errs = errors.Join(errs, t.followJobs(ctx, c, jobIDs))
 	}
 	return errs
 }
@@ -939,157 +910,6 @@ func (t T) instanceDo(ctx context.Context, resultQ chan actionrouter.Result, nod
 	}(nodename, path)
 }
 
-// waitExpectation will subscribe on path related messages, and will write to errC when expectation in not reached
-// It starts new subscription before return to avoid missed events.
-// it starts go routine to watch events for expectation reached
-func (t T) waitExpectation(ctx context.Context, c *client.T, expectation string, p naming.Path, errC chan<- error) {
-	var (
-		filters []string
-		msg     pubsub.Messager
-
-		err      error
-		evReader event.ReadCloser
-	)
-	switch expectation {
-	case instance.MonitorGlobalExpectPurged.String():
-		filters = []string{"ObjectStatusDeleted,path=" + p.String()}
-	default:
-		filters = []string{"InstanceMonitorUpdated,path=" + p.String()}
-	}
-	filters = append(filters, "SetInstanceMonitorRefused,path="+p.String())
-	getEvents := c.NewGetEvents().SetFilters(filters)
-	if t.WaitDuration > 0 {
-		getEvents = getEvents.SetDuration(t.WaitDuration)
-	}
-	evReader, err = getEvents.GetReader()
-	if err != nil {
-		return
-	}
-
-	if x, ok := evReader.(event.ContextSetter); ok {
-		x.SetContext(ctx)
-	}
-	go func() {
-		defer func() {
-			if err != nil {
-				err = fmt.Errorf("wait expectation %s failed on object %s: %w", expectation, p, err)
-			}
-			select {
-			case <-ctx.Done():
-			case errC <- err:
-			}
-		}()
-
-		go func() {
-			// close reader when ctx is done
-			select {
-			case <-ctx.Done():
-				_ = evReader.Close()
-			}
-		}()
-		for {
-			ev, readError := evReader.Read()
-			if readError != nil {
-				if errors.Is(readError, io.EOF) {
-					err = fmt.Errorf("no more events, wait %v failed: %w", p, err)
-				} else {
-					err = readError
-				}
-				return
-			}
-			msg, err = msgbus.EventToMessage(*ev)
-			if err != nil {
-				return
-			}
-			switch m := msg.(type) {
-			case *msgbus.SetInstanceMonitorRefused:
-				err = fmt.Errorf("%s: can't wait expectation %s, got SetInstanceMonitorRefused", p, expectation)
-				log.Debug().Msgf("%s", err)
-				return
-			case *msgbus.InstanceMonitorUpdated:
-				if m.Value.GlobalExpect == instance.MonitorGlobalExpectNone {
-					log.Debug().Msgf("%s: reached expectation %s (global expect is %s)", p, expectation, m.Value.GlobalExpect)
-					return
-				}
-			case *msgbus.ObjectStatusDeleted:
-				log.Debug().Msgf("%s: reached expectation %s (deleted)", p, expectation)
-				return
-			}
-		}
-	}()
-}
-
-func (t T) waitRequesterSessionEnd(ctx context.Context, c *client.T, requesterSid uuid.UUID, p naming.Path, errC chan<- error) {
-	var (
-		filters []string
-		msg     pubsub.Messager
-
-		err      error
-		evReader event.ReadCloser
-	)
-	filters = []string{
-		fmt.Sprintf("ObjectStatusDeleted,path=%s", p),
-		fmt.Sprintf("ExecFailed,path=%s,requester_sid=%s", p, requesterSid),
-		fmt.Sprintf("ExecSuccess,path=%s,requester_sid=%s", p, requesterSid),
-	}
-	getEvents := c.NewGetEvents().SetFilters(filters)
-	if t.WaitDuration > 0 {
-		getEvents = getEvents.SetDuration(t.WaitDuration)
-	}
-	evReader, err = getEvents.GetReader()
-	if err != nil {
-		return
-	}
-
-	if x, ok := evReader.(event.ContextSetter); ok {
-		x.SetContext(ctx)
-	}
-	go func() {
-		defer func() {
-			if err != nil {
-				err = fmt.Errorf("wait requester session end failed on object %s: %w", p, err)
-			}
-			select {
-			case <-ctx.Done():
-			case errC <- err:
-			}
-		}()
-
-		go func() {
-			// close reader when ctx is done
-			select {
-			case <-ctx.Done():
-				_ = evReader.Close()
-			}
-		}()
-		for {
-			ev, readError := evReader.Read()
-			if readError != nil {
-				if errors.Is(readError, io.EOF) {
-					err = fmt.Errorf("no more events, wait %v failed: %w", p, err)
-				} else {
-					err = readError
-				}
-				return
-			}
-			msg, err = msgbus.EventToMessage(*ev)
-			if err != nil {
-				return
-			}
-			switch m := msg.(type) {
-			case *msgbus.ExecSuccess:
-				return
-			case *msgbus.ExecFailed:
-				err = errors.New(m.ErrS)
-				return
-			case *msgbus.ObjectStatusDeleted:
-				log.Debug().Msgf("%s: stop waiting requester session id end (deleted)", p)
-				return
-			}
-		}
-	}()
-}
-
 func (t asyncResult) Unstructured() map[string]any {
 	var errorString string
 	if t.Error != nil {
@@ -1099,6 +919,7 @@ func (t asyncResult) Unstructured() map[string]any {
 	}
 	return map[string]any{
 		"orchestration_id": t.OrchestrationID.String(),
+		"job_id":           t.JobID.String(),
 		"path":             t.Path,
 		"error":            errorString,
 	}
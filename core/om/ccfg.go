@@ -7,6 +7,7 @@ func init() {
 	cmdObjectEdit := newCmdObjectEdit(kind)
 	cmdObjectSet := newCmdObjectSet(kind)
 	cmdObjectSSH := newCmdObjectSSH(kind)
+	cmdClusterRotate := newCmdClusterRotate()
 	cmdObjectPrint := newCmdObjectPrint(kind)
 	cmdObjectPrintConfig := newCmdObjectPrintConfig(kind)
 	cmdObjectValidate := newCmdObjectValidate(kind)
@@ -21,10 +22,13 @@ func init() {
 		cmdObjectPrint,
 		cmdObjectValidate,
 		newCmdClusterAbort(),
+		newCmdClusterAudit(),
 		newCmdClusterFreeze(),
 		newCmdClusterLogs(),
+		cmdClusterRotate,
 		newCmdClusterThaw(),
 		newCmdClusterUnfreeze(),
+		newCmdClusterUpgrade(),
 		newCmdObjectCreate(kind),
 		newCmdObjectDoc(kind),
 		newCmdObjectEval(kind),
@@ -46,6 +50,9 @@ func init() {
 	cmdObjectPrintConfig.AddCommand(
 		newCmdObjectPrintConfigMtime(kind),
 	)
+	cmdClusterRotate.AddCommand(
+		newCmdClusterRotateSecret(),
+	)
 	cmdObjectSSH.AddCommand(
 		newCmdClusterSSHTrust(),
 	)
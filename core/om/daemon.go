@@ -15,6 +15,11 @@ var (
 		Short: "dns subsystem commands",
 	}
 
+	cmdDaemonHb = &cobra.Command{
+		Use:   "hb",
+		Short: "heartbeat subsystem commands",
+	}
+
 	cmdDaemonRelay = &cobra.Command{
 		Use:   "relay",
 		Short: "relay subsystem commands",
@@ -26,8 +31,10 @@ func init() {
 		cmdDaemon,
 	)
 	cmdDaemon.AddCommand(
+		newCmdDaemonArbitrator(),
 		newCmdDaemonAuth(),
 		cmdDaemonDNS,
+		cmdDaemonHb,
 		newCmdDaemonJoin(),
 		newCmdDaemonLeave(),
 		cmdDaemonRelay,
@@ -42,6 +49,12 @@ func init() {
 	cmdDaemonDNS.AddCommand(
 		newCmdDaemonDNSDump(),
 	)
+	cmdDaemonHb.AddCommand(
+		newCmdDaemonHbClear(),
+		newCmdDaemonHbDelay(),
+		newCmdDaemonHbDrop(),
+		newCmdDaemonHbPause(),
+	)
 	cmdDaemonRelay.AddCommand(
 		newCmdDaemonRelayStatus(),
 	)
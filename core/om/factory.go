@@ -138,6 +138,25 @@ func newCmdArrayLs() *cobra.Command {
 	return cmd
 }
 
+func newCmdClusterAudit() *cobra.Command {
+	var options commands.CmdClusterAudit
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "show the audit records of the mutating api requests served by the cluster nodes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagAuditLimit(flags, &options.Limit)
+	addFlagAuditNode(flags, &options.Node)
+	addFlagAuditPath(flags, &options.Path)
+	addFlagAuditSince(flags, &options.Since)
+	addFlagAuditUser(flags, &options.User)
+	return cmd
+}
+
 func newCmdClusterAbort() *cobra.Command {
 	var options commands.CmdClusterAbort
 	cmd := &cobra.Command{
@@ -216,6 +235,28 @@ func newCmdClusterUnfreeze() *cobra.Command {
 	return cmd
 }
 
+func newCmdClusterUpgrade() *cobra.Command {
+	var options commands.CmdClusterUpgrade
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "rolling restart of the daemon of all nodes, optionally installing a new binary",
+		Long: "Drain, restart the daemon and unfreeze the cluster nodes, one node at a time.\n\n" +
+			"With --binary, the om binary is replaced by the given file before the daemon restart. " +
+			"The file must exist on all nodes.\n\n" +
+			"An upgrade interrupted by 'cluster abort' can be resumed with --resume, " +
+			"skipping the nodes already restarted.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagsAsync(flags, &options.OptsAsync)
+	flags.StringVar(&options.Binary, "binary", "", "the path of the om binary to install on each node before its daemon restart")
+	flags.BoolVar(&options.Resume, "resume", false, "resume the last upgrade, skipping the nodes already restarted")
+	return cmd
+}
+
 func newCmdDaemonAuth() *cobra.Command {
 	var options commands.CmdDaemonAuth
 	cmd := &cobra.Command{
@@ -228,8 +269,48 @@ func newCmdDaemonAuth() *cobra.Command {
 	flags := cmd.Flags()
 	addFlagsGlobal(flags, &options.OptsGlobal)
 	addFlagRoles(flags, &options.Roles)
+	addFlagTokenGrants(flags, &options.Grants)
+	addFlagTokenNamespace(flags, &options.Namespace)
 	flags.DurationVar(&options.Duration, "duration", 60*time.Second, "token duration.")
-	flags.StringSliceVar(&options.Out, "out", []string{"token"}, "the fields to display: [token,expired_at]")
+	flags.BoolVar(&options.Refresh, "refresh", false, "also create a refresh token.")
+	flags.StringSliceVar(&options.Out, "out", []string{"token"}, "the fields to display: [token,expired_at,token_id,refresh_token]")
+	cmd.AddCommand(
+		newCmdDaemonAuthLs(),
+		newCmdDaemonAuthRevoke(),
+	)
+	return cmd
+}
+
+func newCmdDaemonAuthLs() *cobra.Command {
+	var options commands.CmdDaemonAuthLs
+	cmd := &cobra.Command{
+		Use:     "ls",
+		Aliases: []string{"list"},
+		Short:   "list the active tokens",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagTokenID(flags, &options.ID)
+	addFlagTokenUser(flags, &options.User)
+	return cmd
+}
+
+func newCmdDaemonAuthRevoke() *cobra.Command {
+	var options commands.CmdDaemonAuthRevoke
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "revoke a token, or all the tokens issued to a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagTokenID(flags, &options.ID)
+	addFlagTokenUser(flags, &options.User)
 	return cmd
 }
 
@@ -247,6 +328,102 @@ func newCmdDaemonDNSDump() *cobra.Command {
 	return cmd
 }
 
+func newCmdDaemonHbClear() *cobra.Command {
+	var options commands.CmdDaemonHbClear
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "clear the faults injected in a heartbeat stream",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	addFlagHbID(flags, &options.HbID)
+	addFlagHbPeer(flags, &options.Peer)
+	return cmd
+}
+
+func newCmdDaemonHbDelay() *cobra.Command {
+	var options commands.CmdDaemonHbFault
+	cmd := &cobra.Command{
+		Use:   "delay",
+		Short: "delay the messages of a heartbeat stream",
+		Long: `Delay the messages of a heartbeat stream, until the fault expires.
+
+Example:
+
+  om daemon hb delay --node n1 --hb hb#1.rx --peer n2 --delay 5s --duration 1m`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Action = "delay"
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	addFlagHbID(flags, &options.HbID)
+	addFlagHbPeer(flags, &options.Peer)
+	addFlagHbFaultDelay(flags, &options.Delay)
+	addFlagHbFaultDuration(flags, &options.Duration)
+	return cmd
+}
+
+func newCmdDaemonHbDrop() *cobra.Command {
+	var options commands.CmdDaemonHbFault
+	cmd := &cobra.Command{
+		Use:   "drop",
+		Short: "drop the messages of a heartbeat stream",
+		Long: `Drop the messages of a heartbeat stream, until the fault expires.
+
+The tx faults apply to all peers. Inject a rx fault on a peer node to drop
+the messages from a single node.
+
+Example:
+
+  om daemon hb drop --node n1 --hb hb#1.rx --peer n2 --duration 1m`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Action = "drop"
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	addFlagHbID(flags, &options.HbID)
+	addFlagHbPeer(flags, &options.Peer)
+	addFlagHbFaultDuration(flags, &options.Duration)
+	return cmd
+}
+
+func newCmdDaemonHbPause() *cobra.Command {
+	var options commands.CmdDaemonHbFault
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "pause the messages of a heartbeat stream",
+		Long: `Pause the messages of a heartbeat stream, until the fault expires.
+
+The last message received during the pause is delivered when the fault
+expires.
+
+Example:
+
+  om daemon hb pause --node n1 --hb hb#1.tx --duration 1m`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			options.Action = "pause"
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	addFlagNodeSelector(flags, &options.NodeSelector)
+	addFlagHbID(flags, &options.HbID)
+	addFlagHbPeer(flags, &options.Peer)
+	addFlagHbFaultDuration(flags, &options.Duration)
+	return cmd
+}
+
 func newCmdDaemonJoin() *cobra.Command {
 	var options commands.CmdDaemonJoin
 	cmd := &cobra.Command{
@@ -321,6 +498,46 @@ func newCmdDaemonRestart() *cobra.Command {
 	return cmd
 }
 
+func newCmdDaemonArbitrator() *cobra.Command {
+	var options commands.CmdDaemonArbitrator
+	cmd := &cobra.Command{
+		Use:   "arbitrator",
+		Short: "run a standalone arbitrator server in foreground",
+		Long: `Run a standalone arbitrator server in foreground.
+
+The arbitrator server gives its vote to the nodes of split clusters. It does
+not need a cluster node installation, and can serve many clusters, each
+authenticated by its own secret.
+
+The configuration file is an ini file like:
+
+  [listener]
+  addr = :1216
+  cert = /etc/opensvc/arbitrator.crt
+  key = /etc/opensvc/arbitrator.key
+
+  [vote]
+  # grant the vote to only one partition of a split cluster
+  exclusive = true
+  # duration of the grant to a partition
+  ttl = 1m
+
+  [cluster#cluster1]
+  secret = 3dc6e3c0ba6f4d5aa41d34dc0a5b3bd2
+
+The cluster nodes use the arbitrator with:
+
+  om cluster set --kw arbitrator#arb1.uri=https://arb1:1216 \
+                 --kw arbitrator#arb1.secret=3dc6e3c0ba6f4d5aa41d34dc0a5b3bd2`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagArbitratorConfig(flags, &options.Config)
+	return cmd
+}
+
 func newCmdDaemonRunning() *cobra.Command {
 	var options commands.CmdDaemonRunning
 	cmd := &cobra.Command{
@@ -1667,6 +1884,33 @@ func newCmdNodeUpdateSSHKeys() *cobra.Command {
 	return cmd
 }
 
+func newCmdClusterRotate() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rotate",
+		Short: "credentials rotation command group",
+	}
+}
+
+func newCmdClusterRotateSecret() *cobra.Command {
+	var options commands.CmdClusterRotateSecret
+	cmd := &cobra.Command{
+		Use:   "secret",
+		Short: "rotate the cluster secret without downtime",
+		Long: "Set a new cluster secret in cluster.next_secret. The daemons accept both " +
+			"the current and the next secrets to decrypt, switch to the next secret to " +
+			"encrypt when all nodes accept it, then retire the current secret a grace " +
+			"period after all nodes have switched.\n\n" +
+			"The progress is reported in the secret_rotation section of the daemon " +
+			"heartbeat status of each node.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return options.Run()
+		},
+	}
+	flags := cmd.Flags()
+	addFlagsGlobal(flags, &options.OptsGlobal)
+	return cmd
+}
+
 func newCmdClusterSSHTrust() *cobra.Command {
 	var options commands.CmdClusterSSHTrust
 	cmd := &cobra.Command{
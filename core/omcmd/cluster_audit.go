@@ -0,0 +1,62 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdClusterAudit struct {
+		OptsGlobal
+		Since time.Duration
+		Node  string
+		User  string
+		Path  string
+		Limit int64
+	}
+)
+
+func (t *CmdClusterAudit) Run() error {
+	cli, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	params := api.GetAuditParams{}
+	if t.Since > 0 {
+		s := t.Since.String()
+		params.Since = &s
+	}
+	if t.Node != "" {
+		params.Node = &t.Node
+	}
+	if t.User != "" {
+		params.User = &t.User
+	}
+	if t.Path != "" {
+		params.Path = &t.Path
+	}
+	if t.Limit > 0 {
+		params.Limit = &t.Limit
+	}
+	resp, err := cli.GetAuditWithResponse(context.Background(), &params)
+	if err != nil {
+		return err
+	} else if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected get audit status code %s", resp.Status())
+	}
+	output.Renderer{
+		DefaultOutput: "tab=AT:at,NODE:node,USER:user,STRATEGY:strategy,SOURCE:source,METHOD:method,PATH:path,STATUS:status",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          *resp.JSON200,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
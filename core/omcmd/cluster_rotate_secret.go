@@ -0,0 +1,79 @@
+package omcmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdClusterRotateSecret struct {
+		OptsGlobal
+	}
+)
+
+// Run sets a new cluster.next_secret. The daemons then drive the rotation:
+// accept both secrets, switch to the next secret when all nodes accept it,
+// and retire the old secret when all nodes have switched.
+func (t *CmdClusterRotateSecret) Run() error {
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	p := naming.Cluster
+	kw := "cluster.next_secret"
+
+	getParams := api.GetObjectConfigGetParams{Kw: &[]string{kw}}
+	getResp, err := c.GetObjectConfigGetWithResponse(ctx, p.Namespace, p.Kind, p.Name, &getParams)
+	if err != nil {
+		return err
+	}
+	switch getResp.StatusCode() {
+	case 200:
+		for _, item := range getResp.JSON200.Items {
+			if s, ok := item.Data.Value.(string); ok && s != "" {
+				return fmt.Errorf("a cluster secret rotation is already in progress")
+			}
+		}
+	case 400:
+		return fmt.Errorf("%s", *getResp.JSON400)
+	case 401:
+		return fmt.Errorf("%s", *getResp.JSON401)
+	case 403:
+		return fmt.Errorf("%s", *getResp.JSON403)
+	case 500:
+		return fmt.Errorf("%s", *getResp.JSON500)
+	default:
+		return fmt.Errorf("unexpected response: %s", getResp.Status())
+	}
+
+	secret := strings.ReplaceAll(uuid.New().String(), "-", "")
+	sets := []string{kw + "=" + secret}
+	params := api.PostObjectConfigUpdateParams{Set: &sets}
+	resp, err := c.PostObjectConfigUpdateWithResponse(ctx, p.Namespace, p.Kind, p.Name, &params)
+	if err != nil {
+		return err
+	}
+	switch resp.StatusCode() {
+	case 200, 204:
+		fmt.Println("cluster secret rotation started")
+		return nil
+	case 400:
+		return fmt.Errorf("%s", *resp.JSON400)
+	case 401:
+		return fmt.Errorf("%s", *resp.JSON401)
+	case 403:
+		return fmt.Errorf("%s", *resp.JSON403)
+	case 500:
+		return fmt.Errorf("%s", *resp.JSON500)
+	default:
+		return fmt.Errorf("unexpected response: %s", resp.Status())
+	}
+}
@@ -0,0 +1,31 @@
+package omcmd
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/arbitrator"
+)
+
+type (
+	CmdDaemonArbitrator struct {
+		Config string
+	}
+)
+
+func (t *CmdDaemonArbitrator) Run() error {
+	p := t.Config
+	if p == "" {
+		p = rawconfig.ArbitratorConfigFile()
+	}
+	cfg, err := arbitrator.LoadConfig(p)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return arbitrator.New(cfg).Run(ctx)
+}
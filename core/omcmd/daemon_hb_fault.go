@@ -0,0 +1,143 @@
+package omcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/clientcontext"
+	"github.com/opensvc/om3/core/nodeselector"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+type (
+	// CmdDaemonHbFault injects a fault in a daemon heartbeat stream.
+	CmdDaemonHbFault struct {
+		OptsGlobal
+		NodeSelector string
+
+		// Action is the fault action: pause, drop or delay.
+		Action string
+
+		HbID     string
+		Peer     string
+		Delay    time.Duration
+		Duration time.Duration
+	}
+
+	// CmdDaemonHbClear clears the faults injected in a daemon heartbeat
+	// stream.
+	CmdDaemonHbClear struct {
+		OptsGlobal
+		NodeSelector string
+		HbID         string
+		Peer         string
+	}
+)
+
+func (t *CmdDaemonHbFault) Run() error {
+	if t.HbID == "" {
+		return fmt.Errorf("--hb must be specified")
+	}
+	if t.Duration <= 0 {
+		return fmt.Errorf("--duration must be specified")
+	}
+	body := api.PostDaemonHeartbeatFault{
+		Action:   api.DaemonHeartbeatFaultAction(t.Action),
+		Duration: t.Duration.String(),
+		Hb:       t.HbID,
+	}
+	if t.Peer != "" {
+		body.Peer = &t.Peer
+	}
+	if t.Delay > 0 {
+		delay := t.Delay.String()
+		body.Delay = &delay
+	}
+	return doHbFaultNodes(t.OptsGlobal, t.NodeSelector, func(ctx context.Context, c *client.T, nodename string) error {
+		resp, err := c.PostDaemonHeartbeatFaultWithResponse(ctx, nodename, body)
+		if err != nil {
+			return fmt.Errorf("%s: %w", nodename, err)
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+			fmt.Printf("%s: %s\n", nodename, resp.JSON200.Detail)
+			return nil
+		case 400:
+			return fmt.Errorf("%s: %s", nodename, *resp.JSON400)
+		case 401:
+			return fmt.Errorf("%s: %s", nodename, *resp.JSON401)
+		case 403:
+			return fmt.Errorf("%s: %s", nodename, *resp.JSON403)
+		case 500:
+			return fmt.Errorf("%s: %s", nodename, *resp.JSON500)
+		default:
+			return fmt.Errorf("%s: unexpected status [%d]", nodename, resp.StatusCode())
+		}
+	})
+}
+
+func (t *CmdDaemonHbClear) Run() error {
+	if t.HbID == "" {
+		return fmt.Errorf("--hb must be specified")
+	}
+	params := api.DeleteDaemonHeartbeatFaultParams{Hb: t.HbID}
+	if t.Peer != "" {
+		params.Peer = &t.Peer
+	}
+	return doHbFaultNodes(t.OptsGlobal, t.NodeSelector, func(ctx context.Context, c *client.T, nodename string) error {
+		resp, err := c.DeleteDaemonHeartbeatFaultWithResponse(ctx, nodename, &params)
+		if err != nil {
+			return fmt.Errorf("%s: %w", nodename, err)
+		}
+		switch resp.StatusCode() {
+		case http.StatusOK:
+			fmt.Printf("%s: %s\n", nodename, resp.JSON200.Detail)
+			return nil
+		case 400:
+			return fmt.Errorf("%s: %s", nodename, *resp.JSON400)
+		case 401:
+			return fmt.Errorf("%s: %s", nodename, *resp.JSON401)
+		case 403:
+			return fmt.Errorf("%s: %s", nodename, *resp.JSON403)
+		case 500:
+			return fmt.Errorf("%s: %s", nodename, *resp.JSON500)
+		default:
+			return fmt.Errorf("%s: unexpected status [%d]", nodename, resp.StatusCode())
+		}
+	})
+}
+
+// doHbFaultNodes calls <fn> for each node selected by <nodeSelector>,
+// defaulting to the local node.
+func doHbFaultNodes(opts OptsGlobal, nodeSelector string, fn func(context.Context, *client.T, string) error) error {
+	if opts.Local {
+		nodeSelector = hostname.Hostname()
+	}
+	if !clientcontext.IsSet() && nodeSelector == "" {
+		nodeSelector = hostname.Hostname()
+	}
+	if nodeSelector == "" {
+		return fmt.Errorf("--node must be specified")
+	}
+	c, err := client.New(client.WithURL(opts.Server))
+	if err != nil {
+		return err
+	}
+	nodenames, err := nodeselector.New(nodeSelector, nodeselector.WithClient(c)).Expand()
+	if errors.Is(err, nodeselector.ErrClusterNodeCacheEmpty) {
+		nodenames = []string{hostname.Hostname()}
+	} else if err != nil {
+		return err
+	}
+	var errs error
+	ctx := context.Background()
+	for _, nodename := range nodenames {
+		errs = errors.Join(errs, fn(ctx, c, nodename))
+	}
+	return errs
+}
@@ -30,7 +30,7 @@ func (t *CmdDaemonRelayStatus) Run() error {
 		return fmt.Errorf("unexpected get relay message status code %s", resp.Status())
 	}
 	output.Renderer{
-		DefaultOutput: "tab=RELAY:relay,USERNAME:username,CLUSTER_ID:cluster_id,CLUSTER_NAME:cluster_name,NODENAME:nodename,NODE_ADDR:node_addr,UPDATED_AT:updated_at,MSG_LEN:msg_len",
+		DefaultOutput: "tab=RELAY:relay,USERNAME:username,CLUSTER_ID:cluster_id,CLUSTER_NAME:cluster_name,NODENAME:nodename,NODE_ADDR:node_addr,UPDATED_AT:updated_at,AGE:age,REPLICATED_FROM:replicated_from,REPLICATION_LAG:replication_lag,MSG_LEN:msg_len",
 		Output:        t.Output,
 		Color:         t.Color,
 		Data:          *resp.JSON200,
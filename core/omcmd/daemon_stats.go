@@ -1,10 +1,16 @@
 package omcmd
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
+	"net/http"
 
-	"github.com/opensvc/om3/core/clusterdump"
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/render/tree"
+	"github.com/opensvc/om3/util/sizeconv"
 )
 
 type (
@@ -14,60 +20,97 @@ type (
 )
 
 func (t *CmdDaemonStats) Run() error {
-	/*
-		var (
-			err  error
-			b    []byte
-			data cluster.Stats
-			c    *client.T
-		)
-		c, err = client.New(client.WithURL(t.Server))
-		if err != nil {
-			return err
-		}
-		resp, err := c.GetDaemonStats(context.Background())
-		if err != nil {
-			return err
-		}
-		defer resp.Body.Close()
-		b, err := io.ReadAll(resp.Body)
-		if err != nil {
-			return err
+	cli, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	params := api.GetDaemonStatsParams{}
+	resp, err := cli.GetDaemonStatsWithResponse(context.Background(), &params)
+	if err != nil {
+		return err
+	} else if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected get daemon stats status code %s", resp.Status())
+	}
+	data := *resp.JSON200
+	output.Renderer{
+		DefaultOutput: "tab=NODE:node,GOROUTINES:goroutines,ALLOC:memory.alloc,HEAP_INUSE:memory.heap_inuse,SYS:memory.sys,GC:memory.gc,DROPPED:pubsub.dropped",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          data,
+		HumanRenderer: func() string {
+			return daemonStatsTree(data).Render()
+		},
+		Colorize: rawconfig.Colorize,
+	}.Print()
+	return nil
+}
+
+// daemonStatsTree returns a tree with a branch per node, detailing the
+// node statistics sections.
+func daemonStatsTree(data api.DaemonStats) *tree.Tree {
+	t := tree.New()
+	head := t.Head()
+	head.AddColumn().AddText("node").SetColor(rawconfig.Color.Bold)
+	head.AddColumn().AddText("")
+	for _, stats := range data.Nodes {
+		n := head.AddNode()
+		n.AddColumn().AddText(stats.Node).SetColor(rawconfig.Color.Primary)
+		n.AddColumn().AddText(fmt.Sprintf("goroutines %d", stats.Goroutines))
+
+		s := n.AddNode()
+		s.AddColumn().AddText("memory").SetColor(rawconfig.Color.Bold)
+		s.AddColumn().AddText(fmt.Sprintf("alloc %s heap_inuse %s sys %s gc %d",
+			sizeconv.BSizeCompact(float64(stats.Memory.Alloc)),
+			sizeconv.BSizeCompact(float64(stats.Memory.HeapInuse)),
+			sizeconv.BSizeCompact(float64(stats.Memory.Sys)),
+			stats.Memory.Gc))
+
+		s = n.AddNode()
+		s.AddColumn().AddText("subsystems").SetColor(rawconfig.Color.Bold)
+		s.AddColumn().AddText("goroutines")
+		for _, e := range stats.Subsystems {
+			c := s.AddNode()
+			c.AddColumn().AddText(e.Name)
+			c.AddColumn().AddText(fmt.Sprint(e.Goroutines))
 		}
-		data, err = parseDaemonStats(b)
-		if err != nil {
-			return err
+
+		s = n.AddNode()
+		s.AddColumn().AddText("pubsub").SetColor(rawconfig.Color.Bold)
+		s.AddColumn().AddText(fmt.Sprintf("dropped %d", stats.Pubsub.Dropped))
+		for _, e := range stats.Pubsub.Subscriptions {
+			c := s.AddNode()
+			c.AddColumn().AddText(e.Name)
+			c.AddColumn().AddText(fmt.Sprintf("queued %d/%d max %d dropped %d", e.Queued, e.QueueSize, e.QueuedMax, e.Dropped))
 		}
-		renderer := output.Renderer{
-			Format:   t.Format,
-			Color:    t.Color,
-			Data:     data,
-			Colorize: rawconfig.Colorize,
+
+		s = n.AddNode()
+		s.AddColumn().AddText("heartbeats").SetColor(rawconfig.Color.Bold)
+		s.AddColumn().AddText("")
+		for _, e := range stats.Heartbeats {
+			c := s.AddNode()
+			c.AddColumn().AddText(e.Name)
+			c.AddColumn().AddText(fmt.Sprintf("%s rx %s tx %s", e.Type,
+				sizeconv.BSizeCompact(float64(e.RxBytes)),
+				sizeconv.BSizeCompact(float64(e.TxBytes))))
 		}
-		renderer.Print()
-	*/
-	return fmt.Errorf("todo")
-}
 
-func parseDaemonStats(b []byte) (clusterdump.Stats, error) {
-	type (
-		nodeData struct {
-			Status int                         `json:"status"`
-			Data   clusterdump.NodeStatsBundle `json:"data"`
+		s = n.AddNode()
+		s.AddColumn().AddText("api").SetColor(rawconfig.Color.Bold)
+		s.AddColumn().AddText("")
+		for _, e := range stats.Api {
+			c := s.AddNode()
+			c.AddColumn().AddText(e.Method + " " + e.Code)
+			c.AddColumn().AddText(fmt.Sprint(e.Count))
 		}
-		responseType struct {
-			Status int                 `json:"status"`
-			Nodes  map[string]nodeData `json:"nodes"`
+
+		s = n.AddNode()
+		s.AddColumn().AddText("objects").SetColor(rawconfig.Color.Bold)
+		s.AddColumn().AddText("")
+		for _, e := range stats.Objects {
+			c := s.AddNode()
+			c.AddColumn().AddText(e.Path)
+			c.AddColumn().AddText(fmt.Sprintf("cpu %s mem %s", e.CpuTime, sizeconv.BSizeCompact(float64(e.Mem))))
 		}
-	)
-	var t responseType
-	ds := make(clusterdump.Stats)
-	err := json.Unmarshal(b, &t)
-	if err != nil {
-		return ds, err
-	}
-	for k, v := range t.Nodes {
-		ds[k] = v.Data
 	}
-	return ds, nil
+	return t
 }
@@ -24,7 +24,13 @@ type (
 	Factory struct {
 		NodeName    string
 		ClusterName string
-		Key         string
+
+		// Key is the key used to encrypt, and the first key tried to decrypt.
+		Key string
+
+		// AltKeys are the keys tried in order to decrypt a message Key can't
+		// decrypt. A cluster secret rotation sets the key rotated from or to.
+		AltKeys []string
 	}
 )
 
@@ -47,14 +53,24 @@ func (m *Factory) DecryptWithNode(data []byte) ([]byte, string, error) {
 		return nil, "", io.EOF
 	}
 	var b []byte
-	key := []byte(m.Key)
 	msg := &encryptedMessage{}
 	err := json.Unmarshal(data, msg)
 	if err != nil {
 		return nil, "", fmt.Errorf("analyse message unmarshal failure: %w", err)
 	}
 	// TODO: test nodename and clustername, plug blacklist
-	b, err = decode(msg.Data, msg.IV, key)
+	b, err = decode(msg.Data, msg.IV, []byte(m.Key))
+	for _, altKey := range m.AltKeys {
+		if err == nil {
+			break
+		}
+		if altKey == "" || altKey == m.Key {
+			continue
+		}
+		if altB, altErr := decode(msg.Data, msg.IV, []byte(altKey)); altErr == nil {
+			b, err = altB, nil
+		}
+	}
 	if err != nil {
 		return b, "", fmt.Errorf("analyse message decode failure: %w", err)
 	}
@@ -0,0 +1,30 @@
+package omcrypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecryptAltKeys(t *testing.T) {
+	oldKey := "0123456789abcdef0123456789abcdef"
+	newKey := "fedcba9876543210fedcba9876543210"
+	sender := &Factory{NodeName: "node1", ClusterName: "c1", Key: newKey}
+	b, err := sender.Encrypt([]byte("hello"))
+	require.NoError(t, err)
+
+	t.Run("message encrypted with an alternate key is decrypted", func(t *testing.T) {
+		receiver := &Factory{NodeName: "node2", ClusterName: "c1", Key: oldKey, AltKeys: []string{newKey}}
+		decoded, nodename, err := receiver.DecryptWithNode(b)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(decoded))
+		assert.Equal(t, "node1", nodename)
+	})
+
+	t.Run("message encrypted with an unknown key is refused", func(t *testing.T) {
+		receiver := &Factory{NodeName: "node2", ClusterName: "c1", Key: oldKey}
+		_, err := receiver.Decrypt(b)
+		assert.Error(t, err)
+	})
+}
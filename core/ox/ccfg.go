@@ -9,6 +9,7 @@ func init() {
 	cmdObjectPrint := newCmdObjectPrint(kind)
 	cmdObjectPrintConfig := newCmdObjectPrintConfig(kind)
 	cmdObjectSSH := newCmdObjectSSH(kind)
+	cmdClusterRotate := newCmdClusterRotate()
 	cmdObjectValidate := newCmdObjectValidate(kind)
 
 	root.AddCommand(
@@ -21,10 +22,13 @@ func init() {
 		cmdObjectSSH,
 		cmdObjectValidate,
 		newCmdClusterAbort(),
+		newCmdClusterAudit(),
 		newCmdClusterFreeze(),
 		newCmdClusterLogs(),
+		cmdClusterRotate,
 		newCmdClusterThaw(),
 		newCmdClusterUnfreeze(),
+		newCmdClusterUpgrade(),
 		newCmdObjectCreate(kind),
 		newCmdObjectEval(kind),
 		newCmdObjectGet(kind),
@@ -43,6 +47,9 @@ func init() {
 		cmdObjectPrintConfig,
 		newCmdObjectPrintStatus(kind),
 	)
+	cmdClusterRotate.AddCommand(
+		newCmdClusterRotateSecret(),
+	)
 	cmdObjectSSH.AddCommand(
 		newCmdClusterSSHTrust(),
 	)
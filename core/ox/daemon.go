@@ -15,6 +15,11 @@ var (
 		Short: "dns subsystem commands",
 	}
 
+	cmdDaemonHb = &cobra.Command{
+		Use:   "hb",
+		Short: "heartbeat subsystem commands",
+	}
+
 	cmdDaemonRelay = &cobra.Command{
 		Use:   "relay",
 		Short: "relay subsystem commands",
@@ -28,6 +33,7 @@ func init() {
 	cmdDaemon.AddCommand(
 		newCmdDaemonAuth(),
 		cmdDaemonDNS,
+		cmdDaemonHb,
 		cmdDaemonRelay,
 		newCmdDaemonRestart(),
 		newCmdDaemonShutdown(),
@@ -38,6 +44,12 @@ func init() {
 	cmdDaemonDNS.AddCommand(
 		newCmdDaemonDNSDump(),
 	)
+	cmdDaemonHb.AddCommand(
+		newCmdDaemonHbClear(),
+		newCmdDaemonHbDelay(),
+		newCmdDaemonHbDrop(),
+		newCmdDaemonHbPause(),
+	)
 	cmdDaemonRelay.AddCommand(
 		newCmdDaemonRelayStatus(),
 	)
@@ -56,6 +56,26 @@ func addFlagsTo(flagSet *pflag.FlagSet, p *commands.OptTo) {
 	addFlagDownTo(flagSet, &p.DownTo)
 }
 
+func addFlagAuditLimit(flagSet *pflag.FlagSet, p *int64) {
+	flagSet.Int64Var(p, "limit", 0, "Only show the most recent audit records, up to this number.")
+}
+
+func addFlagAuditNode(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "node", "", "Only show the audit records of the requests served by this node.")
+}
+
+func addFlagAuditPath(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "path", "", "Only show the audit records of the requests targeting this object.")
+}
+
+func addFlagAuditSince(flagSet *pflag.FlagSet, p *time.Duration) {
+	flagSet.DurationVar(p, "since", 0, "Only show the audit records more recent than this duration.")
+}
+
+func addFlagAuditUser(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "user", "", "Only show the audit records of the requests of this user.")
+}
+
 func addFlagComplianceAttach(flagSet *pflag.FlagSet, p *bool) {
 	flagSet.BoolVar(p, "attach", false, "Attach the modulesets selected for the compliance run.")
 }
@@ -141,6 +161,22 @@ func addFlagForce(flagSet *pflag.FlagSet, p *bool) {
 	flagSet.BoolVar(p, "force", false, "Allow dangerous operations.")
 }
 
+func addFlagHbFaultDelay(flagSet *pflag.FlagSet, p *time.Duration) {
+	flagSet.DurationVar(p, "delay", 0, "The delay of the heartbeat messages.")
+}
+
+func addFlagHbFaultDuration(flagSet *pflag.FlagSet, p *time.Duration) {
+	flagSet.DurationVar(p, "duration", 0, "The duration of the fault injection. The fault is cleared when it expires.")
+}
+
+func addFlagHbID(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "hb", "", "The heartbeat stream id (example: hb#1.rx).")
+}
+
+func addFlagHbPeer(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "peer", "", "The peer node the fault applies to. Defaults to all peers.")
+}
+
 func addFlagImpersonate(flagSet *pflag.FlagSet, p *string) {
 	flagSet.StringVar(p, "impersonate", "", "The name of a peer node to impersonate when evaluating keywords.")
 }
@@ -303,6 +339,22 @@ func addFlagTarget(flagSet *pflag.FlagSet, p *[]string) {
 	flagSet.StringSliceVar(p, "target", []string{}, "The peers to sync to. The value can be either nodes or drpnodes. If not set, all nodes and drpnodes are synchronized.")
 }
 
+func addFlagTokenGrants(flagSet *pflag.FlagSet, p *[]string) {
+	flagSet.StringSliceVar(p, "grant", nil, "Limit the token grants to this subset of the caller grants.")
+}
+
+func addFlagTokenID(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "id", "", "The token id.")
+}
+
+func addFlagTokenNamespace(flagSet *pflag.FlagSet, p *[]string) {
+	flagSet.StringSliceVar(p, "namespace", nil, "Limit the token grants to these namespaces.")
+}
+
+func addFlagTokenUser(flagSet *pflag.FlagSet, p *string) {
+	flagSet.StringVar(p, "user", "", "The token user.")
+}
+
 func addFlagUpdateDelete(flagSet *pflag.FlagSet, p *[]string) {
 	flagSet.StringSliceVar(p, "delete", []string{}, "Configuration section to delete.")
 }
@@ -0,0 +1,68 @@
+package oxcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/nodeaction"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type CmdClusterUpgrade struct {
+	OptsGlobal
+	OptsAsync
+	Binary string
+	Resume bool
+}
+
+func (t *CmdClusterUpgrade) Run() error {
+	c, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	body := api.PostClusterActionRollingRestart{}
+	if t.Binary != "" {
+		body.Binary = &t.Binary
+	}
+	if t.Resume {
+		body.Resume = &t.Resume
+	}
+	return nodeaction.New(
+		nodeaction.WithAsyncTarget("restarted"),
+		nodeaction.WithAsyncTime(t.Time),
+		nodeaction.WithAsyncWait(t.Wait),
+		nodeaction.WithAsyncWatch(t.Watch),
+		nodeaction.WithFormat(t.Output),
+		nodeaction.WithColor(t.Color),
+		nodeaction.WithLocal(false),
+		nodeaction.WithAsyncFunc(func(ctx context.Context) error {
+			if resp, err := c.PostClusterActionRollingRestartWithResponse(ctx, body); err != nil {
+				return err
+			} else {
+				switch resp.StatusCode() {
+				case http.StatusOK:
+					fmt.Println(resp.JSON200.OrchestrationID)
+				case 400:
+					return fmt.Errorf("%s", resp.JSON400)
+				case 401:
+					return fmt.Errorf("%s", resp.JSON401)
+				case 403:
+					return fmt.Errorf("%s", resp.JSON403)
+				case 404:
+					return fmt.Errorf("%s", resp.JSON404)
+				case 408:
+					return fmt.Errorf("%s", resp.JSON408)
+				case 409:
+					return fmt.Errorf("%s", resp.JSON409)
+				case 500:
+					return fmt.Errorf("%s", resp.JSON500)
+				default:
+					return fmt.Errorf("unexpected status [%d]", resp.StatusCode())
+				}
+			}
+			return nil
+		}),
+	).Do()
+}
@@ -14,9 +14,12 @@ import (
 type (
 	CmdDaemonAuth struct {
 		OptsGlobal
-		Roles    []string
-		Duration time.Duration
-		Out      []string
+		Roles     []string
+		Grants    []string
+		Namespace []string
+		Duration  time.Duration
+		Refresh   bool
+		Out       []string
 	}
 )
 
@@ -46,6 +49,15 @@ func (t *CmdDaemonAuth) Run() error {
 		// Don't set params.Role when --role isn't used
 		params.Role = &roles
 	}
+	if len(t.Grants) > 0 {
+		params.Grant = &t.Grants
+	}
+	if len(t.Namespace) > 0 {
+		params.Namespace = &t.Namespace
+	}
+	if t.Refresh {
+		params.Refresh = &t.Refresh
+	}
 	resp, err := c.PostAuthTokenWithResponse(context.Background(), &params)
 	if err != nil {
 		return fmt.Errorf("%w: %w: %w", ErrCmdDaemonAuth, ErrClientRequest, err)
@@ -65,6 +77,20 @@ func (t *CmdDaemonAuth) Run() error {
 			if _, err := fmt.Printf("%s\n", resp.JSON200.ExpiredAt); err != nil {
 				return fmt.Errorf("%w: %w: expired_at: %w", ErrCmdDaemonAuth, ErrPrint, err)
 			}
+		case "token_id":
+			if resp.JSON200.TokenId == nil {
+				continue
+			}
+			if _, err := fmt.Printf("%s\n", *resp.JSON200.TokenId); err != nil {
+				return fmt.Errorf("%w: %w: token_id: %w", ErrCmdDaemonAuth, ErrPrint, err)
+			}
+		case "refresh_token":
+			if resp.JSON200.RefreshToken == nil {
+				continue
+			}
+			if _, err := fmt.Printf("%s\n", *resp.JSON200.RefreshToken); err != nil {
+				return fmt.Errorf("%w: %w: refresh_token: %w", ErrCmdDaemonAuth, ErrPrint, err)
+			}
 		}
 	}
 	return nil
@@ -78,6 +104,8 @@ func (t *CmdDaemonAuth) checkParams() error {
 		switch s {
 		case "token":
 		case "expired_at":
+		case "token_id":
+		case "refresh_token":
 		default:
 			return fmt.Errorf("%w: out contains unexpected value: %s", ErrFlagInvalid, s)
 		}
@@ -0,0 +1,48 @@
+package oxcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/output"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdDaemonAuthLs struct {
+		OptsGlobal
+		ID   string
+		User string
+	}
+)
+
+func (t *CmdDaemonAuthLs) Run() error {
+	cli, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	params := api.GetAuthTokensParams{}
+	if t.ID != "" {
+		params.Id = &t.ID
+	}
+	if t.User != "" {
+		params.User = &t.User
+	}
+	resp, err := cli.GetAuthTokensWithResponse(context.Background(), &params)
+	if err != nil {
+		return err
+	} else if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected get auth tokens status code %s", resp.Status())
+	}
+	output.Renderer{
+		DefaultOutput: "tab=ID:id,USER:user,USE:use,ISSUER:issuer,ISSUED_AT:issued_at,EXPIRED_AT:expired_at,GRANTS:grants",
+		Output:        t.Output,
+		Color:         t.Color,
+		Data:          *resp.JSON200,
+		Colorize:      rawconfig.Colorize,
+	}.Print()
+	return nil
+}
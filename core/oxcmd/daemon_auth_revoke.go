@@ -0,0 +1,42 @@
+package oxcmd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	CmdDaemonAuthRevoke struct {
+		OptsGlobal
+		ID   string
+		User string
+	}
+)
+
+func (t *CmdDaemonAuthRevoke) Run() error {
+	if t.ID == "" && t.User == "" {
+		return fmt.Errorf("%w: --id or --user is required", ErrFlagInvalid)
+	}
+	cli, err := client.New(client.WithURL(t.Server))
+	if err != nil {
+		return err
+	}
+	params := api.DeleteAuthTokensParams{}
+	if t.ID != "" {
+		params.Id = &t.ID
+	}
+	if t.User != "" {
+		params.User = &t.User
+	}
+	resp, err := cli.DeleteAuthTokensWithResponse(context.Background(), &params)
+	if err != nil {
+		return err
+	} else if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("unexpected delete auth tokens status code %s", resp.Status())
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package oxcmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"encoding/json"
 
 	"github.com/opensvc/om3/core/client"
+	clientapi "github.com/opensvc/om3/core/client/api"
 	"github.com/opensvc/om3/core/clientcontext"
 	"github.com/opensvc/om3/core/event"
 	"github.com/opensvc/om3/core/naming"
@@ -239,9 +241,13 @@ func (t *CmdNodeEvents) nodeEventLoop(ctx context.Context, nodename string) {
 	var (
 		retries    = 0
 		maxRetries = 600
+
+		// lastEventID is the id of the last event read, used to resume
+		// the stream after a reconnect.
+		lastEventID *uint64
 	)
 
-	evReader, err := t.getEvReader(nodename)
+	evReader, err := t.getEvReader(nodename, lastEventID)
 	if err != nil {
 		_, _ = fmt.Fprintf(os.Stderr, "getEvReader %s: %s", nodename, err)
 	}
@@ -274,6 +280,7 @@ func (t *CmdNodeEvents) nodeEventLoop(ctx context.Context, nodename string) {
 			if err != nil {
 				break
 			}
+			lastEventID = &ev.ID
 			t.evC <- ev
 		}
 		for { // get reader retry loop
@@ -298,7 +305,12 @@ func (t *CmdNodeEvents) nodeEventLoop(ctx context.Context, nodename string) {
 				return
 			default:
 			}
-			evReader, err = t.getEvReader(nodename)
+			evReader, err = t.getEvReader(nodename, lastEventID)
+			if errors.Is(err, clientapi.ErrEventsEvicted) {
+				_, _ = fmt.Fprintf(os.Stderr, "events lost for node %s: %s\n", nodename, err)
+				lastEventID = nil
+				evReader, err = t.getEvReader(nodename, lastEventID)
+			}
 			if err == nil {
 				_, _ = fmt.Fprintf(os.Stderr, "retry %d of %d ok for %s\n", retries, maxRetries, nodename)
 				retries = 0
@@ -309,15 +321,20 @@ func (t *CmdNodeEvents) nodeEventLoop(ctx context.Context, nodename string) {
 	}
 }
 
-func (t *CmdNodeEvents) getEvReader(nodename string) (event.ReadCloser, error) {
-	return t.cli.NewGetEvents().
+// getEvReader returns an event reader for the events of nodename. The stream
+// resumes after the event lastEventID if not nil.
+func (t *CmdNodeEvents) getEvReader(nodename string, lastEventID *uint64) (event.ReadCloser, error) {
+	getEvents := t.cli.NewGetEvents().
 		SetRelatives(false).
 		SetLimit(t.Limit).
 		SetFilters(t.Filters).
 		SetDuration(t.Duration).
 		SetNodename(nodename).
-		SetSelector(t.ObjectSelector).
-		GetReader()
+		SetSelector(t.ObjectSelector)
+	if lastEventID != nil {
+		getEvents.SetLastEventID(*lastEventID)
+	}
+	return getEvents.GetReader()
 }
 
 func (t *CmdNodeEvents) doEvent(e event.Event) {
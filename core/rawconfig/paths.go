@@ -56,6 +56,10 @@ func ClusterConfigFile() string {
 	return filepath.Join(Paths.Etc, "cluster.conf")
 }
 
+func ArbitratorConfigFile() string {
+	return filepath.Join(Paths.Etc, "arbitrator.conf")
+}
+
 func CreateMandatoryDirectories() error {
 	mandatoryDirs := []string{
 		NodeVarDir(),
@@ -115,6 +115,8 @@ type (
 		Encap                   bool
 		Restart                 int
 		RestartDelay            *time.Duration
+		RestartDelayMax         *time.Duration
+		RestartWindow           *time.Duration
 		Tags                    *set.Set
 		BlockingPreStart        string
 		BlockingPreStop         string
@@ -16,23 +16,45 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /audit)
+	GetAudit(ctx echo.Context, params GetAuditParams) error
+
+	// (POST /audit)
+	PostAudit(ctx echo.Context) error
+
 	// (GET /auth/info)
 	GetAuthInfo(ctx echo.Context) error
 
+	// (POST /auth/refresh)
+	PostAuthRefresh(ctx echo.Context, params PostAuthRefreshParams) error
+
 	// (POST /auth/token)
 	PostAuthToken(ctx echo.Context, params PostAuthTokenParams) error
 
+	// (DELETE /auth/tokens)
+	DeleteAuthTokens(ctx echo.Context, params DeleteAuthTokensParams) error
+
+	// (GET /auth/tokens)
+	GetAuthTokens(ctx echo.Context, params GetAuthTokensParams) error
+
+	// (POST /auth/tokens/replica)
+	PostAuthTokensReplica(ctx echo.Context) error
+
 	// (POST /cluster/action/abort)
 	PostClusterActionAbort(ctx echo.Context) error
 
 	// (POST /cluster/action/freeze)
 	PostClusterActionFreeze(ctx echo.Context) error
 
+	// (POST /cluster/action/rolling-restart)
+	PostClusterActionRollingRestart(ctx echo.Context) error
+
 	// (POST /cluster/action/unfreeze)
 	PostClusterActionUnfreeze(ctx echo.Context) error
 
@@ -42,6 +64,9 @@ type ServerInterface interface {
 	// (PUT /cluster/config/file)
 	PutClusterConfigFile(ctx echo.Context) error
 
+	// (GET /cluster/stonith)
+	GetClusterStonith(ctx echo.Context, params GetClusterStonithParams) error
+
 	// (POST /daemon/action/join)
 	PostDaemonJoin(ctx echo.Context, params PostDaemonJoinParams) error
 
@@ -51,6 +76,9 @@ type ServerInterface interface {
 	// (POST /daemon/log/control)
 	PostDaemonLogsControl(ctx echo.Context) error
 
+	// (GET /daemon/stats)
+	GetDaemonStats(ctx echo.Context, params GetDaemonStatsParams) error
+
 	// (GET /daemon/status)
 	GetDaemonStatus(ctx echo.Context, params GetDaemonStatusParams) error
 
@@ -69,6 +97,9 @@ type ServerInterface interface {
 	// (POST /instance/path/{namespace}/{kind}/{name}/status)
 	PostInstanceStatus(ctx echo.Context, namespace InPathNamespace, kind InPathKind, name InPathName) error
 
+	// (GET /job/{id})
+	GetJob(ctx echo.Context, id openapi_types.UUID, params GetJobParams) error
+
 	// (GET /network)
 	GetNetworks(ctx echo.Context, params GetNetworksParams) error
 
@@ -144,6 +175,12 @@ type ServerInterface interface {
 	// (GET /node/name/{nodename}/daemon/event)
 	GetDaemonEvents(ctx echo.Context, nodename InPathNodeName, params GetDaemonEventsParams) error
 
+	// (DELETE /node/name/{nodename}/daemon/hb/fault)
+	DeleteDaemonHeartbeatFault(ctx echo.Context, nodename InPathNodeName, params DeleteDaemonHeartbeatFaultParams) error
+
+	// (POST /node/name/{nodename}/daemon/hb/fault)
+	PostDaemonHeartbeatFault(ctx echo.Context, nodename InPathNodeName) error
+
 	// (GET /node/name/{nodename}/drbd/allocation)
 	GetNodeDRBDAllocation(ctx echo.Context, nodename InPathNodeName) error
 
@@ -381,6 +418,9 @@ type ServerInterface interface {
 	// (POST /relay/message)
 	PostRelayMessage(ctx echo.Context) error
 
+	// (POST /relay/replica)
+	PostRelayReplica(ctx echo.Context) error
+
 	// (GET /relay/status)
 	GetRelayStatus(ctx echo.Context, params GetRelayStatusParams) error
 
@@ -396,6 +436,69 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAudit converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAudit(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "node" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "node", ctx.QueryParams(), &params.Node)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter node: %s", err))
+	}
+
+	// ------------- Optional query parameter "user" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user", ctx.QueryParams(), &params.User)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user: %s", err))
+	}
+
+	// ------------- Optional query parameter "path" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "path", ctx.QueryParams(), &params.Path)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter path: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAudit(ctx, params)
+	return err
+}
+
+// PostAudit converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAudit(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAudit(ctx)
+	return err
+}
+
 // GetAuthInfo converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAuthInfo(ctx echo.Context) error {
 	var err error
@@ -405,6 +508,26 @@ func (w *ServerInterfaceWrapper) GetAuthInfo(ctx echo.Context) error {
 	return err
 }
 
+// PostAuthRefresh converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthRefresh(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAuthRefreshParams
+	// ------------- Optional query parameter "duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "duration", ctx.QueryParams(), &params.Duration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthRefresh(ctx, params)
+	return err
+}
+
 // PostAuthToken converts echo context to params.
 func (w *ServerInterfaceWrapper) PostAuthToken(ctx echo.Context) error {
 	var err error
@@ -429,11 +552,110 @@ func (w *ServerInterfaceWrapper) PostAuthToken(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter duration: %s", err))
 	}
 
+	// ------------- Optional query parameter "grant" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "grant", ctx.QueryParams(), &params.Grant)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter grant: %s", err))
+	}
+
+	// ------------- Optional query parameter "namespace" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "namespace", ctx.QueryParams(), &params.Namespace)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter namespace: %s", err))
+	}
+
+	// ------------- Optional query parameter "refresh" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "refresh", ctx.QueryParams(), &params.Refresh)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh: %s", err))
+	}
+
+	// ------------- Optional query parameter "refresh_duration" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "refresh_duration", ctx.QueryParams(), &params.RefreshDuration)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter refresh_duration: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostAuthToken(ctx, params)
 	return err
 }
 
+// DeleteAuthTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAuthTokens(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAuthTokensParams
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", ctx.QueryParams(), &params.Id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Optional query parameter "user" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user", ctx.QueryParams(), &params.User)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAuthTokens(ctx, params)
+	return err
+}
+
+// GetAuthTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthTokens(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthTokensParams
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", ctx.QueryParams(), &params.Id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Optional query parameter "user" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user", ctx.QueryParams(), &params.User)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthTokens(ctx, params)
+	return err
+}
+
+// PostAuthTokensReplica converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthTokensReplica(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthTokensReplica(ctx)
+	return err
+}
+
 // PostClusterActionAbort converts echo context to params.
 func (w *ServerInterfaceWrapper) PostClusterActionAbort(ctx echo.Context) error {
 	var err error
@@ -460,6 +682,19 @@ func (w *ServerInterfaceWrapper) PostClusterActionFreeze(ctx echo.Context) error
 	return err
 }
 
+// PostClusterActionRollingRestart converts echo context to params.
+func (w *ServerInterfaceWrapper) PostClusterActionRollingRestart(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostClusterActionRollingRestart(ctx)
+	return err
+}
+
 // PostClusterActionUnfreeze converts echo context to params.
 func (w *ServerInterfaceWrapper) PostClusterActionUnfreeze(ctx echo.Context) error {
 	var err error
@@ -499,6 +734,28 @@ func (w *ServerInterfaceWrapper) PutClusterConfigFile(ctx echo.Context) error {
 	return err
 }
 
+// GetClusterStonith converts echo context to params.
+func (w *ServerInterfaceWrapper) GetClusterStonith(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClusterStonithParams
+	// ------------- Optional query parameter "peer" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "peer", ctx.QueryParams(), &params.Peer)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter peer: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetClusterStonith(ctx, params)
+	return err
+}
+
 // PostDaemonJoin converts echo context to params.
 func (w *ServerInterfaceWrapper) PostDaemonJoin(ctx echo.Context) error {
 	var err error
@@ -556,6 +813,28 @@ func (w *ServerInterfaceWrapper) PostDaemonLogsControl(ctx echo.Context) error {
 	return err
 }
 
+// GetDaemonStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDaemonStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDaemonStatsParams
+	// ------------- Optional query parameter "local" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "local", ctx.QueryParams(), &params.Local)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter local: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDaemonStats(ctx, params)
+	return err
+}
+
 // GetDaemonStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) GetDaemonStatus(ctx echo.Context) error {
 	var err error
@@ -712,6 +991,35 @@ func (w *ServerInterfaceWrapper) PostInstanceStatus(ctx echo.Context) error {
 	return err
 }
 
+// GetJob converts echo context to params.
+func (w *ServerInterfaceWrapper) GetJob(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetJobParams
+	// ------------- Optional query parameter "local" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "local", ctx.QueryParams(), &params.Local)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter local: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetJob(ctx, id, params)
+	return err
+}
+
 // GetNetworks converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNetworks(ctx echo.Context) error {
 	var err error
@@ -1379,11 +1687,91 @@ func (w *ServerInterfaceWrapper) GetDaemonEvents(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter selector: %s", err))
 	}
 
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID InHeaderLastEventID
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetDaemonEvents(ctx, nodename, params)
 	return err
 }
 
+// DeleteDaemonHeartbeatFault converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDaemonHeartbeatFault(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDaemonHeartbeatFaultParams
+	// ------------- Required query parameter "hb" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "hb", ctx.QueryParams(), &params.Hb)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hb: %s", err))
+	}
+
+	// ------------- Optional query parameter "peer" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "peer", ctx.QueryParams(), &params.Peer)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter peer: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDaemonHeartbeatFault(ctx, nodename, params)
+	return err
+}
+
+// PostDaemonHeartbeatFault converts echo context to params.
+func (w *ServerInterfaceWrapper) PostDaemonHeartbeatFault(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "nodename" -------------
+	var nodename InPathNodeName
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodename", ctx.Param("nodename"), &nodename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nodename: %s", err))
+	}
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostDaemonHeartbeatFault(ctx, nodename)
+	return err
+}
+
 // GetNodeDRBDAllocation converts echo context to params.
 func (w *ServerInterfaceWrapper) GetNodeDRBDAllocation(ctx echo.Context) error {
 	var err error
@@ -4802,6 +5190,19 @@ func (w *ServerInterfaceWrapper) PostRelayMessage(ctx echo.Context) error {
 	return err
 }
 
+// PostRelayReplica converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRelayReplica(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BasicAuthScopes, []string{})
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRelayReplica(ctx)
+	return err
+}
+
 // GetRelayStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) GetRelayStatus(ctx echo.Context) error {
 	var err error
@@ -4908,22 +5309,32 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/audit", wrapper.GetAudit)
+	router.POST(baseURL+"/audit", wrapper.PostAudit)
 	router.GET(baseURL+"/auth/info", wrapper.GetAuthInfo)
+	router.POST(baseURL+"/auth/refresh", wrapper.PostAuthRefresh)
 	router.POST(baseURL+"/auth/token", wrapper.PostAuthToken)
+	router.DELETE(baseURL+"/auth/tokens", wrapper.DeleteAuthTokens)
+	router.GET(baseURL+"/auth/tokens", wrapper.GetAuthTokens)
+	router.POST(baseURL+"/auth/tokens/replica", wrapper.PostAuthTokensReplica)
 	router.POST(baseURL+"/cluster/action/abort", wrapper.PostClusterActionAbort)
 	router.POST(baseURL+"/cluster/action/freeze", wrapper.PostClusterActionFreeze)
+	router.POST(baseURL+"/cluster/action/rolling-restart", wrapper.PostClusterActionRollingRestart)
 	router.POST(baseURL+"/cluster/action/unfreeze", wrapper.PostClusterActionUnfreeze)
 	router.GET(baseURL+"/cluster/config/file", wrapper.GetClusterConfigFile)
 	router.PUT(baseURL+"/cluster/config/file", wrapper.PutClusterConfigFile)
+	router.GET(baseURL+"/cluster/stonith", wrapper.GetClusterStonith)
 	router.POST(baseURL+"/daemon/action/join", wrapper.PostDaemonJoin)
 	router.POST(baseURL+"/daemon/action/leave", wrapper.PostDaemonLeave)
 	router.POST(baseURL+"/daemon/log/control", wrapper.PostDaemonLogsControl)
+	router.GET(baseURL+"/daemon/stats", wrapper.GetDaemonStats)
 	router.GET(baseURL+"/daemon/status", wrapper.GetDaemonStatus)
 	router.POST(baseURL+"/daemon/sub/action", wrapper.PostDaemonSubAction)
 	router.GET(baseURL+"/dns/dump", wrapper.GetDNSDump)
 	router.GET(baseURL+"/instance", wrapper.GetInstances)
 	router.POST(baseURL+"/instance/path/:namespace/:kind/:name/progress", wrapper.PostInstanceProgress)
 	router.POST(baseURL+"/instance/path/:namespace/:kind/:name/status", wrapper.PostInstanceStatus)
+	router.GET(baseURL+"/job/:id", wrapper.GetJob)
 	router.GET(baseURL+"/network", wrapper.GetNetworks)
 	router.GET(baseURL+"/network/ip", wrapper.GetNetworkIP)
 	router.GET(baseURL+"/node", wrapper.GetNodes)
@@ -4949,6 +5360,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/node/name/:nodename/daemon/action/shutdown", wrapper.PostDaemonShutdown)
 	router.POST(baseURL+"/node/name/:nodename/daemon/action/stop", wrapper.PostDaemonStop)
 	router.GET(baseURL+"/node/name/:nodename/daemon/event", wrapper.GetDaemonEvents)
+	router.DELETE(baseURL+"/node/name/:nodename/daemon/hb/fault", wrapper.DeleteDaemonHeartbeatFault)
+	router.POST(baseURL+"/node/name/:nodename/daemon/hb/fault", wrapper.PostDaemonHeartbeatFault)
 	router.GET(baseURL+"/node/name/:nodename/drbd/allocation", wrapper.GetNodeDRBDAllocation)
 	router.GET(baseURL+"/node/name/:nodename/drbd/config", wrapper.GetNodeDRBDConfig)
 	router.POST(baseURL+"/node/name/:nodename/drbd/config", wrapper.PostNodeDRBDConfig)
@@ -5028,6 +5441,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/public/openapi", wrapper.GetSwagger)
 	router.GET(baseURL+"/relay/message", wrapper.GetRelayMessage)
 	router.POST(baseURL+"/relay/message", wrapper.PostRelayMessage)
+	router.POST(baseURL+"/relay/replica", wrapper.PostRelayReplica)
 	router.GET(baseURL+"/relay/status", wrapper.GetRelayStatus)
 	router.GET(baseURL+"/resource", wrapper.GetResources)
 	router.GET(baseURL+"/whoami", wrapper.Getwhoami)
@@ -5037,218 +5451,301 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9e3MbN7LvV0FxT1U251KSZTt7Et9KnXKsOKu1Y2sle0/ViXxV4EyTxGoGmAAYyUxK",
-	"3/0WXvMEhjMkJcvS/BNHHDwajV83Go1G489JxNKMUaBSTF78OckwxylI4Pqvo9Ofjl4xOieLdzgF9UsM",
-	"IuIkk4TRyYuJXAKa50mCMiyXiM2R/oEkgIhAMcR5BDGac5bqD1S1MZ0QVfP3HPhqMp3o315M7CcOv+eE",
-	"Qzx5IXkO04mIlpBi1a9cZaqckJzQxeTmZjo5yjk2ZDSpSvFnFLuv/v4qn8s+4DNOs0R9/k5Mpp4uf77C",
-	"SY6lhxHgvvi7q3xuDWnGWAKY2g6AytckkcDbfSRESMVjUIUUl1Upf3/Fx7I3IiEV7UZNSQSfMw5CEEZf",
-	"oN8uCY0//TZN8AySHxXl8Ok/zxWrSga9n/0bInkmsczFxyzGEuKpwsCPc8barCt+wJzjlR7pcZoBF4x6",
-	"uUnKjxo4ln2EUYQFoiwO8blScdKNnrckJdLH45RIpHmFIpZTGehIl/OD53A6mTOeYqnoofJvz0t+ECph",
-	"AdwQwBbrJjphi11NM0aeia5McH229/f3a7MtSPzjD/h7ePIc/rY3iw6f7j1/Bn/b+/5ZfLg3h8Mn8XfP",
-	"/vYM8H/1mnk1cJYk7NoDRv27nvKELURo1Kb2GlF6yxZvCQUPLzhkjEskl0Qgmqcz4IrZGRYSJfo/bIGA",
-	"Sk5ABGef6m8tAqoTrDSmyHAE73XHOGlTQl2RDq3ovneB+R2Lu3phMSABCUSSVQGwH+rVyJdPL9KnU/zH",
-	"j5AfetXjCZbLdvdMq4ohBChF0rkYlATFs8PpNcz+M0hPmC0b07URHSIs5pYQ1bpAkiEBNNb4R3PGO0gR",
-	"fQS/0nhdpK+iwykSV9HTXkJ7CglevUpyIYEfH/kNgch8RiRGhU3hbAKRMKk+MKr/5Kq5wNBsMxckHmIQ",
-	"TCef9xZsz7ZRUupoVyJCgzYMtV+3Itw1MtCO0eSdQsp8K+HxHOkWUKG0AAm96ioCNTXC/Aj8SvFeoCgh",
-	"hv59dDxHc5wIQIwjyhTWZaClShOQziCOITath2SBG4LXKGE9to8CuJ/1dnQI09hy9/ccNIaW2AyLMybR",
-	"gmOqCcemWApC4AWUhqXIICJzAjHKBXBDOMowl0TbDIQKqeracRa9fCPKQqFx5o74HpPYIeNuphgiNEry",
-	"GBBxgBIZowJQjCUWIIPsNrjzyPsa4a0LhqVTUUzisG7kIFjOo0HLhqsT0JBz8ZfDKcm8CvKUJdDBPJwR",
-	"xFkSWiXtJw9r/oPDfPJi8peDco9zYIqJA9WnV9Wd2SGHueOYEqCn8rkLMoSqdeENobGmmZYLjG1HmeGd",
-	"uqRreLrdshu3ffN0s4HKKts01km4YWe99FnLJQjphYftjsXQNYw+2rfsLGERTpYs2OM/1ZzqrS9Pix6b",
-	"K5X9vEYJusa42at6W+KNrWi4mSNIQBpp8W5s9ec+lsEHuyPXEoYERFpXSoZME1N0TeSS5RLNOI4uQYr6",
-	"nkBicfmXnF5jKiHuZUO4ARCBZwmcsiSZ4egyOBBT7IK7cv3YU92i996J1xlzBBzmwIFGMEUiYplZoCJG",
-	"r8AunJewumY8RhxfI71l2i854CHqNeNRkKI54xH0HF1j1zxkC+yZfLUv0AhQy1Jly329BFrsuekCYTfe",
-	"fXQGUv9UK25x4lj7o17TOcicU4Ew+gnH6NSsuQg4Z3y/S+bewCo0tEtYdUp3fYgv0eWVkIzr2XK+p65u",
-	"RXe/awWqT4fdq7MmokaT4nqYruueZFm0SoaU2XYFdUkGerW/iSC/BRwb94V3j2y+9sP1qTPJzkgcarAw",
-	"2y6E3hqU7Rbuljwn7RE0DSDXk7Fm1BahQkdH941OOzupt3oGMjiHxuQbMIksA+O6dCYZxAgLdJ4/efIs",
-	"urzW/8Jv5k9CY/hsfvlkfmGZ+dP8pVWX+cGoe8QylJBLQD+i//Mj2vuxDRTA8sc5z4kUQ6Byls/UQEM8",
-	"MF/X2Ry6pQ94EWpG4kXPNliwCdavhY9UdMxpTnvOanUJNjuwYhE2grrrRfhGqU+z4dDkPH3yRP0TMSqB",
-	"6vnBWZaQSAPs4N/CWCz9LM4TzmYJpKaX+jjfv1G0PH3yvM2Cdwy9sr3fTCfP74aeyopkej28i14/UpzL",
-	"JePkD4hNt8/uotvXjM9IHAM1fT6/iz7fMYles5zacX5/F306E+MDSYHldmJ/uIue1TYhIZHu8ru7QfAx",
-	"lcApTtCZcdr8rOwq0/+dgEp1SyJAHym+wiRRlrrWj7aqavklnxHJsWTcHBPp00Wuli9JjPYRxe9dVNja",
-	"N9NJzhO/Vi5Nwt90oalr+lOhAY0fVLXyMpfLYzpnbXpSkEtmzS2nsIHmqWqWZUC1BTDDgkRqvf/uyQ+q",
-	"I2NGVHoKm3q2jVa/xmN3YT61WrmGJLm4pOyaXuScrGdAo/y00vynZlk34hCfPrBLoG2C4XOmWrjAsmZ+",
-	"xVjCniQBu9c11U19pWlXx0fcK5zhGUmIXLWpc77G7o50qe6mjyWk7eZjLNdKToW8m6lx5FSw1OjBB50U",
-	"1nfyjsXwqyrXHJp1HOk2pobe9QMVvT1nDfI9QC9LvCVCtlm4QTeim5G6n0/TNXNuGWO697LEnBt4RFQH",
-	"Qawl2VQ3EROqPX2U1q+Smk2tJAwx/Sq9Lyjvp0ttNadSG+yxg5y6E0BLSqc2rQ+5lLpWiXeWFaHv74tx",
-	"h0qUy0i7BEtTIpUN3MaaiJaYLiAO7ECrDCjL+oZ69O7sFCLGvRocC78z3mmL1oeAlppOpEx8J8qOoF56",
-	"zRaeWsJMox2q4Ojd2f8yCr1ls2SFR/qPTn86epkkLCrCc7ZfPczaOGSjb87+UkIZ97MzY1wGju6r/NTF",
-	"XEPT+vpEAkApoqbCq0cxlNlK+h1TVSLCE4chZfQti8w5RWMhrJx5tjjKWS5dfMQaFlRd665WmJgTn4mT",
-	"1YybIK9DDNUNh8zIqFTaPXSfEUrV3lqQV3jbUpW2saItL9lEXHoQAFeZDZQIwLwHrFkMiX9aYUEY7b/K",
-	"nuryPjEW5A+oC10omimomqaTK6BxTQADANfi7Dhj+y5qu/EWWs0NMsT0zQ03PWUeS6No9baMNU2cbapr",
-	"WP1ntiDZp6KJuNzCNCuJCbBqR+bYESdXPmtsSwvfNLsFSAxZvrHrL+LLIqUY3YAJLTniQ4v+ug1eKiSF",
-	"ubYj0OgYWkdsK6xB+z6LIogIhJGLMxBCKZdyZSYUa7duaxp/4SzPPLzwrXE+9d0Pv1onBkGsadgcw2YI",
-	"nsko2/1SAC4o6A+wkmgPfPXHLdBboSfErx1B9++Yx9eYw6ANRhXhvu+FDm19Cpoh/XYadq2uElBuOGy3",
-	"tq2uwW6O4YJdnmmptf6lkFwloj/eaqR78Oy+bwHpOmEd7NsRsI9PXsYxB+Gx3nH5oTVH8wQvYsg4RFh6",
-	"N/B15fo6wYujsrg+rpNzb8spjgK/G5N9Q5FQzU6LIbUGYAmy3XTIRsGvzYWjZLlneuvtfynxqFHRH7x1",
-	"4j0CUhTYQkIatPl4eFTtZQcyQoXENIJNnY+uful9TBklkvG+FX+1xXt7E13FijsxOKqX+mT7ZRRB5nXT",
-	"2XOUi+GOnnogR5XllTa7GB5y1eAs86qCaAnRpcjTwEeSxNycdPQP0Y155nNPTidArwKaET5fpPiz37Vl",
-	"vhLa8VVivgDpL2Bxc4EjZ1X0H0mnz4nxaAlCchu+1oWt95Wi2lTh7oZif1qC9k2W4AhSoPIiYwmJVmsP",
-	"Ql35E1Ncew+Z3wGTcbhoM9BTjDBuj6/aM+Biqd0KSUwo8kkNn91uHdNAqQxa8NeRjcMY2nIJhT1CJqZn",
-	"/eGuKVYhk2UsYYu1U/LBlbuZTnJzAXGAQ7mhKJSkV+S6IsVGNI0cVqSuImJ1eWoJjxcQ06pLtSoUU2dU",
-	"O7x7sFrBThUobkJL1leYWeNRSxdafVpMolGL+6/ccVDxdY+kzmtuZGuyIHKZz/Yjlh6wDKi4ig5Y+uwg",
-	"YhwOXEPmvqf9YwuDpmjOsxZXW9/UnCnWwS3OVKuEDDA2quT7DBr7fRt7pkZYBwv7WTOmT9tKFyN+xdmm",
-	"Oqw64eH27cS2Tz78K1DzlDEwvkIydEudAyyNrIbVVrEEWrUXCZvh5AI+Z35yGiUumN5fi/VtXQxXhtMJ",
-	"ERdLfJEU8bxtO4SIdZ8zDvoCWewvoe9bdI23WmCjQdR17AV8higf2kapi0tbtMv2fF8tb3x7jSbERWwP",
-	"Vts8qRg1rUndmQVQserbJkDN6O5pZJvdgV+89JeNZm/rNbwuUR1SERKtKsgbItGAbxisHgSFEFHjvuOp",
-	"h4OdwG5IXt0eqDVSGhSFXuprBzgE7dYQCJ3m6njC/jGBUWgjNufsD6BD1WBNi8Uwx3kiJy/0JdpmCKQr",
-	"iogwt1jJ3OQUsLdqlzpVhUQzAIrsXKA417dt8DldAuZyBliimF1TRRKK2BVwiNFshTBKsbKmqWIVyoAT",
-	"Fu+fU30zR1+BbX1FQGMxrV7rFUuWJzGaAcqpjWuZnlNMY1SQfk2SRBUQIBVZepz7OtWHR4NjIS+ExHyw",
-	"Uq1cpOw3qYoPOBlQIePsiihhMhO3Jqi1KLpLPVsS09blOaWKF8P2WhFOwL873H6/o2XMCk9VVNqzXJm+",
-	"cl5aaqfK/7oScmN3A9poJ2J5uxsF9OZfZ5Jx+Nlm+OhrQFeqrXzzVfve0mqzlb2zuSbuZ6ovm601T82N",
-	"NNOozzi1xLyBbQIw640ENw6NvrZ3hLb7bQ/Az6XpBoZ/6dXoEQVVDYq0twJV5X6j2IbzXsSZG2FH2LcD",
-	"0le71P9UBQrTVWtYpqB3BKb9zXfsVQJ9wKm0v+me3baxzZa9QsaAGarQHp6abYSvSlWYebsSuQob2/Ga",
-	"9l5vfIH9SxcRF0UZ/07HXl7ckch27tXLzhqETesD8bKhwWRxFU2mkyum18q5XsRA/ZILZQ1TYX6L1D+f",
-	"AocU9keKU0IX+2/MRGy4jJlGyuxWXSEutsCGAS7vQF4z7gle1Pe3B7rh5xwChkzwoICW/fdW1x1RiLmA",
-	"PuGn9dBpR4OqjheacWogtrUOzW+Zd3ziEf1sbXznSWP8nQewric3X13iFDwN4eu3/6ee0zWdy8WKnMvY",
-	"ZUKWLDGdvBmmbkuWevBVfNxC3Tbo8ijcei/bO0hbc9c3yrFbOja5JNBnwjaZro7J2sFUrZmoXU2TFadN",
-	"TuRV3cGn8TqoYuhJvKrUdQqvvt/DE/gKg9orTeDku+L5uFhwHMGF8X/Ut8JleldPhDyOV8Mr/ZsRulmH",
-	"IkuIDJ8FNy9A6pPG4Cgb9Pspa/S5ZputdPjWh316IXC5KP3Xa3WGUV+aL/27dqMtobBVdHYa82m/sF17",
-	"iMFbVWVdZEI7QaZOw2BJqKZk0GRcL4GbTLSWVu1E0/kaMddJAwld6HR0+z4AZP78j6YB37AlQ0IyjheA",
-	"NPlIYGr6682Ks5fvdDpOX1KOKtzspNROpA29fVBTTPaOcLPxVtPdnmwtBq7VL3XJ1hEwYH1zJPtWzwLg",
-	"QWuhnWG2gJiqqMHtRWnhMai3oH+uN9HMeNVtZIQdDHo0WxgCBWsDE79DE2DQMbPPcRRsOHR8PPSEeJND",
-	"t9s/lL3bA9VHep75JQ8n+3vz9YKx9Vlibb0IniEubO6T1rTgjPh/L5KVbHwQ1Mp34jPEVT0s/ejd4MRy",
-	"YcJLQuR6/B1rAjlKG61FekrohT44ukghDQSOFkXENc56eFzMRJlpqU9Cwar68dRCx+TVSWn1Wz/Jt0Pq",
-	"g85tz5lq4BTOCu6/mKkKzVU/YHWJHZldJu3CutvqfoCZ/ItdXGU8Bg5xirP99+Z/f9Xz049qgmnEEkgx",
-	"PSgb0lSnWiA2U6zuVoIu7FuWDUv8xyoDoxNuNUzbyIXfI28PPHvH2Klp2SpAe/iR/Q5isIsmihW8Vwtn",
-	"0kWVh4O4u6KzN482uN2Y681ipy8KsFyYF096xBz0Cy/oEy5tQVyFbDMkuow68MVCNzBQi46uhyW4+Oha",
-	"VHRr9N0mTaEeNt+qVtSLZ99SaX3TLatpYptNa0lE/91YhXAPis3XLTZ7VZKCbNvRhq/CwBaxAw8lw80X",
-	"j4L0VwXv69q5CE+bUKavKBhWLLH25hhznUsvjGrbrH/mkPscwr692xC3cGsv12RRs30fs05wdIkXHhc8",
-	"5tEyvPYlCcRtQxr7LYTGCZyr/7JpzajK+x9UC11nl6KWB2vtycx0cgVc9HINO4eKLT81PCiOcaoDN2R0",
-	"MHRz/eVmxCOF1ba/1KXSCg39tUuVcI/g2c9bqK8aVWHO7Sic4wRLIxwNSsOSUdjOm0iCfkMrkI3IrL89",
-	"sG0aqVSpAzo4zG2ArLjknwy5/MIgtiMbgjDHDC+AZbTcMPCxWXfVpwNPCGR5AOb4jONYx6NjujBZnVJ2",
-	"Zf6nkfWkZP62cZRdetv833rr1t3hUz0EJ28rXVFMvhecrvUd6InGnqphVGhHoP85MVcRFYa4o08/oWcs",
-	"j+kkYThG+MrllBRI7+P1X8bLGDGu/804YG2jL8ncb7I0dm/Bh84Kytx+oMzgK0mqw5gpo3uVvw6UKOY0",
-	"hrm/Y7tJbEQAuNSjzZlda8VtE0LVYxO4VIwcBvwBO8x1EVY92rhiSZ5CeK/ZGaqyNDCpcb/RZO84LTWx",
-	"A3WsgoJP+zGWbCPwBSE+eXdtb7+vUU39S7Oq+7Zif1wSccF4tsQ0dMEtdAE/5HjpjcVWlk4dpGnj0CrX",
-	"t0sK1yDBMGY4HixDA6gwX7fERpW0AEIq/ewCJ0K65JcL8YpRyX0qMIErSOprBjFOaUdZDLN8oQ05/fM1",
-	"5voVYZ2rfjqZY4nNpFGdUV2vCWupN712k32Wz15G/uSzbSuEg1utyn9Z5l0KRD7zxHGYDKCVpyMTop8V",
-	"K3PIF+91LGd/Odznn3s9neK1OTQFocE7Z+4JZwt/siUiLvSLhzjpc4C6YQxY+EA1HB3m6oSGpgzqMq2u",
-	"e7ujPbtF2uENhlDmLDaj2CxVb52EDiebGpZxBRmsnloctgY1d6+HebJXr2317Jp494IxCEkoXp/gJSXU",
-	"KsXDNSCtNhkasH5/8lfzfmcwk2+P8/vaA6+uWtDCScUiePWgXy7G2qO0tf5M65W2vEO3L2l4pkHaA6Xm",
-	"e0/LPMV0T5nFeJYAgs9Zgg1z3XOnEZLM3DhlUZRz/WCdDVs7p5npsXaZsx7XkAceJPr7hw8n7gppxGJA",
-	"f/3t9PWr/3r67PDTFJ3ZF4r+9i1aAAWuL7XOVqZPxsmCUPeO7JzxAHXIR1zVyiQyAR9PxJJxOW2yRuRp",
-	"ivmq0ThS7e4jdCzR2d/ff3x7dE7fvf+AzG7TPB1bIUyyMJlTBJ8jyOQ5VUPKcp4xAcI80B7hhPxhZuWv",
-	"sL/Yn6JcELpQVZXOvgJkX2I5pxQWTBJd9v8iAYA8bH22//xb75S1RE2aIxbhTqwNzwLYU4BbBS5zDNwr",
-	"mCdevbahm7VwZJn6clgVafXDU7XXdN4f9cOzjgz9znZwT/K6F2dN513BZo4NW/iLHCMrNtgXiSmsDmWA",
-	"JVllgM9ctd+3MVZrhPlM1WofO/Bf1A9im6/06ieKpsid8CHGUfGwceVosOkpsLf9U/JZ7x+1f0DyHHwW",
-	"oU2fPijJ+8JlD944/XuPy6zrs7Z3Z2Av3/4wqdgN0b5JuH9L+gWOYz50wZ/aN7a93o1NAgArr553w9y9",
-	"7V2SPh1ibzQSfRT9BufKxGD49eAtTtdFAoFIl1uZM9F8LeYeT6dmTY8p7XyJpzG3Q56AqIPCszZUimyx",
-	"PLQo9KwQzZ6292a4BB2b3uJqp1LseZPLk4Gp322uZkqRm45RhSL3iLiwL2jHwZRddhwdJdTCGc9W/u+8",
-	"3LB6M1iqjxexE9AeV6VarzGVQ2jQWyNuWnHh1Lvtm1+kwczd5BlxjR7TObv9JBY88HpgzfZec0ehsuqb",
-	"m7wmxUXYpm4OcYgqaDDHq3TKMltpnSaRXrXT6Gt3emfzDUehuboI3uaYutBQW2xGqoRsMClr5n4X875u",
-	"znc832/ZYjCNb9kieLTeKhN2xHtAUJjlfbzqZYWuAe4q5ebGmQd8yqqT4NAdq8oKNmAhd47atuHXDLjr",
-	"t+rsNr9egFjPbVwhB1nAHFJMaD1EIrSZLMtOi466ZqjYyIdu9AwK+K8cQPSOzW4eTViXQPiWQMNIayt4",
-	"Y7q0/RJLQqW5TVk4I8iCMg4C4SQxzggkOaZCX7lA5uxHeHPyAY3MPZt6F4TGJMISVDdYNvoSaIlpnBR+",
-	"W6QbEXmifbn6Ro6weQINXTGybSxXGfArIhhHWl8EEgUSe++lTtMlrPbMXdIMEy6MAyYmdIEUiLg+O1D/",
-	"bybYvoIfsSSBSJ4rXsDeNYkB4RnLpXEsuzFV6SgnKHH3ZD23GhcDFHPD4q+PSkKSmMm0p4Bkjoh0qRcl",
-	"J4sFcISRbcBOJnJ5HM9pdV4okyjPAlytZlFszHbJCee3x4sFh4WeUEIlQ+9NCL12hQGOEZujl1eYJKVv",
-	"zFTcP6f6JXGBCEWux7L1mNFvJBKSZQiHgBogf8CdiZBSWLflqGxWWjmRLHfMtODkGq+EToyZTRFcAUV4",
-	"LvU86bENG9nQV8xNenYPlBqZB0y5OtJ1OichyIJCjCTzniPjxcDgon4pY5w+c0qnONU3cmakqpSUWt7I",
-	"VnrI8sDd7uCKcwzLHTuO0BM59RXVcWfr+3u8MLiVgmdGe5fRiub+yizB0WVChHQ/LPRZtI5NMhldJ9PJ",
-	"v5n+lAA2EY2M6fH+nmMpgXsNdpexwRO2SyTBPRwOtoXjoryGg7tA1qPmB1O4ZfoWDRbt+VbEVveedcl+",
-	"cvkElkxIJJRadxkuENA4Y4TKfYOb3hkOMLpmPIn1GpFT8rteaCrtIRIDlWROgO/rd3xdTAb5ne4/ffLk",
-	"+d7hE4WK/XyWU5m/eHL4Av42i5/jZ7PvvnsejthoifEqK9IlFH3rs8h6ryISpG8KheA7UU2Wb77X9GGn",
-	"uWHy9valQqR9xPTfHHqH4tGNzXJb7Ef9BPdg844Oy1yzm/CpgzU74MgaRux2/B8KhdiQW/27k9xG+p17",
-	"oaF+2Ds81BrKrlv7gl+9iOHqKT3ct/Tum1HsHw7XV/iONFa0hDhPoCsyr3csv95a8nxYzoSi0pwE4hVM",
-	"Duw8ikCIcCkKn4d3bll1YTc2jIdc66ZYw2r2GJ8Vdva8tlBUcf7dKheb7PExoz5035j8A+iCwxYLlxvO",
-	"bTlJd/EsUHWYA/RjlTk+DWy/b6OCa4T5dHC1j+2dpKW3xHWQq61EzK5pGSBcvZGhtgbxbIV0MfO/urDX",
-	"gtZ7h9CJWIbVFhiSQCRl/WVbW7R3gvpqz7vx49VfRus9n1VCPJD5UEkRUIZtzzFJmHmu13uppnJj3k1b",
-	"pco8gc/e+fgofI+33+kr2YqEY72s+gLgcB4M0cHmckoos87Q3XWFpHBaV5yCyHAgvI7j64uCrF5rcFnD",
-	"DajaR5BbG2tiPd0eFVK0+qW2Co6A/mqxINkzoerbFhq3JCbAqp2YuzqWP8o5kSulwVP7CgUWJHppQa8J",
-	"0lpQ/VoaK0spdS6YGWAO3JU2f712Rs4//ueDNSVME/prs42bijPYRodOrNIzfmZk8j4VF+Anz/YPn+4/",
-	"Ne5OoDpZ1+TZ/pP9J5NKFs0DJbYHrmFrzKt5MLH78eTF5BeQinCbI8nlRNe1nz55YmM/pE0ShrMsISZk",
-	"/+DfwpigZrbWpvxyfeih1lXn+zfq15upJVeySxP+lDFf2vZXHLAEnVeUg8w5RRj94+z9O/Q/MEMfVF1t",
-	"nkcJUWyLMEW5AISV2a6IYNxGIet3hWLgiFBEpEBzliTsmtAF4ubOhNg/p+f0gz4R0D9AjDhLwGQyhXQG",
-	"cQyxafkbrTW+QVGCSYrIHKVYRkvVmKIlF/ycuiI2576JXa7PxQkTejL0KMxLVDgFCVxMXvzm529Z5OBU",
-	"0Ta5mTYZluLPSPMUuXiSKUrxZ5LmqclPiZ4+X2on5eTF5PccdJ57u7xUIlDKeS43OodPUs8259Mt48iw",
-	"JwCk6eS56c7XSkHWgSqkyx72KXtoyj7rU/aZKvtdHxq+MzR816ddVaiqqjQgKkrqt09q4quK6LdPaiKM",
-	"j/s3s35/0kJmg+oOzDbnAM+c2eUVt5fqszkXM+8TIVvfnjGZU5paghItN6dgXPI2L7A71TG5GZFJuWjh",
-	"x6g+ztPXnkNiYWMobWZuTfItosyX9OVe4+35k+d9yj43Zb/vU/Z7U/aHPmV/GIb5LXBsweeH8pwDmJhu",
-	"P5Zf6+8abGaJ0LUL4J3TEw5XerFNEmSD4h1yBYoh0vtzMdUXdqwWdOUEkvgSlJ2vW9KJByvvypnkXWgG",
-	"c8bV4rWqvUtX4F3JgnaqrYSEdHpOK3Req2VH3xQClGKKF2rxKSHeT3QMC0bZqcnOQ5WHnK6TiI+2RIdM",
-	"nIKQCrNBeVDA1+uCyzSw2kRAFK1VEUkAXzn7ySTEcAfPIcExwmIlBw0QnCkSDOUUSwlUWXRuw46IOKdA",
-	"dVgtwgtMaC8RczwdhexhC5mJiT9wXm/vUcmp2aFUJctUywtTqQWoX8DhyTinXhtP8gAssUiC3BOSA07r",
-	"mFr7oJUXQyaZCJhULJ/3EizkXspiMicQ7/F59OzZsx8opizo3M/0Wb5q7f+dn8d/Pr/ZU/88df98MP+8",
-	"qP3z1/PzffV/h9Mfbr797//97//wE/t1Wfs7AeF0kuWenfxJHsCN3rz+xOLVHULmpgXYHgbqU2egfm0G",
-	"9b3WVybcztkEOtwmvNuLY4QRhevidZWq6rLhVqU7BGdEF2x5SjhKcyHVuq69HhDrit9wxuQ3ain+RpHx",
-	"jXGnFJUzziIQ+lK47UmVcm2agK4VjZacUZaX1fQtfMc8VUooE754/7nWhjHvl1iYt6azfJYQsYR4XzVF",
-	"hP1OhHm+A2I9uh/P8ydPnkU4IxfqT/2XHTKzbiN3Cb6D/qn2Q6lfS0+T6W5OEglcTM/pHvoHI/TMHCFO",
-	"g31PcRxDbD+VP6O/amPJTV4xSl1ax4hWjbtvXXfHJnS1ozs1jL3K52CX11ggnOhnlxCudVf0poMmN+wL",
-	"U6RvtZsEBCjOlQKyD4bXetN5Zb4NGGsm8c0/TNhZw8XWzvHg5ADHbRYGnGY26L70QZvs7z4HGoXrC1s8",
-	"JfQt0IWS5qe9fWpfv/9rCzWno6EpTrx6zsQTBhXdKSyIMPsJXbLQEJIhk12xAWCUQjrTe5dBeu6tany9",
-	"oqvTsKGmqzdyx6qu1nk/Xad5s17Zmenwqbu6mrPl/IpO97Ve0+lRhNSP7s4Gn3u0m+5inXrr7GCX+u2t",
-	"jaddq+DcRrva/g4UG4th71qyvSJV6RfQbzvXLQlbqG1fkeTNqpbgHFRywvU1xIf5Afx9eUxyAdLdu0jY",
-	"ArlLbPWpvPFPwjqj/cmjWnUMF+u4KC82hM5YbbY9F3U/7GTvnQtFeO8uCigS11Q6AxNiVta5zXO52vg6",
-	"PFEPbeLz2UEZjblOH5TJFm9bG5Q9eebCndlRpxFEPitzMopRLWyPDioO4jzNgn7BozzNalvro3dn6A9G",
-	"iyRoAcfg0bszVfU2XctH787+l1F4qEJMhZ2jIoSwQ2sfV17aGaayT7BcDtHW71gMd6Op3Zh08JRnknVo",
-	"v7vraJzV0/JSJo3t/cdHttO0WKlD5yDDcnnwZxEpeHPw5yWh8Y356eYgq2aXDa4NrVy0Q7FGqEJbYST0",
-	"gZup8obQuH9p1YGF5u0sXS1GeND5yiSlVKqzAKkDp72Mqjbw80QH45qdqm5M7VPt/fDqJeSYxHo/p+9Z",
-	"Qrzfd/EbHS/l5qivOJRW8nph2NBSfgii0GCBRwgU+5ANOC+uA4+wHQhbCvKa8cuu9f+dKSLW+VGqF9JL",
-	"z9AMR5dAY+Q6CjhVbLq6Aht3GU5pBxiyBR6AweeYX5vzA5L1mPbjk4c+78cnj2fmbdKl4JzbA52Bnpk7",
-	"M9uLV/oDJrt2C4/muiiyXpXTfhAlgHnHjQL1WRjXu0B/rcSuTXUsGMTfIkLbscza3tz3+uDVbOlmJ6Pv",
-	"ZPh8rbuwUn3V+1YFTnRdWXlgTFfr0cGfLrHuTfB6QBvsJ9AMzN/IaGcxVOzqMXDyAQRO9sRYzDGhfTF2",
-	"pAuPGBsxNghjPe+GuEXev6yXKCzuUWwHwz4Oh3+qfcOpCzg5I/HtG5pWm0cRZPK+g/c+gSzLxfIAC5u0",
-	"LhR5NOcglsY2V9tEF2TpcoLov3QjKCYiYlfAV2Er00zVSS6WL4VJB/fIEflIUBYTcbktyFQbwzB2pHod",
-	"IfY4IJYVb6NvgbHMPNc+DGbmvfERZ48EZ5eLL4Oyy8WIsYePMRFhetB8hLwbbIWrr1oNRThawv45fVVc",
-	"dEWqbQrcpAQxebrLbOGRvuptsrdQ/SsoaFaSRHNirsPqFrHtRjWVCxPIbG6fIsaRTSqM5oBlzkGgGVZl",
-	"7H1x8x6jdPdh6cLeg7U+ykCkcImUswiXwyIgRrl4BHKxEhyyzuQfr4ySLZWvuRtW1FynZc+KLu4MT68Z",
-	"j8aN9UPD6oBMBn09OJVr+qMPZ4TaTctEWHuhv2ob2FtQ9jLsg7AQ7EHbTs2C2wR9yfR1QQ0j4A3gi4Sp",
-	"XQetRarW29aSP1/hJMeyV9njNAMuGNXFb/UsR0fZuSyxI6R6Qap3TpRK0IpLiIKO58i15y5b6vulLMIm",
-	"R5yOuJqimCl1+nnVpbqqeTDuUnGNCVgeLvbD2VduA3Jj7pZHlrulp4a1mtWrYH8BqexBsOspwi7nbC2K",
-	"raZ2kdLY+9169Je7PF18YygWA6oMsR9slTszI+xwRsN0CMZN4oPwlv8IEpCABEQ2jV9OBThnlXSgF4NR",
-	"XwRw6rIfDRV3hnwzqiHA/6iGPaTCmS5+q3sxlqZEjn6HPmiv562pvNsYOqPQBaqX2PQWX9vKlVRcGu46",
-	"fzyN0RUrH7AUynRWZnVk7tI5B4DNTQMub4p2M1Cmn1nS72CynNeSb5r7dkKfx63QNdE5nOU5lXylT+ls",
-	"us8yAahNaGIfWVWj2O/MYXJaPH94K8b7GIQdvMDeA6himUv9vkwQqWfLXOonaIrssmFM6oSt1DwqWiLb",
-	"JGVuIbKGynpC2Aw4YfG0jkrJV+fUi0gskGCMqn/lEggvL5W6RMx2lJagb8Q5dWmA1M/d+D1zLBoK4COX",
-	"dr//jcQ7cbGZYZ2QUa1vIC+SZR2y4gH+Rlp8ax2uAC49opJTSRKbQ7mof7HgOIILI3VKKOBzRjjEa+RC",
-	"seI+u5JHnG+Ac53gLbgpVVsfoAbP1mzRFUQg0Yku8vOVTUhz27b3EIX7lqRE9nNoA5WvdcK720rYJOGz",
-	"NIz3+n+6MK6pGzekfTHOZ/EBThJm9Emn70WrcT6L7ekdSgllHNE8nelzQBqjjHFZyZRqmi3P6qwdH3LH",
-	"HJ3+dPSyJOVeK9I6qTtB2v3YtCk8tA7QGldKQEZLNOcsRdgoPmxw0XZCoDnHizSc98lN+50dxpWd3Q1I",
-	"xhO21imD3060IbC9AaUKa5sxSbrCAL88uG4np1B9bDYCxwcze6l7TKSyE+Wo1j2xdpE0xqAtHNJ6+vP9",
-	"XuQ0iaMp1QsbPZNF9cnKdyc++bvOJ3XLWf/MU61j1r8hWf/QARJXkU7jU/xwpVM6V36I5ov6DwIaVXLB",
-	"dyAYzp00Y6zjkOAnZsNmbJaw4vltrwHgwGFCRlXdhyZaG8bo9q82qLR5enxAhQ94MaQ0uxtdMkYYD1QY",
-	"u5P+WB8Srz0a31ADmNqjDrj1OP1Rknax9LZW2tZavNuld0AqkQ2E7w4zi4zCNwrfF13G9H0Y0Xg9oc77",
-	"E1dkU3kqGni0InVkLgadsiSZ4ejyFi9TvtVR6qO5Peqph6an1gTlnRUheQ0Nha6JXCKMOAjgV+aKSx+l",
-	"dXq2k8i3UWWNGmjUQA9EA/WKH9ud/tlBjNaofkb1M6qfB6B+Bt1K2GCTtqtI/1HhjApnVDgPQeHkHT6h",
-	"09zrDUISi8te2iZ/vM4gHQjF0yE1eN+LKKNCGhXSQ1VI/a676atiG9pAG98WeyiqadQco+Z4iJpjQ9dx",
-	"L50x7prGXdOoakZVU1E1qkY8W21yWEUosrVRGkyh6tFAZ7bLURGNimhURKMi8jz1HU6031RCpm5P3bPF",
-	"I+FjqNwoUV+TRG1y/NtPih7xSe+4/o7a4gFqi4HvJWygNe70+YRx9R3l6QvLU49Q9Y9loc2lKnv04epj",
-	"0Pm4hj9qnRMlgHnHo1zqM8IUAeeMo7+eT0zo1RyTBOLzCZozjuAzTrMEvnXJjwsq3Z3+zofh3Ozrrh5J",
-	"moUR1fcu1cHA10TseutNhsTS4lWRHk+MrH1dpBCQ3T338FUnIxkfPHmASsHKk1MJxZ9GIRR/GnVQFoZa",
-	"4R2pAr1cFZrALYw1kHBIsCRXsKea0skxG3PXtdKdqfYfrByPr8g8sldkukS3QxoTFs5meQb8CvTzrwlb",
-	"iHCayrdscRdHMm/Zon9qXVWYJQm77ln4LaH9XuBQVItbTtSr6enOLfeA88UZ6Pa9JZeL5YF7PPOA0Dnb",
-	"9mH55uFk8TKnalypxb4X6nKxPLV1jxVdo9v0/rlNH6dbop+Ebbs0uNm4o+XhnoH/LlarL70Ija6SW3CV",
-	"9BPO1pK3zlVSW8aQNE9Iz72nFt3i/JDXtNtcnKp8GwXrbpYwxfs47+dLdGW3kY0z198oF73lwvHs/svE",
-	"EP/AfZefjNCwdYfFpcnULhlSBfVrblGSKyPevDLV8WjFiWp598nbvy5X0j15w2Z7/bfmYZqdKbxRw9wb",
-	"/4sQy4NLWIl1oBFiibJ8lpAIqeLmyK0PZs7+/kY1f/uQ0bufLMGkAZaezuwRERVESJ4bn5p9C7/OsA/q",
-	"q1EjDVSweeVxQm/0Qe5QoRv5wkvHQ57FlZCQHsREXAZF+18Ers1rZqpUSIB1Q0emxD1+pIWIy1HlD4HG",
-	"grM8W48NU6wTHL/YIvcXHZrCER5D4LHEPL7GHNYjxJUU3Sj5u2vwPgPFETliZQhWSIbjmIMQO1Enxycv",
-	"bWv3GSkFlSNUhkAlw9ElXvTQKq5gJ1ROikL3FyiWxhEmw2Aio2UfkKhiayBiitxngMhoOcJjEDy4mnG5",
-	"6oEQV7IbJGWpe4wTS+QIlSFQEZgeEEokwZLx9Xgpi3YC5uzlu+NKyXvsDn35TnVWEDuCZyh4XLhxN24k",
-	"5guQYi1q1GR8DYAZcTIEJ7mAHrpFlVqDkI/inj+GrAgcsdHEhgkcCCJAMUwfq5pywt3as6esgeOT96bw",
-	"YDgoMLzXXePkdsFgKBzhUInJrwGiuXYEpthEmW8yzXcxvTYG/kGG1YbmrBZlJK4iF2IUm0RwHQ+zmgJa",
-	"uq+XLAEkriLEOBIs1cfsRIoiOi+QBOvsKrLNbLoSDI8TGhrlfQd35ceokKEXgXrDGGg3in+muwCxaWXE",
-	"8IjhnWG4FvC5fmG9O+zdtzhLM/5jCemDXrl3dnl50DU0PGP1jN9t9Wf4b68m6eKPF4o8WoKQhkH/zCG/",
-	"73lmht0M/r5P2e+/ulvEty1DMSQgob8QHZnyoxSNUjRKUSFF7SyQ3VL0equcjqMUjVL05TJaDBKMBbkC",
-	"naq/t2j84mqMwjEKx30Wjg2kwZvctFscTrbNUzrKwygPX8likeV8McCIOtHFR7EYxeJhi4XnUfBuwdjy",
-	"le97ljBvYHBegBdaMlSHhEM8eSF5DjejcI423GBpHCiLZ1+JJI5yMMrBQDmoP5S0Tgw2f/xolIJRCu6t",
-	"FFwTe0GmpxyY8qNlVrBiNMxGUdyJKPre4uoWxm3f1hoXplEavhIfQuBhrXXykY3e51FEHrqImIds1kcx",
-	"mkdo7rckrC/98xVOcix7lT1OM+CCUV389sMkLYPHKyxfJJRlt69AYboy2SyviVwijGLIEraCuEzqit4y",
-	"dqkfUTPPAbTasc87ls9FoTnhQup3pRofllggysrnA2p5ZNe+MlVF3zZv04wvRo0vRn1t+mG61hb8quRi",
-	"fIFpfIFpC1HIfZKQj4IwCsJjEoTBNqO1Fb0m4y8gEeMI7LYDYXQJq2vGY3f5PmhI7q+z1X4B+bXvxuwl",
-	"xTeGJWJAlSH7OFvlzrZzdjhjQoIvLpl5pmzvjnvy+jqP6kz9IKYopwKkfaxNOlEVG8hq04D8aCh5GPJq",
-	"2DZEXD8qvg6pcKaLjxeX76uAXV4JyWppeQNr1Zt/nemCD2alEre8eBh+/UwlJ6ATnjxKLPfcsbj8nA3l",
-	"q37+iuB3WyEHig1tPK2PN/ja9i0P4RTnVtTzAVDJV8bucRed66JilvKarPys6zwYfT1aEbeheXst+o8A",
-	"Sbd2+PB1OYXur4Wwxr3/oJF6B17Qh2VK3EsEd3rlR/yO+L3P+B1usjaeAey2MLZ51O/rD84rmeB8zeOr",
-	"tXeK2V29iF4mZS6CeERntM4uHkQfnzd/tM+b38VL5grTntfMu3G97du+49Pk49Pka7CfMZZ02RcnjCUe",
-	"m6I+C/rJYWzSEGM0w9El0BgpAwYvAOkuVPeTF5PflUk7mU5U6ckL88+0413gW326h7FkHa6+Yt2n2V5O",
-	"8sEVS/IU1s31v3SpBzzjZoCPZN71K9AHLAOKM9I19WfXeLHQr5xsxXw7mTaD//3mb8EvzSTLMQ4JXh2k",
-	"IET9PcQWw05VwV9tuaHLs678zr5X02e51RVemYdJjo961/gogNM7sDsrrHiYMqVhscaD2kDEbd2aXsdt",
-	"RSDC5iZEjCUWIO0lDKRHgZaAuZwBlpOeV63X+XuePKothYNCqS2ExDIXnTGPVqEItxPQFQXKBcRotnJ7",
-	"4YzRmNCFnrv9c/pBX2tZEHqQYSF0lKSuIBmag4yWetfMUxN3hbl5GkKoRVk/AO2mWXcT2GZoMJ0Z+jdS",
-	"YqK3LjqFlMm70ERmOA94ga8j0Oz5u5cq+yjIlo9WrZ9otaQNKX9K4rt5E8uxIISKBcjSGWUCGqcoZZRI",
-	"xk38o5GRx6XoLLQM0q6XDKedNqQtccvv3B3HQKUazg6EezB3bm5ubv5/AAAA///1B9smkwYCAA==",
+	"H4sIAAAAAAAC/+y9e3PcNrI4+lVQs7+q7J47lvzaPRvfSv3KG+WhxLF1JHtP1WZ8VRgSM4OIAzAAKHmS",
+	"8ne/1XiQIAlwyJmRLMv8J7GGeDQa3Y1Gox9/ThK+zjkjTMnJiz8nORZ4TRQR+q+T83+dfMvZgi5f4zWB",
+	"X1IiE0FzRTmbvJioFUGLIstQjtUK8QXSP9CMICpRStIiISlaCL7WHxiMMZ1Q6Pl7QcRmMp3o315M7CdB",
+	"fi+oIOnkhRIFmU5ksiJrDPOqTQ7tpBKULScfP04nJ4XABowmVGv8AaXua3g+73M1B/mA13kGn/8uJ9PA",
+	"lN9d46zAKoAI4r6Ep/M+t5Y05zwjmNkJCFPf00wR0Z4jo1IBjgk0QgvTKjxf+bGajSqylu1BTUtEPuSC",
+	"SEk5e4F+vaIsff/rNMNzkn0DkJP3/zUDVFUIejP/jSTqQmFVyHd5ihVJp0AD3yw4b6Ou/AELgTd6pafr",
+	"nAjJWRCbtPqoCceij3KGsESMpzE8ex0n3dTziq6pCuF4TRXSuEIJL5iKTKTbhYnnyXSy4GKNFcDD1D+e",
+	"V/igTJElEQYAvty20RlfHmqbMQpstLfB9d0+Ojqq7bak6Tdf43+Sx8/JPx7NkydPHz1/Rv7x6J/P0ieP",
+	"FuTJ4/Tvz/7xjOD/7rXzsHCeZfwmQIz6d73lGV/K2KpN7y2s9IovX1FGArgQJOdCIbWiErFiPScCkJ1j",
+	"qVCm/8OXiDAlKJHR3WdEhgDwNxgkpsxxQt7oiXHWhoS5Jh1S0X3vIubXPO2ahacESZKRRHGfAI5is/K0",
+	"PmFFCOzpFP/xDSmeBMXjGVar9vRci4ohAIAg6TwMKoDS+ZPpDZn/VxSeOFp2hmsnOGSczS0gMLpEiiNJ",
+	"WKrpHy246ABF9mF8b/A6S18nT6ZIXidPezHtOcnw5tuskIqI05OwIpCYz4imqNQpnE4gM67gA2f6TwHD",
+	"RZZmh7mk6RCFYDr58GjJH9kxKkgd7MAiLKrDMPt1L8DdIAP1GA3eOVnz0El4ukB6BFQKLYKkPnUBQA2N",
+	"ND8ScQ24lyjJqIH/CJ0u0AJnkiAuEONA6yoykjcEWc9JmpLUjB7jBWEA3iKE9dreSSLCqLerQ5ilFru/",
+	"F0TT0AqbZQnOFVoKzDTg2DRbEynxklSKpcxJQheUpKiQRBjAUY6FolpnoEwq6MsX9Vm+klWj2DoLB3yP",
+	"TezgcbdTHFGWZEVKEHUEJXPOJEEpVlgSFUW3obsAv29h3jpjWDgBYprGZaMgkhciGXRsuD4RCbmQf3ky",
+	"pXlQQJ7zjHQgD+cUCZ7FTkn7KYCa/yPIYvJi8pfj6o5zbJrJY5gzKOou7JLj2HFIicDjfe4iGcp+JDgl",
+	"4hWWSuv9MblKS8rV6olR/wVJCL0m6VSfGEoQvLaqMqxyxvJinlG5IinCCy2U1ay8C630vBXAAMEjDcKj",
+	"05Ma1KUiW3RospTBAfczZalGPqtOSjs+3Cc6hWLXPulxq2ncPTQwzQ6ytxrTqFnxgZ0a1kcpUUSqyTQ+",
+	"HU9J1zL6HCPVZBlPcLbi0Rn/B4hT3+HFupyxeeTaz1ukuRtMcBYdSXDWc5gTkhFFZGykVH/uo+K8taYF",
+	"LSqQJAn8DmxhhpiiG6pWvFBoLnByRZSsX24Ulld/KdgNZoqkvZQhtwAq8Twj5zzL5ji5ii7ENLsUrl0/",
+	"9Pi2ht4mhTpiToggCyIIS8gUyYTn5qRNOLsmVgO4IpsbLlIk8A2CAcnRZNoJFGHqgrKE3KqoOkKwpzWx",
+	"hIzQ0opBDiOmsKyjWczAIzWQO8gyvc7vuUiimF9wkZCeu9gwcwyxWQSIHC5ymtJBj6i6oZsVYaWRhC0R",
+	"dvt6hC6I0j/Vmlt+sD3IN1oJE0QVgkmE0b9wis6NkoSIEFwcdcmWn8kmtrQrsumUYvUlvkRX11JxoanS",
+	"GQu7ppXd824VHH0m7FanNBA1mADrcbhueoJluVJxJMiaX5O6xCLs+mgXgfXKnPsR4DKnFfSh63OnQ1/Q",
+	"NDZgqWdfSprWxq1YsaDtFTQ1VjeTUT9PT2pwdEzfmLRzkvqoF0RF99Do6AM2kefE2JqdDg2CTqJZ8fjx",
+	"s+TqRv+f/Gr+pCwlH8wv780vPDd/mr+0iDY/mGMN8Rxl9Iqgb9D/8w169E2bUAhW3yxEQZUcQioXxRwW",
+	"GsNBMW+iIcqnb/EyNozCy55j8OgQvO8IV4TFFGwFH5Eml6CATgfMAZfdrlkKGbXm2k89ZnrHZAeFFqwn",
+	"jfqKkzEAlKqTETuHVp0+TifuvqvBefr4Mfwv4UwRpqkN53lGE80ux79Jo2f2uyecCT7PyNrMUl/nm58B",
+	"lqePn7dR8Jqjb+3sH6eT53cDj3e+mlmf3MWs7xgu1IoL+gdJzbTP7mLa77mY0zQlzMz5/C7mfM0V+p4X",
+	"zK7zn3cxp1OY3tI14YXd2K/vYma43GU0MVM+uRMK/oEzbTz5+90wzClTRDCcoQtjovxOCC7M/HdCwzAt",
+	"TQh6x/A1phlc57Q4tl1h5JdiTpXAigvzKAq/5QLOfkWNsFvxLI2dDVqzhwagqhtbOqZrc6FNqbxCuBxe",
+	"33haglaWk3Yt0YL2cTopRBY+YSpl/VfdqBz6fTmreVKAUV4WKVXnJOEiba8XJ+HXeTh1jEWvUKRhkz0K",
+	"rQ2r8CCKronfGd1gWV42YaRSzUyxIo+geWh4bV2Ww0yqRuvsVmM/TidrolY8DY4IO95xywNbvKOFLtxo",
+	"dw2D7jSlxmB5VtuG/otqg+IQq707wHSgNQ1U+YgcIb/ZnKcbeIFgXM2Y0GQBt34sEVVojTdgeVCYMlA0",
+	"BCgTPi1XVJUHnxH9iQphPE6CKLFW6OAA9m0Ep6kgUh51c1JofmusN41QwlNyFDAkwCgCK7LcRMi/UCvC",
+	"lJVTyDV2zFBIIoKwKSyWRF1uoR3TiqRovvHpZ4roAmG26Ro5jnr7jrjL2IVVibuFjeYgrCZT9/7s1GGH",
+	"yZJTyy0uGWzqTKhW5pR80Vd6nTom6fV04HUM8ZH3+RWVqi0Zh05ioPs4NYb0F39OCCvWgLPmTO+n25Cs",
+	"R7IDhXGiVqdswdtAG1TX4Xdw8JwwvX9zLGkCd+u/P/4akG+u7AG42lizY7TmNSx7ScNi9IZk2eUV4zfs",
+	"shB0O5U12k+94d8327oVx/Ck73ttgMmHHEa4xKp2RnSeQYIsBJGry336KgdOd4sYJuPdO7o1MOaB7wbs",
+	"RF6Y0nZBwu6HeKsZlbIYOLvuIrpE8gorZAZGpS0gJizD4zjzAV6SKcJJQqSEZ327tzCW40bzcVLue5D9",
+	"BghlK4gBMk8G2zX7+Jr6W7d144fKXK9rWOp6DfaSuy0Yg5K3OdthZK8e85wsqVRiE1iBxvXhkCbINb/a",
+	"ZcBzcs2N9hK0ItbWbWCuJtuy9nLgLYIhciEwb0g3K5qsrIaiZzX8IxEWBNlxpkhyaAK6qpsUJZihOQHP",
+	"qyVXijCjo/YUA2lMbfRAQDQ9Cotx3Wig6Dd95pugJCtkTCjBF3Sz4pI4vFjhVDBFM1TBovFl/zwKXT0b",
+	"m131nNTA2yoavsU5ntOMqgDVO+eh7ql1q+6hgZ3bw6dYbTUOeOAFpEFjhvfhq+DWScAx4Bdo11yadaDQ",
+	"Y0wNvNsX2l/kNcAPyImqxR6StQleJyL7yVSLGDN9DCWJJaqGRzRDeA0e3nDr0oe0c2CSms4bWmheRCw3",
+	"pQNxkheyISx4Mc88xjVtASztbN15bd/qQj4NAJO49SSFVHxt/gZrVbW2KdJvTNUNzn8A0GjQsCGcXgMw",
+	"0hoh1uGr+trwUxuSNVlzsUGS/qE93eYbRRrI6XiEr09jX+rsj4nd0KO3/odHdJ1zYQhTX2EnS6pWxfwo",
+	"4etjuFnI6+SYr58dJ1yQYzeGnsy6igYuHjruZStRm+4mSMY37PToBPwOXexC+3V6UyK/n83PdnOmvwYD",
+	"2UWWl+4S5x335vqSX/wZbfHaoiL2/U257liLypbabsHXa6oUSUNaUrLCbEnSyBt2XTtxbUNLPXl9ETNv",
+	"JhmW4XuGO09aHyLn2HSiVBYKInAA9Tr5bOOpBcwM2nFYnLy++A9npLf0rlAROB8gTuxllvXU3IZoU0Nc",
+	"BYy795oyLsLodDIiIHN8fOpmbqBp/VZLI4RSBsrF9YtyKSANt2pS8Y3DZM3Bd1SoOcHqe1xk6mXE1v5f",
+	"KMeFJC98t2mjA69Ilk4rTy3ObMheRq+JIKlxKYLPCxjfqsxyxv4LpYLngQFTKhMsUpLqNuBmHGpUjm/U",
+	"9GoC3UOfEE4X0JADBQuew/+gQVC1Mgh5BX6QAd3R8/tv9YQnCBcjtIUmfK9M1yu+O2chS1ZO0x4T5TTt",
+	"GBgEogyvUoZPYhDkVCqayOqtBScrrRXobnANEtaka1fYTyBUALmDrPMeaGDcsrSXZ6ehkzgN75+J0Ovl",
+	"29fxGBM0+02mZlo3yRa4S3aM314qt4nV/C9Pgte5D5daUeq7IjW0/SZvQFIwmuCI63D8hCnh9EDYgp9f",
+	"tD7YRg6GQyNMuHpc/QRqDhaSgg9obl8i5GTaa83LZKvezgEZMPwyQckmyUhr7GdPg2MDOJeUBU121Qoo",
+	"BKA8KiQx4Mscs77Ay43cghurZ/M5vKv5sda83xyNfTbbUVuZgUIjcsseO3WvscM5Da9BvwHbBz3NYxIk",
+	"kOE+/djoPbLtIJNAkgQ0lSX3hX4XXaR6LOR1iNCAYfz+9+Cg2AiAui55pudolsm8S0hLxDjmCa69jPrR",
+	"VubyuCgYg1ug6aqDqTBLNDqGLre6uzTXmhdzWcwHDHVmOgCTFHO5kYMMHt44F653CCqtmvZUWAOn3cSO",
+	"UKO6cl9rkJcYqFHUVHNPtWtbGLC6T7WMF5duKe1dT/LCWE4TzmSxriwDdsNzwRMiZV0qevkTAqaubqNA",
+	"jbqak0xLc0FPGemerLu3w74Pl5gwUG7B51lJlA2FXvA8J2kEnc4GUyq+EHlZKb4WubD3rqeczpgJC3Qu",
+	"H1LpGUAtb5hNuk4Lf8hdeaFczTZ1ziGhOe8WnNbmOCxmFfcxOyeiJ94WeE2zzbAb++8FKcglmLf6Kl+6",
+	"R++V3WCqwzYWXOy2KDPd5Rp/CE+5okt4HxFEgtuZ40bTqwRjFxXCqooWqeW6axDV8Dctd70H6RhR3aKb",
+	"+qnexkY88tue8jlOrvCyOuzgU01oe6H+uscxXXPWV2/2RupeY8hvMKkMlD3sfMYABeP1Y3xzbW7C7eYs",
+	"xwqCTeVVgIXJdUQkh1/cgyYcnpIsOIIgy0HS7Vy3D53tQ3g3YoabTq4JS3nfd3SHGTt32dutt7pf2UXG",
+	"kL77Mxb0Dr27lKPe1tOVBs4O1bWsAeeWAzlkjqTyao+HqgqYCKoO9Dh1IuDkOvh7pxl2DyIxYIXWrr/I",
+	"T0sp5eoGbGjZJ0gt+us+9OKBFMfagYhGB8Q6YFtZG7R7atkETLoYuTQKUt/mKys0ZVhfQ1rb+IPgRR7A",
+	"Rch8GRLf/ehXy8QoEWsYdqdhs4TAZlTjfioCLiHoT2AV0AHy1R/3oF4Pnhi+DkS6P2KR3mBBBj2mkdp1",
+	"of29lKGq7RsfUUP6varZs9oHoHpcs9PasboWuzsNl+gKbEtt9E9FyT4Q/emtBnqAnt33PUi6DlgH+g5E",
+	"2KdnL01EQRteXH1o7dEiw8uU5IJo63bgsbouXL/P8PKkaq7DQdUiOPIaJ5Hf5VXwQz+WgGGn5ZJaC7AA",
+	"2Wk6eKPE1+7MUaE8sL318T8Ve9Sg6E+8deADDFI22INDGrCFcHjiz3IAHrF2410dbVz/ytNmzRlVXPTt",
+	"+Itt3ttzxnX0XGeiqzIP8C+ThORBl5Tf+PxyuEPDT3xuNCobSLHDEPWsCf6OWZBqg3dtXMy9Aed5+HF2",
+	"RZIrWawjH2mWChNwMCBCTeQhl57phLDriIQlH5wNLGD4018p6/hq4p3CDVZYpJd4saDMOhj2X4jpyhTd",
+	"sf8aAxwMtuXyhrLUJFMNiPtms0vsMq8OmMwwzmUV0Nm/b6f7BRfJipj4rm28+MZrasIeyeB4j6g+mGc4",
+	"IWsIA8p5RpOtT25nrv2ZaQ5DcB42WOWCXLYRGGhGubBk0KY0+0zay8M2sT7Kpcdnl5dpt+nMDFAJ3JZo",
+	"kAnOSBhknW5q2P60LHIdTx18oXZkHdN1d9aTCnZzFZZDJjHK9jhs08zDLM95xpdbKe+tawfe/Sbt9gCf",
+	"uuZjf55PPDHtCWUjaY1Y9YSoJzHr4rElI4J0P/WdqHzeL8M3HVsHWNJjEZ+2HaFVqPeQWcPR+26/Yves",
+	"ffSt84jd0bnYDWSynNs/9tBzy+ECKpo/+q5abqke7RF44AMyQAf1wQ/pufb7PmpuDbAOFB4ohKtEJs53",
+	"Fbv+hsfHtxvb9gLs8PrYfr8rOUOP1LnASvduKPOeYtfqvcz4HGcQ5RoGp9Hikldv2d1jXQ4XhtMJlZcr",
+	"fJmVSdHa4pzKbZ9zQXTa5DTcQifn7Fqv32CnRYQ1wS4C+6Xq8b+mQ0vHuyQfSFIMBaUS6dVVpetq8sZv",
+	"f3oSGEJeptZFvY1aTwVs0UZ1elxTnuG2Q8TWU/5g2pN362yBWb/V9bzFmdtrmM/1l53IyGo1lzlh4IQS",
+	"fivPuFQoJ0ToRNYLwhKC5mTBhUnDovAV4ddE2KScQzWqS1/LjaG82z5sHZ71cKVvoclJSnCyqhYQgn/G",
+	"6gtobdfeCldd/HWIsJgc9CVSQ341ZE1csgT4NMZ3NQp1dBegsk7x0RCTdeWtNkil/ZWHSF+lzXHZYbW2",
+	"mEeGTgLVP9dSEjOCLAT/g7ChZ1btyEmJDp+YvNB5/pvs4JrCO6DO8UoXpuyJda1dYeNDNSeEIbsXKC10",
+	"flk8Y6UnIkr5DbM+gqUXG0be2YNyIiiHEOEycKT9FRGWyqlfeUCueJGlEHBdMBuHNZ0xcP4tQb+hWQYN",
+	"JFGaa2GdNQb1j1ss1aVUWAw+urxc7/02FfCAswEdcsGvKTATSbd1OvOaHvIsqoBpyTbr9zTwKhy//u9/",
+	"OdU8ZpnHZ5X2LnvbV+1LS+z4+K8LIbd2t6Cdro0Wt4cRQD/xeVc+tbY1VZDBJz5h6S0E5EGz0kG87y0N",
+	"zNztW0/NhtiZW4Wg3/hcZ3+TxdwEhSLFI/nSIjbAutRoT6W/Gz/pssyd0JEL6zVmA9LNldqbu4KWDpIe",
+	"HRZJQkiqf11gmul/FEznLepIphSCGzADX1+gWf2Un01K51Lrg20ITE4hrcxsAmf4bDJjrpHbVtfsqBas",
+	"B62bekSP+3RaOb25IgjGb740GTmlwyNxn8RCd9Sf+Pw7ACfw0KT3Krj/w9mBCMHDjgfkA1WXSZRqLRgI",
+	"munolinSKXshaUlWuqDG8soNP9ykSokQ3bCYNlPQEpiJtVIcUSVNdOg/nv9M/xXJlpfyQm0dmxdq+NiK",
+	"qqzHo7RpNi13t4akEsISDRGKiT9PAmkPkmWa+DrkWK/AETNrCNif/32huCDf2Qp1fQHzum1C0NW+t5DQ",
+	"jjMMBzFPde79rYuERtNJPHLQAvMz2SffSH2QqAmwMdf+L93tedsLCGNpuoMJr3o36RHB7Gd4MHugO/db",
+	"xT6YD1KcSZB/gkO2TJ3pHv7ha1uYteNQTMPgCsz4u9vefQBDhOONv6v13Y6xj/HdA2PADlWdOrZmH+bz",
+	"oYoj71As56GxBa8rc5JeYhnJ+HdZtgkbG20thwOxbKfVvZqsAdi0vpAgGhpIltfJZDq55voitdCapVbT",
+	"CilgOml+S+B/7yNuJPZHhtegk/xsNmLHO44ZpKrO2uXDbBvs6MHctnGHQ5ATRa/rlgpjQ0eykNYUqpXf",
+	"H1+iuvLczhCFwyVtQ72tCo2ArKUOb7/RdhfhpjNADDWjJiuSFlmYFnVetx2v4uXAbpgtd2IPnUcW+zuT",
+	"jDeWqXRL1A0XgbAjrY0PNPIvBCHd8WJtZq/m730Od8QPFZL0yQlST7/gYIDueEkmdiF2tI4j3SLv9Cwg",
+	"0/OtkVlnjfV3uk66mew/OuVk9E4uaNq3cE/tVplXstSVEjbBBhaYTtwMO0fLbiH6Kj/ucY424AqcpPVZ",
+	"9n/Dbu1d3/ikbu7YJZVVnw3bZbs6NusAW7Vlow61TTzd2ZcW+g72o9Xu0EN9aKFTl/8sfN/mO3sbzq9b",
+	"fF49BLXAifma+i/xS4ETcmlePeqnblfehLt06hQEp5uhEAryG6dst9XJPKMq7gLZ2B/jeRZFaQP+MGSN",
+	"ObdoLXBg7O38xXQiLEtA4ezmVerPRrFj/bt+qVuRUuOtMnLqahX95BBPySvoss0htw6A++JAaCUGvVkR",
+	"+9JvYdXvdLpqPRba3KczFgi+jpreA+s2A4SWrauFcgFh+Rp8JDEz8/VGxcXL11Bxd2syC7spNQ9FA28f",
+	"qik3+0B0s7PBwuVha508btRPlZnYATDgMHUgh47qksCjqkmDtD2y1lutiTtIpaXdqT6C/rk+RLNcbrdG",
+	"EzdT6dXsoXWUqI1s/AH1jUFuhyHzY3TgmDvhUI/BXXyfPhsnvbt1sIN61ZQtLwXR7yl96PDcdDm3PdqD",
+	"6OkuadqrerTti2zf6iCyOVtMuS/9DbKOzjeB/PSN22INvhPOiMFKE8olvSaXrqZ3pFpbOXNZCqkBrnaz",
+	"AecVxdGczFjBjBNDxHflHjkD3qWj26f0Wuvv5qGP+b2dzGqnfINX2i9uxrgZJECYy7EKXyPT1LzQZ5lO",
+	"G2b8HTWhWg9HCqXWLedYEg2XEoxxZ4u+02nppaWnFEQWazIt+RS4E7BD2XLGtNsZ1ZWPYZe0xiivaJ6T",
+	"NABGKKuEjJedb4LmXD+BWHvX7wj5Jpg5+xBHYzsPQyNRB8SlrXbZ2rwyV+qQUDGborM9WFl0cmfXs1ZZ",
+	"zJARAPphFT6FdvCRXBLWBW6o6n+3n391ZWuBvqbsUruqXdqsh4G8w2UTeYPzcBsvqKzfvca233avMYTi",
+	"0kj6+1live5bB7hrrqq1hLobssVOHybZ10muxhzS3a/7q8mhKkiR+5w80IXO5OTclho+TKtKFKQbq1yk",
+	"RJB0jfOjN+afv+Dcb9MJNcUs4RlZY3ZcDaShXu+R+dSlRdCNQwq/QUn42X+ga/Wtxncbvgi/GPs+jr2i",
+	"63C+X8TzcH/jAwQ1l0OUWmavES6UBToWh3PoWrX6LddG8+o0tkrA/ck4t0usqFzQSlEvN0+nXS2L4Whl",
+	"SdsFImVp4yHeXbHbu/t9l+7VXVmqZUYTmyYd6Q7CunPWVlELzIZGl6bfrsd6RWaBjQAWdg7+VkmNQumX",
+	"I5ox3cxU9g3vwe1Gle8WhX1Z0tNlWY9gm0N8P9/3PoHXVkj5IqkZXF25xIeiqhs8XouzrvvMu0jrWnx1",
+	"a/Xd16pS/O9u5PSOj4DFyxt9V2OnGWIfc2cFRH87XtUnRMXm6x5mQh+kKNoOZCr0ENgCdqBTVHz4M/ec",
+	"0F8UvKmfvmXs1IRxz5V9hfU7gPMjDpJRzbL2P2Vm51jOn2g57bpbEPjq0/QIvdPe4M7xXp9LtYayFm0w",
+	"JI9QyII4ZKCWRbG5a83xQ/t3ZnItB5Q/kazi6hZYNtrXQBxWShsGBNf/ZVOBhs5Hb2GELq8fSZfB3zvy",
+	"AgvZ653TvQ7Y9lODg9IBwl+4AaMDobuLVLcjAcHgj/2pEql5MPQXeD7gAVlgP+8hUWtQxTF3IA/XM6yS",
+	"VQDSOGeU17VdOEHrl5EM3EYl6EHbZhCvS52go8vch5ABS+HNUKtPTMR2ZUMozHYJE7BKVjvGgjT7bvpM",
+	"sOkKSXR4xmmq47cxW5pM5mt+bf7RyPRbIX/f0JIuuW3+tV3hdtFmMEN08/aSFeXmB4nTjX4AOdG4xjf0",
+	"HP0+UldHXAUW1xGVdwMHn36Nc3btjOMU4WtXM1QibTqaTN3gMuFC/z8XBAOsckUXYS2qYTB48ec2yNwV",
+	"xQHGc0XXOuyXcfbI++sYa2fllCzCE9urfH0nE1d8eLA1Yh/n4x730hUgchjhD7j0bvNN7jHGNc+KNYlf",
+	"fzudPFeGTGrYbwzZ28MZNnagjAVSCEk/zrN9GL4EJMTvbuz9r1ow1L81qrpTMfWnSyovuchXmMXS7sSS",
+	"KMbMY71psVWFVcetuMpNVW66CsItlGAQM5weTL8YVZive9KGD1qEQrx5DkEnUtkKMcbd9vDPzxDUa7S7",
+	"vu/PR+itjqPPCFoXEorMavc/BiExuq88mkX8UGWxJtvzn5h2UY+SqXmBdlE35jijCuFMe5fOmOddEvDZ",
+	"+BjBc6hAb5futL1IT7DY70dXEze4R/qT2yRXz2laGhXM51LvqaobPZUhhJeOveG57Fc3nd4SRBkgpTn+",
+	"k3Vo/NU8PHKVgUYqQfAa0bQ2GlRSPRIfQkPmhIgI+RIiqtQRBlic5xklEik+1dRnslzRhXlBINsrpK7m",
+	"ftaCEl/vO2nkFV9CElAlQupIRq5JVqPxCTVvkk5KpGReLCdT9/MNFmxilRGdOEJhI0AZTZx+tlWSmFm7",
+	"wb4o5lXB6W03AlF6RFT/53lQLYMUnu0tszKjZA7tJOw7APcih+5ncrdvGoLY4t1b3pngy3Cyf0g5g4Wi",
+	"OAsfngeJZIj7fMVjHFyf2NLgcluVMD837jORar272g6r+vBmFbuVRa+D0GGDh2UZS7E98GIn3YKLJOgC",
+	"+bHHqBc3NGiXSYlUlOHtCbPXlFkF5ckWIvWHjC34HMT6L0baRyvJ9XAxtKqC2SjXLXrbWMtlNDI62kmS",
+	"38OmcXtYIQlUyJLysVMqvM6rV9ycS10hUXtsgCIBx3WGN9rrLKNAI/ZcL4sqXhGS+7+gG8gTqFP/QTlE",
+	"IlVz0im6WWFFrk2peipQkvHkyqolQxVaD/0NpBoUeggL7q/g8yxoGCPKOk3UkfkSrYo1Zo8Ewaku904+",
+	"5Bk2FIRkThK6oImpmkkl4klSCKHXbo7xGcvNjBElrAoAa+/hj2/fnvn1m9Fffz3//tv/fvrsyfspujBq",
+	"AfrH39CSMCJw5UQ7Y1zQJWVIexUKq66EoEMh4PxrrUse08SJXHHQ/BqokcV6DUpsfXCdOekIoVOFLn58",
+	"8+7VyYy9fvMWGfOWqbXtAaZ4HMwpIh8SkiuTTykvRM6leRDXHq/0D7MrfyVHy6MpKiTQbi64DVBPOFOE",
+	"qRljZMkV1W3/XyQJQQG0Pjt6/rfglrXkiTLPzNJ5ZRmcRWgPCG4TibseaJzQmdeCn2Ipf7y4DPjyxJdb",
+	"8MPTyYvK3Aw/PGsIcn/hTkGyrGfBcZN3hWo4NOxhoHaI9C59nyQix1/KgKur1yt4P7bf97kd1wAL3Y39",
+	"OQ5gMK3759TFBYghmpBplf2Mi6pMuuce0TRN2uvomn4gqTNIKlGQkNpra5QOqqS6dCX6dq6x2iOh0ODa",
+	"v40yp6XLoh7QAR3ahPunt1ziNBXDtRrhbuS3rO/8hwg+Y3TR+oRSbgtj6/46z5qdoa/asksAiDbNin6F",
+	"Hw2OfDRPh+hGBpWN3MHl7FHqMi5rYcltqa69N6VlQwcCVO4YgHGS+rrkFCIbMFoIIleMSKldC0EL5aKB",
+	"9q4g6Vuk9MuMRBxhb4XcnRJO0kvQk8Lo1ZYYPUZdLceVEk9SE1GMvlvnamMSGJMZ85vazbB8ovkjZjY0",
+	"Y8LlMcPLLVs+J+qGEFYOqudB3PzgQQ5R0MpcP/TwZdCybkqlaTaACCrF+gCRWbfMmJqqaswZZktPy8TL",
+	"Xlw6pMZ3rWNIL/Ga7KGatCAMaCfNmfY33bvszbsm+2jXceqZ8CNQwqBf0o9mvumPHauKRUZANB+VcDdL",
+	"o8U37Do6WoDSls434e9eYGuo5JYNWHUSrmeCDK+XC0wY1LMKD+7RrUlKHs4aCKphY+oZZevr7JvturF7",
+	"h8l67QaFyJvbz5oZTC7VvGhuTdNaokloAWlyasYvkM0lDpE99Z5hKVe12UvMNYEMyrnGXIcTdLvfrt0I",
+	"nQDv4wRWisQ9bt4+IDtsypa9P8S+b9vzA+/3K74cDOMrvow6rrXaxJ/WAkRQ3kH7vJNVHboWeKhqXTtn",
+	"xAsJq06AY+k4euaCaIxT5oNo6ZtND/t+p85hq71EgA1oW9a7qSscyy5JehfG0lwEdxXnSjfPSMRwTeXl",
+	"IsPaKyE8WW28KgvF2pQxwgxxk8dUrXih0JyAZaA9Y7NqypAbhSBrTFnds7JmPQhWUahqJsC4bdRIU4QG",
+	"4YUiosoP7+cq2CEatYK1Wuh0UoUqlSWKSqR3MUZpLIxF5g8KnPVecnuHBTaW58yO8WjbhjLePleNxtje",
+	"r5WOoKxtE10yLohOWWsMnkgJzCT1ktrKIIkRluC8PYU1kRCYBqvGXFCeiKVZdbPXg8gi00YBHdkubbEg",
+	"A1eK7BirTQ52W8kF0mI6QvfUxo/XYboim0cm21OOqZDGyKsTAQOJC2JMbZSZDYaFK44SnmUkUTPABXl0",
+	"Q1OC8BzYzxoAzJrCMY2Zy2QVyDu0HHAeNm52DeYjWWY207pT0AV4Otn6S0rQ5ZIIKOlkBrCbiVwxJ+0K",
+	"Ve4LWBeLPIJVv5RSY7crTLi3QbxcCrLUG0qZ4uiNCVXU5naCdTqglzqctLS/m45HM/ad9rlFlCE3YzV6",
+	"ytlXIGZ5jnCMUCPgDwjXjQmFbVdL71Laclez2DHbgrMbeM+G6lj5FJFrwqxwxGZtw1bW7+5ercEU1I0c",
+	"eF5uQNOuTulAJVhKumS62kfQIQcvB3pM98sg6+SZEzqle5ThM8NVFafUike1akRVnkv24lxasSx27Dos",
+	"cFsUGYedvfNgiPKeAwKeZ6QegmHihOcZTq7AV8r9sNROPdNJ6VQ3mU4gaybghGATpsG5Xu/vBVaKiOA9",
+	"yeVUDMQiUUVxD8OSHeG0bK/JwSVi6NHzrWncunGUA5bjhU7E1vSBc8l+chn/VmD6lSDWXQ5KRFiac8rU",
+	"USsTfHcOQoxuuMhSfUYUjP5ekPp4iKaEKbqgRBzVfB3p7+zo6ePHzx89eQxUcVTMC6aKF4+fvCD/mKfP",
+	"8bP53//+fNK7OBT86pZXzg0/NmaViaR9kxyGmSCA8t2v+CHaad5Tg7N9qrivEDD97+TBpQRkY7PdHmaA",
+	"MMA90HygB3k37C546kDNATCyBRGHXf/bUiA2+Fb/7ji3kSD3Xkiorx89eaIllD23jqS4fpGS66fsyZGF",
+	"98is4ujJcHmF70hiedU0etdhjNmn9cVTFMPSmJWdIEAiPqyuFShlvBUjH4ZPblF1aS82XMSeUEyzhtbc",
+	"bthRnCTqi+26OLO6j8UmekLIqC89tKbwArrIYY+Dyy3ntmzTZaqpPWzT/jIHyEevV1AC2+/7iOAaYCEZ",
+	"7M+xv226spa4CYocEAcFOMtICz/MVNcXnG9QkZf/TGPVOi+qbH/N0gKHK0Lp4l+CrO3KrPepni7RosgW",
+	"NMtc2WfLZIsic62HV3zfoYqlmTXiqO9vrl55bRKvvGc1UL+EhXp95yQxBbn2zQZox9tDilSJH1sc4I39",
+	"qfK8ezDIockso9LDfN5HePhQxTF3KNGhLQMxv4Ycg4GLZH3o2DXtXYPan/kwjyNmSFmtph/CfUACW/rW",
+	"S7RWRbdBpWN+rXk3mAfAyzvmds7rAmnRgnT/TpLASxYN1d0K+cn2c50y9aViDogAwqlWmkMu9LiIOvli",
+	"prpS9A21nXkgxWs44TWROY446OdErKkOK4ucH2SxICZowWvqTIWFJALpVclpGXyDkkIqvp4xwTNiTIjZ",
+	"NUkbZ0oXuQF6z8rZQssX+OayxGavi0HVw+2Dj5roJu8s2KF3SDaVo34q+4UDoL+4LUEObAR820OSV8BE",
+	"UHWgO3iDolrAlrTUZgD9CS3ptQs/q/ggpNaUNCXjlnb9He7gioiKmQy0xgwAsJnUcAgLMmOupiRnR+hl",
+	"llWj+DHNQ7W2apIwqG5SnNMKoBLsQVNJUt06I/kH+qPDATZjDh2u7a64aJY7sPKhmjZAVHpVSSGo2sBd",
+	"ZW3TLGBJk5f2ANBUrjUC+LWilpVSOnvwnGBBhGtt/vreKc8//e/bydQbQn9tjvHRe/a0sVYTqwCYF1Vk",
+	"MoWX+esmz46ePD16arefwVf47fHR44lX0ekYFynV/BA0Wf1AzLusboWEVqbLfVsXyoRqAtm4uwmyVQVc",
+	"VKB1PDYpGqaIZymRyngTmCQSblDY7gUXN1ikps68c9aeMdtXcoSZzfabYIYwkzdlpCEUueIZQXa+I3Ru",
+	"tlkaMATnyjC4oZdyw09Ts8yXGg9TrbqtiSJCTl782kQHZ9nGlkZAyoNde3AIksA2aEcO/aTmeX9S6P17",
+	"QXTNW6ukmAoFduNxPRz+ySpkBusLDl+Et0M/AKckAo/9VIFzkPk1rVCpFYjIxPbTgSc2dkojyiEM1enf",
+	"IQhs1FMnBKHDrSKW41d0TdXk4/tpWQdZ89fTx4+tv7eyhR9wXoYyHP8mzRFVTdxZkQFI1Fxo9WGpZUId",
+	"MW9+Bn5//vhxbKwSuGNopNs+6dP2iWn7rE/bZ9D2731ggEa+eNUs5wnWX98D5n3h+ev7j+/tyy1YHDXT",
+	"vtc5jEIlqXUGvIAAq+SMNouUaUXqUgOB0JixqNSAsH0nNizZ/Yunm9vYb6s51Y8vJQryMUxv3Wh/6rb+",
+	"CyOTj1M479Tq2B2k9swLHAbwOKZ9D26Rm+0cETb+WIEriA5OgxHDdP6tIFgRHc8kiCoEQxgxcoN+unjz",
+	"Gv0vmaO3/Iow7+JmbnJlugA7AVLQDES1rvYDDQFKLlyQu0FxnBfA3mZA3XKQrvEHO5k7JKdojT/QdbE2",
+	"1fnQ0+eriLD2jtXg0fk4kKfoluWyWmkEP1iJ3MFZauUzlt7TYXRap1FjVsgoaFKg4xWSmPDMGiHO2Irg",
+	"lAjwIaMK5DncE0x+MHP8H83YjBn1Uv9AUmSMFKBmkvWcpKkhcoy+0szwFUoyTNdwsVhjlazcHbCQYsZc",
+	"kyuyueEitYprqVIi7T9lxDJornZOM6OG5H+tT28DGlNxyzDCynJcApZDZ2s5Qi/ZZsYAt4QpG1WpbTGA",
+	"nMSgUxbJCmEzzFQjNwNlRGPGMrriCM+Y9ffii/ZEph80qyq5ljdPvYTTRQX9FGFUlxnwvJtJbreVOBT5",
+	"bWYs0S7O2Ub7xUmDK3gRB1Glm0iTmaMm9TypJTEUBbOY6RBChhlbImiLBgfeWHLycfrJZVULArOdFalU",
+	"m6pV245tncUuH+7iW0HV/wY9BDwiiU9I6KVhGwckARChp/Z0s9zEWbtjdCFlmwMtxqPiJpFHIBDlkde6",
+	"P3jvAyGqqrNQjLr+++njVffUl1vIzNDneCTucSRC22d92j7bU1MNnKfWaJeRUKblc3LNr0jFfbISl1Yy",
+	"p1PEhXb89xpRKYvSzDJj+kgpmKIZYvzGCe9rm6/NnG9lVL/phGqWnSN72naciU04+QJxtYJ5JRFBeX6i",
+	"11zSkhws0ilU+BAb3Vu/CQ3qYQz778cbVm+6nYatiGCtMLdw875Up8H5JkBODQ1rxhrklLkhexOTvdbd",
+	"P0o6rMAto3o7BO/nT2gNAXlspVP85vELEUsjfUrJp+nGXEWcoGslL6kZh2asZR1CW4xDjt7OLXy3ZSiy",
+	"80A+Kqlja0dL0b7kZeXRsfFqPMZz54cRpK+X89IWXTAIWnTyzIaU6UHqtaD0reqcSOLVR3dBXKbsNjLV",
+	"tK0e2M6u3aa4WqJwDdNtmrBCtbTutb73/PHzPm2fm7b/7NP2n6bt133afn1ndGyJL0zKC0HIHyROy9/r",
+	"76XG2NTzZuxMkGv94gjBpCbPnqNciVKSaHdcm7Lc3kJcO4kUviLg+KNH0vV6XdDcXCfm/IMwl3geXvsg",
+	"BlgRZjL4lU/TNpeT3EhF1tMZ8+C8wULn+oOf1pjhpX7XLkm8H+sYFIy8U+Odh8oPtrbAIy/0M8wYNsGA",
+	"H4zLF2E+mSLOrEzHCoxzVFennbHvyuoK8EIsMGVgTqNKuhGplxpgWkbIZhsTQDpjtlKDfnfXlrOyjoO2",
+	"JnpZz+DVlVmO0uHAVIGVEcz9EDwIw1tG013IB1X2ywVPiJTal8vd7OqF+sGWNycmsloUOShM5cVTn5X2",
+	"zNOGxRkz9Ry8NuYHuz5I1kyTlS7qIL2KDu1yDr24t1XR/zaUrm2zfrQ62Cg/xrO3kjXAkN2n7zvbouP8",
+	"BRLjojxT22cvHLJaB3WVmTa7HMal8LBSAkKN6xVXXEx77JCeMe+URgMOae3sUzCslHb/R85bGFE5Y4Tp",
+	"RGkILzFlvQSCw+l4oD/sA92kVTx2AXVBY9S5se37nGW6FeW1LGQ7svRkPOO/N0FqA2iJJ4qoR6YUTp2m",
+	"qiIa+hAPGOpDNGSKrxFTuu7DIwihe7TmKV1Qkj4Si+TZs2dfM8x4NErHOmFOXkz+v9ks/fP5x0fwv6fu",
+	"f2/N/17U/vfX2ewI/vVk+vXHv/3f//zf/xMG9ouxFlREOJ3kRcCV5KyI0E0fdeTQJNPWRp73MQA9/zwV",
+	"iM9CXskqnHCbrLJN0YrC2V+Wu2gqBx2iywWgbXHN8ZPEYLQgLCFpl9uoi9mL+k7eptnbj/96mAbvOuGY",
+	"O6JTJuEW12GSTFN7QzQV0+qvdpZ+KocUcOK2Zu6654wwJf7m1mvMJk//SnCuvgId7isA4yvP2eXtirgL",
+	"JKiLdiZoVcWmSoTlhiUrwRkvqm66+oxDHrSShKkyl1V9DGODAreZOSEM5cU8o3Klr4hvwS3CfKeQjG5O",
+	"MkvE38yKx4+fJTinl/Cn/ssumVs3IqS2wj/Vfknwa+V5ZKZb0Az4ajpjj9BPnLILE7I6jc49xeCJZD9V",
+	"P6O/mju53bxylbo17GWN8f/mpjs16dQ6poNlPPI+R6e8weX9G+HadOVsOpHXjnNhhnQEtCm8A84PgEST",
+	"grw2m37q+1tEyzdV7X4yqZA6xdpbZxVRHJDYQmG3U3z9KSXsZcHIzaVtvqbsFWFL4OanvR0vvmhPbm1H",
+	"YjgLyjmT46rDLAevXvoiqluWEkK7HUAZ8wYBozV4AIqjgXLuFQy+XdDVYdhR0tUHuWNRV5u8n6zTuNku",
+	"7Mx2hMRdXczZdmFBp+faLun0KmLixxk6GVch6aan2CbeOic4pHx7ZXO8bRVwzkLjj38AwcZT8uhG8Udm",
+	"Vz6NfDu4bMn48jjxKrha0RLdA6/g6+0ZlNtzBbRaSZR7LMj4Erl81uNz/2DKMFis04VUWMnojcwFRIqC",
+	"6VzI0JpKRZMyKtLuDDjlarunbFzVbDjjC7TkgheKMiKncCBxeMDJi7ks5uj3ghTwc1VEWQm8WNBkCmr6",
+	"jFnyc17bfvLdQhfX4YuaL4JNw+DyQ8QuirZGsEbAwEhIXQvRyLMKJRHRo9t2u8ve5p3RX+XDvDPGyLqQ",
+	"XUFPFV6K4f5xr50b9huXk7WHi9yFjRWv+tzNvhdf1MYX8+Mq8d22Y64qEH7bh1w1U2AvnL8U88RpVUdc",
+	"jqfd/tTB5HFarPPoSXdSrPOaxejk9QX6g7Oypm3sEHl9AV1v86nt5PXFfzgjD5WJmbR75M7rLqntcgcO",
+	"F9mQqnSItIbn4buR1G5NMbOuzqJq29jHu2mV/56lNtX8F2ZAsbRSJ53jHKvV8Z9llNTH4z8hhc5H89PH",
+	"41zwpbDJ+eJng9uQM9d6uPs8UFupJPTzn4cuP1OW9m8NE1jSvJ2jq4WIAHV+a2qMa19zR6SOOG3ef44E",
+	"WWQ6M5oxwOjB9ItLYvzQvZtESlNXFVZ7QPU9/EZ7YnXn78sOlZa8nRl21JQfAis0UBBgAlNx1Vw7y8oL",
+	"I9kOJNvf+Pz4T5p+3GqOMHIF5AermZtd4MFvfO7FUfNC5YUqsz8kfL3GLJWIfCBJoUNfrGvWb3zeEZ4F",
+	"X2mqXbJc2LdrbXdeV/hxMtCCYkoDCenlWsEzpitc6DjFViCwnscOWDJuRPn8ic/bDKltEDbFjzVB0LTT",
+	"9lmVTy90y8EJiTxzyDUlNxbVsJL7aRMBvD0Y79E74VTYSsOkjKgbLq66lPTXpokc5nvhWG6OkyvCUuQm",
+	"igfCfzI/DLvAB+yH4ZBf2/NjmvfY9tOzh77vp2dfzs7b2p/RPbfOBAPNp3d2t4aZuu7VJrvieKcui69W",
+	"236cZASLjuxG8FmaZ1+J/uo53E+1AztJ/4Yoawd7AmZ1JZT2XQN2Sw87GQ2cw/drW5o3zau3neetmuSB",
+	"iscG0uE8Ov4T/mmu1LH46Taxn5Fm5PJON2ueEu/yO0Z7PIBoj540psMp+9LYiW480thIY4NorGfwvDvk",
+	"w8d6RYVloPl+ZNg7Hc25c3a8oOntK5pWmicJydV9J977RGR5IVfHWNoivjGvV5M8TuvmhFVpuVyNNP2X",
+	"HgSlVCYQPrmJa5lmq84KuXopTXncL5wivxAqS6m82pfIYIxhNHYCs44k9mWQWI5VstqXxnKcXIFn4yAy",
+	"O9Mzj3T2hdDZ1fLTUNnVcqSxh09jMsHsuMyo4YoCdhJbaerzu6EEJyt4wPzW/bhBMDYjwqQn1xVtUy8z",
+	"ps6FZWr8MP0rAdKsfL5TQU0ODz0ittNgUWXZNikz4H01pVL/c0GwKgSRaI6lLjdlpiqEgHksybOlTd5h",
+	"bZSRKJWKUi4SzL71UTTyxcPni400L98dlnEjZCvha+KSy57bpOxFOcWd0dP3XCTjxfqh0eqA9Et9LThe",
+	"bqHRhjOS2seWirA1C5HX3jkK2UQMD0JDsA9tB1ULbpPoK6SPVdb6EXxZYrrrobUsbn3bUvI7SJeMVa+2",
+	"p+ucCMkZVrdMVG+0/6LFwUhS/UiqdyI3z2nFZXFDpwvkxnOB/g3PTPg0RSkHcfph0yW6/ORddym4xqxx",
+	"D5f24ynjboPkxoRzX1jCuZ4S1krWaHABF4jY8xRhV/+u5sVWE7uQE4YcdcvRH+7ydfFnA7Ec0GWI/mC7",
+	"3JkaYZczKqZDaNwk3Ylf+U2ZKSRJYnMPF0wSZ6xSjujlYKovHTh123cGijujfLOqIYT/DpY9pMOFbn6r",
+	"dzG+XlM12h36UHs9Z9pOxQxYVSS+TFQGfyB5nWh+uOZZlUUGVGfGFUpMwKszAJhuZdkkY2ZgXGktUxfq",
+	"KkQtY7juiKR+j9ugG6qL3KgZU2KjX+lsjvIqa7lNpmXz3sAqjjrzZ1WFAG5FeR+dsKNZJnoQqlwVKuU3",
+	"HYlML1aFQtCkTIkfp0lbA0MqnnuUbarWtCiyRpX1LPY5EZSn0zpVKrGZsSBFYokk58xWzaaiBKisVGNX",
+	"aQH6Spo0ULoICL9h3fR7YTsPJuATe0ANCBu+ExObWdYZHcX6DvyieN7BKwHC30mK7y3DgcBVgFVMlVFT",
+	"+KHsf7kUOCGXhuuAKciHnIp4uRfLF4CK+2xKHul8BzrXyUWjl1K4+hBm6Nl0MNlIZY3S7ZcrQnKJMJrz",
+	"QpcR+Y0XEF3vXlnKPKp2iOmM6Rh5yhJBsM6ISlNdtNpWpDdFi2BEYyWxBVC0XRFLBXHwCaHXbkBUSKen",
+	"vAJT23fw46PTE2Sr11unI0lZQmaspFETXf/8yWMbc2dKQtkAe2pANwBXKVmh0gqVEI5vOBVlnC1NgXwT",
+	"eK4XPkUZvSJl2RaDpbK6kq4TbMfXcf2oYFcscjqVCeP0ou7C0WPIYfaKrqnq91hAmPpeJ7LdLWNdn4P1",
+	"R73dQAJ6ukH1Y3WPC6CQPqJKkQ/KMFDQjtclq/RE99+w8PxJHxiePL5/cm01P17gIlNd1bx1rKdJtgxN",
+	"JaIM3o2INrnhmmyrcoOanY4X0DZ8+qNr/70G4gD82o5hb8KEdPaMUNT6at4z+fBq/pcnR+JDnyQcyldZ",
+	"nIzXeDR5pAkWR+ilvUJYAC2aQWjqBkbC6nRLRB1FgN+15MV4UQwyzjSiy54yk0TG7uFWDpjCNku6LjJt",
+	"rZ4xG7mvg68LQcy5agbLcSHhtpgKnkvt9UAyvJEeaczYmkhIputUVqqsVlolcVcfSgLK80xbK+CKCvQh",
+	"j9A7CTZzYdvo+r0efSo+YxWwDkZHtuXctgo1KBJZ6RfSoRMfmM3f33Ya0Aa8Y+HqWzuFxDw9xllmS51v",
+	"TSkF7a3fEFpTxgViBVRNMDXTcy6UVx/EDFt5CcXo1D4EnZz/6+RlBcq9vsLVQT2IbnQ/zMVADy3XnUYw",
+	"K1HJyokgU8zW0EX7+QMtBF6u42lh3bbfmRtQNdndEMno29PybwhbqGzwTW+Cgsa26HFXAMKnJ67bOSbr",
+	"a7O+vyEys+lkxjyLBxGOcO5tLwNh1FHbOCb19Of7fchpEEevgl600TOXbJ+k3XfiDXDX6WZvOSn4qSLr",
+	"MSn4sKTg6BjefiZT/4drntV/SBbL+g+SNLoUUhyAMdxD1pzzDveEf3HrsNuoXRN2s3HEYYJVoO9DY60d",
+	"o4P6dxvU+qKYS6IGdHiLl0Na87uRJWNs00CBcTjuryzgnU55O0oA03uUAbceIThy0iGO3tZJ2zqLD3v0",
+	"DkhitgPz3WFOs5H5Rub7pMeYjsSVjeJqddyfuSa78lM5wBfLUicmJPmcZxkkRb/FNA6vtKvGqG6Pcuqh",
+	"yakt4QAXZTBAQ0KZsjEYCQIunCa4to/QOr84iM/9KLJGCTRKoAcigXp5rh9O/hzAO3wUP6P4GcXPAxA/",
+	"g+Ihd7ikHSrGcBQ4o8AZBc5DEDhFh03ovAhag5DC8qqXtCm+XGOQdoQS6yE9BGcDmo8CaRRID1Ag9Qu0",
+	"hxa76kA7x6k/FNE0So5RcjxEybGj6biXzBhvTeOtaRQ1o6jxRA30SOebXR6rKEO2N1pHk7cHJNCFnXIU",
+	"RKMgGgXRKIiObbRArxI/TSFk+vaUPTDL6Co3usp9ARy1y/NvPy76gl96x/N3lBYPUFoMrNS0g9S408JN",
+	"4+k78tMn5qcerurvqka7c1X+xburj07n4xn+RcscnUqvoxwofEaYISIEF+ivs4lxvYIcaCSdTdCCC2RT",
+	"AP7NpTAtoXQx/Z0lad3u66m+kDQLI1Xfu1QHA+uY2fM2mAyJr8t6Zj2Km22ta1YyyOEKTX3WyUjGUmsP",
+	"UChYfnIiofzTCITyTyMOqsak1vhAokAfV6UkcAdjjUgEybCi1+QRDBXKK9t10oEpmTxYPh7r131h9eu6",
+	"WLeDGzMez2Z5QcQ10YXnM76U8TSVr/jyLp5kXvFl/8Tz0Fjn+u/Z+BVl/Wp/AdTyllPLa3i6c8s94Hxx",
+	"hnT7RskVcnXsynYfU7bg258gTaE6k1XTVAPPTJWC4OOkGxxRZsRi34C6Qq7Obd9TgGs0m94/s+mXaZbo",
+	"x2H7Hg1uN+7oeLhnxH8Xp9WnPoRGU8ktmEr6MWfryNtmKqkdY0iB85qrndB8tehm54d8pt3m4eTjbWSs",
+	"uznCAPdp0c+W6NruwxsXbr6RL3rzhcPZZ1BKa4B94L7zTw5mnBhXYHnlau4gaKiL9CRZIZWrb9lRtOIM",
+	"Rj588vbPy5R0T2rY7C//thSmOZjAGyXMvbG/SLk6viIbuY1opFyZiqAJlPy3lbf60MzFjz/D8LdPMvr2",
+	"k2eYNoilpzF7pAiPIpQojE0tLwIk8Ra+GjHSoAq+8MoiB70PCkcVepBPfHQ85F3cSEXWxymVV1HW/jcl",
+	"N3obdasYA+uBTkyLe1ykhcqrUeQPIY2l4EW+nTZMs07i+ME2ub/UoSEcyWMIeaywSG+wINspxLWU3VTy",
+	"oxvwPhOKA3KklSG0QnOcpoJIeRBxcnr20o52nymlhHIklSGkkuPkCi97SBXXsJNUzspG95dQLIwjmQwj",
+	"E5Ws+hAJNNtCIqbJfSYQlaxG8hhEHgJ2XG16UIhr2U0kVat7TCcWyJFUhpCKxOyYMqooVlxsp5eqaSfB",
+	"XLx8feq1vMfm0JevYbIS2JF4hhKPczfuphuFxZIouZVqYDM+B4IZ6WQInRSS9JAt0GoLhbyT97wYMgA4",
+	"0kaTNozjQJQCAGH6WdW0ky5qz76yRp5P3pjGg8kBiOGNnhpnt0sMBsKRHDyf/BpBNM+OyBYbL/Ndtvku",
+	"ttdA9zDdamN7VvMykteJ+fsjPKfAe3lHYVbTQHP3zYpnBHw1EBdI8rV+ZqdKlt55kSRYF9eJHWbXk2C4",
+	"n9BQL+87iJUfvUKGBgL1JmPCuqn4O3YIIv6OjTQ80vBBabjm8Ln9YL072rtvfpZm/aeKrB/0yX2w4OVB",
+	"YWh4zusZv9viz+Dfhibp5l8uKYpkRaQyCPqfghT3Pc/MsMjgf/Zp+8/PLor4tnkoJRlRpD8TnZj2IxeN",
+	"XDRyUclF7SyQ3Vz0/V45HUcuGrno02W0GMQYS3pNdKr+3qzxg+sxMsfIHPeZOXbghmBy0252ONs3T+nI",
+	"DyM/fCaHRV6I5QAl6kw3H9liZIuHzRaBouDdjLFnle97ljBvoHNeBBeaM2BCKkg6eaFEQT6OzDnqcIO5",
+	"cSAvXnwmnDjywcgHA/mA50PYYPfiRyMXjFxwb7nghtoAmZ58YNqPmlmJilExG1nxIKwYqsXVzYz71tYa",
+	"D6aRGz4TG0KksNY2/shH6/PIIg+dRUwhm+1ejKYIzf3mhO2tv7vGWYFVr7an65wIyRlWt81kPoLHEJZP",
+	"4spy2CpQmG1MNssbqlYIo5TkGd+QtErqil5xfqWLqJlyAK1xOGuUi0ILKqTSdaUaH1ZYIsbLset5ZLdW",
+	"mfKpb5/aNGPFqLFi1OcmH6ZbdcHPii/GCkxjBaY9WKEIcUIxMsLICF8SIwzWGa2uGFQZfyAKQhaJvXYg",
+	"DBlqb7hIXfB9VJE82qar/UDU534bs0GKPxuUyAFdhtzjbJc7u87Z5YwJCT45ZxY56N4dcfI6nAcmgx/k",
+	"FBVMEmWLtSnHqnIHXm0qkO8MJA+DXw3ahrDrO8DrkA4XuvkYuHxfGezqWipeS8sbOat+/veFbvhgTip5",
+	"y4eHwdd3TAlKdMKTL5KWe95YXH7OhvCFnz8j8rstlwNAQ5uetvsbfG73lofwinMr4vmYMCU2Ru9xgc51",
+	"VjFHeY1XvtN9Hoy8HrWI25C8vQ79L4CSbu3x4fMyCt1fDWGLef9BU+odWEEflipxLym40yo/0u9Iv/eZ",
+	"foerrI0ygN0axj5F/T5/57wKCc7WPFatvVOaPVRF9Copc+nEIzu9dQ5REH0sb/7Flje/i0rmQNOBaubd",
+	"dL1vbd+xNPlYmnwL7eecZ136xRnnWUCnqO8CEDYwiSZ0BAmbCLwZKi7wkiA9BUw/eTH5HVTayXQCrScv",
+	"zP+mHXWBb7V0D+fZNrr6jGVfzmubfHzNs2JNtu31v3WrB7zjZoFfyL7rKtDHPCcM57Rr6y9u8HJJxGRP",
+	"5NvNNIfcPcdviS+NJIsxQTK8OV4TKev1EFsIO4eGv9h2Q49n3fm1rVfT57jVHb41hUlOT3r3gLow7A70",
+	"Tg8VD5OnNFlssaA2KOK2oqa3YRsARNhEQqRYYUmUDcJAehVoRbBQc4LVpGeo9TZ7z+Mv6krhSKGSFoLo",
+	"/Yz7VWmzRIl/K1yQ7aZI6vZHV+nXjaaILtAaegmSEKZmTK2wvVrAYKkb5Qi9XRFvSJjAVVdEVCLvnIYx",
+	"iDfHETo3u29aCc4VWgrMVOhKUlLeuV3s7RD4NuLWgFZ4qyF0JOfDkLNUWBWy04XXYly6i63uKKGUWorm",
+	"G2fayTlLKVtqUXQ0Y291lNaSsuMcS6mdfnUHxdGCqGSljUBibdwIsTCVTiRem3+UUktPE7k1a/K5MPDv",
+	"dCbL3kfrOVlzdRcHq1nOA9ZX6xRoTFjdmpdps28Ntu0bDRrakPbnNL2bEm8OBTGqWBJV2VaNf+4UrTmj",
+	"igvjzmt45MsSdJa0DKXdrDhed16JbItbLtt4mhKmYDkHYO7B2IEnkv9/AD6X6CyUdwIA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,7 +8,9 @@ import (
 
 	"github.com/iancoleman/orderedmap"
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/opensvc/om3/core/capacity"
 	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/maintenance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/resource"
@@ -19,6 +21,11 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditRecordListKind.
+const (
+	AuditRecordListKindAuditRecordList AuditRecordListKind = "AuditRecordList"
+)
+
 // Defines values for AuthInfoMethods.
 const (
 	Basic   AuthInfoMethods = "basic"
@@ -27,6 +34,17 @@ const (
 	X509    AuthInfoMethods = "x509"
 )
 
+// Defines values for AuthTokenInfoUse.
+const (
+	Access  AuthTokenInfoUse = "access"
+	Refresh AuthTokenInfoUse = "refresh"
+)
+
+// Defines values for AuthTokenInfoListKind.
+const (
+	AuthTokenInfoListKindAuthTokenInfoList AuthTokenInfoListKind = "AuthTokenInfoList"
+)
+
 // Defines values for CapabilityItemKind.
 const (
 	CapabilityItemKindCapabilityItem CapabilityItemKind = "CapabilityItem"
@@ -37,6 +55,13 @@ const (
 	CapabilityListKindCapabilityList CapabilityListKind = "CapabilityList"
 )
 
+// Defines values for DaemonHeartbeatFaultAction.
+const (
+	Delay DaemonHeartbeatFaultAction = "delay"
+	Drop  DaemonHeartbeatFaultAction = "drop"
+	Pause DaemonHeartbeatFaultAction = "pause"
+)
+
 // Defines values for DiskItemKind.
 const (
 	DiskItemKindDiskItem DiskItemKind = "DiskItem"
@@ -97,6 +122,21 @@ const (
 	InstanceListKindInstanceList InstanceListKind = "InstanceList"
 )
 
+// Defines values for JobState.
+const (
+	Failed    JobState = "failed"
+	Queued    JobState = "queued"
+	Running   JobState = "running"
+	Succeeded JobState = "succeeded"
+	Unknown   JobState = "unknown"
+)
+
+// Defines values for JobType.
+const (
+	Exec          JobType = "exec"
+	Orchestration JobType = "orchestration"
+)
+
 // Defines values for KVStoreKeyListKind.
 const (
 	KVStoreKeyListKindKVStoreKeyList KVStoreKeyListKind = "KVStoreKeyList"
@@ -305,6 +345,16 @@ const (
 	Warn      Status = "warn"
 )
 
+// Defines values for StonithItemKind.
+const (
+	StonithItemKindStonithItem StonithItemKind = "StonithItem"
+)
+
+// Defines values for StonithListKind.
+const (
+	StonithListKindStonithList StonithListKind = "StonithList"
+)
+
 // Defines values for Topology.
 const (
 	Failover Topology = "failover"
@@ -323,10 +373,62 @@ const (
 
 // ArbitratorStatus defines model for ArbitratorStatus.
 type ArbitratorStatus struct {
-	Status Status `json:"status"`
-	Url    string `json:"url"`
+	// Holder the node holding the claim of a disk arbitrator
+	Holder *string `json:"holder,omitempty"`
+	Status Status  `json:"status"`
+	Url    string  `json:"url"`
 }
 
+// AuditRecord defines model for AuditRecord.
+type AuditRecord struct {
+	// Action The api route of the request.
+	Action string `json:"action"`
+
+	// At The time the request was received.
+	At     time.Time          `json:"at"`
+	Grants []string           `json:"grants"`
+	Id     openapi_types.UUID `json:"id"`
+	Method string             `json:"method"`
+
+	// Node The node serving the request.
+	Node string `json:"node"`
+
+	// Params The request path and query parameters. The request body is not
+	// recorded, as it may contain secrets.
+	Params map[string][]string `json:"params"`
+
+	// Path The request url path.
+	Path string `json:"path"`
+
+	// Source The client address.
+	Source string `json:"source"`
+
+	// Status The response status code.
+	Status int `json:"status"`
+
+	// Strategy The authentication strategy of the user.
+	Strategy string `json:"strategy"`
+
+	// TargetNode The node targeted by the request, if any.
+	TargetNode *string `json:"target_node,omitempty"`
+
+	// TargetPath The object targeted by the request, if any.
+	TargetPath *string `json:"target_path,omitempty"`
+	User       string  `json:"user"`
+}
+
+// AuditRecordItems defines model for AuditRecordItems.
+type AuditRecordItems = []AuditRecord
+
+// AuditRecordList defines model for AuditRecordList.
+type AuditRecordList struct {
+	Items AuditRecordItems    `json:"items"`
+	Kind  AuditRecordListKind `json:"kind"`
+}
+
+// AuditRecordListKind defines model for AuditRecordList.Kind.
+type AuditRecordListKind string
+
 // AuthInfo defines model for AuthInfo.
 type AuthInfo struct {
 	Methods []AuthInfoMethods `json:"methods"`
@@ -341,8 +443,63 @@ type AuthInfoMethods string
 
 // AuthToken defines model for AuthToken.
 type AuthToken struct {
+	ExpiredAt        time.Time  `json:"expired_at"`
+	RefreshExpiredAt *time.Time `json:"refresh_expired_at,omitempty"`
+	RefreshToken     *string    `json:"refresh_token,omitempty"`
+	RefreshTokenId   *string    `json:"refresh_token_id,omitempty"`
+	Token            string     `json:"token"`
+	TokenId          *string    `json:"token_id,omitempty"`
+}
+
+// AuthTokenInfo defines model for AuthTokenInfo.
+type AuthTokenInfo struct {
 	ExpiredAt time.Time `json:"expired_at"`
-	Token     string    `json:"token"`
+	Grants    []string  `json:"grants"`
+	Id        string    `json:"id"`
+	IssuedAt  time.Time `json:"issued_at"`
+
+	// Issuer The node that issued the token.
+	Issuer string `json:"issuer"`
+
+	// Use The token usage, access or refresh.
+	Use  AuthTokenInfoUse `json:"use"`
+	User string           `json:"user"`
+}
+
+// AuthTokenInfoUse The token usage, access or refresh.
+type AuthTokenInfoUse string
+
+// AuthTokenInfoItems defines model for AuthTokenInfoItems.
+type AuthTokenInfoItems = []AuthTokenInfo
+
+// AuthTokenInfoList defines model for AuthTokenInfoList.
+type AuthTokenInfoList struct {
+	Items AuthTokenInfoItems    `json:"items"`
+	Kind  AuthTokenInfoListKind `json:"kind"`
+}
+
+// AuthTokenInfoListKind defines model for AuthTokenInfoList.Kind.
+type AuthTokenInfoListKind string
+
+// AuthTokenRegistry defines model for AuthTokenRegistry.
+type AuthTokenRegistry struct {
+	Issued  []AuthTokenInfo       `json:"issued"`
+	Revoked []AuthTokenRevocation `json:"revoked"`
+}
+
+// AuthTokenRevocation defines model for AuthTokenRevocation.
+type AuthTokenRevocation struct {
+	// ExpiredAt The time after which the revoked tokens are expired, so the
+	// revocation can be forgotten.
+	ExpiredAt time.Time `json:"expired_at"`
+
+	// Id The revoked token id.
+	Id        *string   `json:"id,omitempty"`
+	RevokedAt time.Time `json:"revoked_at"`
+	RevokedBy string    `json:"revoked_by"`
+
+	// User The user whose tokens issued until revoked_at are revoked.
+	User *string `json:"user,omitempty"`
 }
 
 // Capability defines model for Capability.
@@ -372,6 +529,9 @@ type CapabilityList struct {
 // CapabilityListKind defines model for CapabilityList.Kind.
 type CapabilityListKind string
 
+// Capacity an amount of node resources
+type Capacity = capacity.T
+
 // Cluster defines model for Cluster.
 type Cluster struct {
 	Config ClusterConfig `json:"config"`
@@ -422,6 +582,11 @@ type DRBDConfig struct {
 	Data []byte `json:"data"`
 }
 
+// DaemonHeartbeatFaultAction * pause: the messages are held, the last one is delivered when the fault expires
+// * drop: the messages are discarded
+// * delay: the messages are delivered after the fault delay
+type DaemonHeartbeatFaultAction string
+
 // DaemonLocal defines model for DaemonLocal.
 type DaemonLocal struct {
 	Nodename string `json:"nodename"`
@@ -433,6 +598,101 @@ type DaemonPid struct {
 	Pid int `json:"pid"`
 }
 
+// DaemonStats defines model for DaemonStats.
+type DaemonStats struct {
+	// Nodes the statistics of the reachable nodes, sorted by name
+	Nodes []DaemonStatsNode `json:"nodes"`
+}
+
+// DaemonStatsAPI defines model for DaemonStatsAPI.
+type DaemonStatsAPI struct {
+	Code   string `json:"code"`
+	Count  uint64 `json:"count"`
+	Method string `json:"method"`
+}
+
+// DaemonStatsHeartbeat defines model for DaemonStatsHeartbeat.
+type DaemonStatsHeartbeat struct {
+	Name    string `json:"name"`
+	RxBytes uint64 `json:"rx_bytes"`
+	TxBytes uint64 `json:"tx_bytes"`
+	Type    string `json:"type"`
+}
+
+// DaemonStatsMemory defines model for DaemonStatsMemory.
+type DaemonStatsMemory struct {
+	// Alloc the bytes of allocated heap objects
+	Alloc uint64 `json:"alloc"`
+
+	// Gc the number of completed gc cycles
+	Gc uint32 `json:"gc"`
+
+	// HeapInuse the bytes in in-use heap spans
+	HeapInuse uint64 `json:"heap_inuse"`
+
+	// Sys the bytes of memory obtained from the os
+	Sys uint64 `json:"sys"`
+}
+
+// DaemonStatsNode defines model for DaemonStatsNode.
+type DaemonStatsNode struct {
+	// Api the api request counts by method and status code
+	Api []DaemonStatsAPI `json:"api"`
+
+	// Goroutines the number of daemon goroutines
+	Goroutines int                    `json:"goroutines"`
+	Heartbeats []DaemonStatsHeartbeat `json:"heartbeats"`
+	Memory     DaemonStatsMemory      `json:"memory"`
+	Node       string                 `json:"node"`
+
+	// Objects the resource usage of the running object instances
+	Objects    []DaemonStatsObject    `json:"objects"`
+	Pubsub     DaemonStatsPubsub      `json:"pubsub"`
+	Subsystems []DaemonStatsSubsystem `json:"subsystems"`
+	Time       time.Time              `json:"time"`
+}
+
+// DaemonStatsObject defines model for DaemonStatsObject.
+type DaemonStatsObject struct {
+	// CpuTime the cpu time consumed by the object processes
+	CpuTime string `json:"cpu_time"`
+
+	// Mem the memory usage of the object processes, in bytes
+	Mem  uint64 `json:"mem"`
+	Path string `json:"path"`
+}
+
+// DaemonStatsPubsub defines model for DaemonStatsPubsub.
+type DaemonStatsPubsub struct {
+	// Dropped the count of messages not delivered by the subscriptions,
+	// including the stopped ones
+	Dropped       uint64                    `json:"dropped"`
+	Subscriptions []DaemonStatsSubscription `json:"subscriptions"`
+}
+
+// DaemonStatsSubscription defines model for DaemonStatsSubscription.
+type DaemonStatsSubscription struct {
+	// Dropped the count of messages not delivered to the subscriber
+	Dropped   uint64 `json:"dropped"`
+	Family    string `json:"family"`
+	Name      string `json:"name"`
+	QueueSize uint64 `json:"queue_size"`
+
+	// Queued the count of messages waiting for the subscriber
+	Queued uint64 `json:"queued"`
+
+	// QueuedMax the high threshold of the queued messages
+	QueuedMax uint64 `json:"queued_max"`
+}
+
+// DaemonStatsSubsystem defines model for DaemonStatsSubsystem.
+type DaemonStatsSubsystem struct {
+	Goroutines int `json:"goroutines"`
+
+	// Name the daemon package running the goroutines
+	Name string `json:"name"`
+}
+
 // DaemonStatus defines model for DaemonStatus.
 type DaemonStatus struct {
 	Cluster Cluster     `json:"cluster"`
@@ -602,6 +862,7 @@ type Instance struct {
 
 // InstanceActionAccepted defines model for InstanceActionAccepted.
 type InstanceActionAccepted struct {
+	JobID     openapi_types.UUID `json:"job_id"`
 	SessionID openapi_types.UUID `json:"session_id"`
 }
 
@@ -645,6 +906,58 @@ type InstanceMonitor = instance.Monitor
 // InstanceStatus defines model for InstanceStatus.
 type InstanceStatus = instance.Status
 
+// Job defines model for Job.
+type Job struct {
+	Action    string             `json:"action"`
+	CreatedAt time.Time          `json:"created_at"`
+	EndedAt   *time.Time         `json:"ended_at,omitempty"`
+	Id        openapi_types.UUID `json:"id"`
+	Instances []JobInstance      `json:"instances"`
+
+	// Node The node the job was submitted to.
+	Node string `json:"node"`
+	Path string `json:"path"`
+
+	// StartedAt The start time of the first command.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	State     JobState   `json:"state"`
+
+	// Type The job type: "orchestration" for the object actions, or "exec"
+	// for the instance actions.
+	Type JobType `json:"type"`
+}
+
+// JobState defines model for Job.State.
+type JobState string
+
+// JobType The job type: "orchestration" for the object actions, or "exec"
+// for the instance actions.
+type JobType string
+
+// JobExec defines model for JobExec.
+type JobExec struct {
+	Command string     `json:"command"`
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	Error   *string    `json:"error,omitempty"`
+
+	// ExitCode The command exit code, unset while running.
+	ExitCode  *int      `json:"exit_code,omitempty"`
+	StartedAt time.Time `json:"started_at"`
+
+	// Stderr The command stderr, truncated to its last 64KiB.
+	Stderr string `json:"stderr"`
+
+	// Stdout The command stdout, truncated to its last 64KiB.
+	Stdout string `json:"stdout"`
+	Title  string `json:"title"`
+}
+
+// JobInstance defines model for JobInstance.
+type JobInstance struct {
+	Execs []JobExec `json:"execs"`
+	Node  string    `json:"node"`
+}
+
 // KVStoreEntries defines model for KVStoreEntries.
 type KVStoreEntries = []KVStoreEntry
 
@@ -716,6 +1029,9 @@ type Kind = naming.Kind
 // LogList responseLogList is a list of sse
 type LogList = openapi_types.File
 
+// MaintenanceWindow the active maintenance window suspending the HA orchestration
+type MaintenanceWindow = maintenance.Window
+
 // Network defines model for Network.
 type Network struct {
 	Errors  *[]string `json:"errors,omitempty"`
@@ -824,6 +1140,9 @@ type NodeMeta struct {
 // NodeMonitor defines model for NodeMonitor.
 type NodeMonitor = node.Monitor
 
+// NodeRollingRestart defines model for NodeRollingRestart.
+type NodeRollingRestart = node.RollingRestart
+
 // NodeStatus defines model for NodeStatus.
 type NodeStatus = node.Status
 
@@ -852,13 +1171,24 @@ type ObjectData struct {
 
 	// PlacementState object placement state
 	PlacementState PlacementState `json:"placement_state"`
-	Pool           *string        `json:"pool,omitempty"`
-	Priority       int            `json:"priority"`
+
+	// PlacementViolations the affinity constraints not satisfied by the instances,
+	// indexed by node name.
+	PlacementViolations *map[string][]string `json:"placement_violations,omitempty"`
+	Pool                *string              `json:"pool,omitempty"`
+	Priority            int                  `json:"priority"`
 
 	// Provisioned service, instance or resource provisioned state
 	Provisioned Provisioned `json:"provisioned"`
-	Scope       []string    `json:"scope"`
-	Size        *int64      `json:"size,omitempty"`
+
+	// Scale the number of slices of a scaler object.
+	Scale *int `json:"scale,omitempty"`
+
+	// ScalerSlices the avail status of the slices of a scaler object, indexed by
+	// slice path.
+	ScalerSlices *map[string]Status `json:"scaler_slices,omitempty"`
+	Scope        []string           `json:"scope"`
+	Size         *int64             `json:"size,omitempty"`
 
 	// Topology object topology
 	Topology         Topology `json:"topology"`
@@ -901,7 +1231,9 @@ type Orchestrate string
 
 // OrchestrationQueued defines model for OrchestrationQueued.
 type OrchestrationQueued struct {
-	OrchestrationID openapi_types.UUID `json:"orchestration_id"`
+	// JobID The object orchestration job id. Unset for the node orchestrations.
+	JobID           *openapi_types.UUID `json:"job_id,omitempty"`
+	OrchestrationID openapi_types.UUID  `json:"orchestration_id"`
 }
 
 // Package defines model for Package.
@@ -1032,6 +1364,37 @@ type PoolVolumeList struct {
 // PoolVolumeListKind defines model for PoolVolumeList.Kind.
 type PoolVolumeListKind string
 
+// PostClusterActionRollingRestart defines model for PostClusterActionRollingRestart.
+type PostClusterActionRollingRestart struct {
+	// Binary the path of the om binary to install on each node before its
+	// daemon restart. The file must exist on all nodes.
+	Binary *string `json:"binary,omitempty"`
+
+	// Resume resume the last rolling restart, skipping the nodes it already
+	// restarted
+	Resume *bool `json:"resume,omitempty"`
+}
+
+// PostDaemonHeartbeatFault defines model for PostDaemonHeartbeatFault.
+type PostDaemonHeartbeatFault struct {
+	// Action * pause: the messages are held, the last one is delivered when the fault expires
+	// * drop: the messages are discarded
+	// * delay: the messages are delivered after the fault delay
+	Action DaemonHeartbeatFaultAction `json:"action"`
+
+	// Delay the delay of the messages, for the delay action
+	Delay *string `json:"delay,omitempty"`
+
+	// Duration the duration of the fault injection
+	Duration string `json:"duration"`
+
+	// Hb the heartbeat stream id
+	Hb string `json:"hb"`
+
+	// Peer the peer node the fault applies to, all peers if not set
+	Peer *string `json:"peer,omitempty"`
+}
+
 // PostDaemonLogsControl defines model for PostDaemonLogsControl.
 type PostDaemonLogsControl struct {
 	Level PostDaemonLogsControlLevel `json:"level"`
@@ -1080,6 +1443,11 @@ type PostRelayMessage struct {
 	ClusterName string `json:"cluster_name"`
 	Msg         string `json:"msg"`
 	Nodename    string `json:"nodename"`
+
+	// Seq The message sequence number stamped by the posting node. The
+	// relays replicating the messages keep the message with the
+	// highest sequence number, whatever their clocks.
+	Seq *int64 `json:"seq,omitempty"`
 }
 
 // Problem defines model for Problem.
@@ -1141,27 +1509,42 @@ type Region struct {
 
 // RelayMessage defines model for RelayMessage.
 type RelayMessage struct {
-	ClusterID   string    `json:"cluster_id"`
-	ClusterName string    `json:"cluster_name"`
-	Msg         string    `json:"msg"`
-	NodeAddr    string    `json:"node_addr"`
-	Nodename    string    `json:"nodename"`
-	Relay       string    `json:"relay"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	Username    string    `json:"username"`
+	ClusterID   string `json:"cluster_id"`
+	ClusterName string `json:"cluster_name"`
+	Msg         string `json:"msg"`
+	NodeAddr    string `json:"node_addr"`
+	Nodename    string `json:"nodename"`
+	Relay       string `json:"relay"`
+
+	// Seq The message sequence number stamped by the posting node. Zero
+	// if the posting node does not stamp its messages.
+	Seq       int64     `json:"seq"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Username  string    `json:"username"`
 }
 
 // RelayStatusItem defines model for RelayStatusItem.
 type RelayStatusItem struct {
-	ClusterID   string    `json:"cluster_id"`
-	ClusterName string    `json:"cluster_name"`
-	MsgLen      int       `json:"msg_len"`
-	NodeAddr    string    `json:"node_addr"`
-	Nodename    string    `json:"nodename"`
-	Relay       string    `json:"relay"`
-	Status      string    `json:"status"`
-	UpdatedAt   time.Time `json:"updated_at"`
-	Username    string    `json:"username"`
+	// Age The duration since the node posted the message, as a freshness
+	// indicator.
+	Age         string `json:"age"`
+	ClusterID   string `json:"cluster_id"`
+	ClusterName string `json:"cluster_name"`
+	MsgLen      int    `json:"msg_len"`
+	NodeAddr    string `json:"node_addr"`
+	Nodename    string `json:"nodename"`
+	Relay       string `json:"relay"`
+
+	// ReplicatedFrom The peer relay the message was replicated from. Empty if the
+	// message was posted by the node.
+	ReplicatedFrom *string `json:"replicated_from,omitempty"`
+
+	// ReplicationLag The duration between the node post on the peer relay and the
+	// replica storage on this relay.
+	ReplicationLag *string   `json:"replication_lag,omitempty"`
+	Status         string    `json:"status"`
+	UpdatedAt      time.Time `json:"updated_at"`
+	Username       string    `json:"username"`
 }
 
 // RelayStatusItems defines model for RelayStatusItems.
@@ -1250,8 +1633,16 @@ type ResourceMonitor = instance.ResourceMonitor
 
 // ResourceMonitorRestart defines model for ResourceMonitorRestart.
 type ResourceMonitorRestart struct {
-	LastAt    time.Time `json:"last_at"`
-	Remaining int       `json:"remaining"`
+	// Count the number of restarts since the resource was last stable
+	Count int `json:"count"`
+
+	// IsFlapping the resource was restarted more than once without being stable
+	IsFlapping bool      `json:"is_flapping"`
+	LastAt     time.Time `json:"last_at"`
+	Remaining  int       `json:"remaining"`
+
+	// UpAt the first time the resource was seen up after its last restart
+	UpAt time.Time `json:"up_at"`
 }
 
 // ResourceProvisionStatus defines model for ResourceProvisionStatus.
@@ -1368,6 +1759,31 @@ type ScheduleListKind string
 // Status defines model for Status.
 type Status string
 
+// Stonith defines model for Stonith.
+type Stonith = node.StonithRecord
+
+// StonithItem defines model for StonithItem.
+type StonithItem struct {
+	Data Stonith         `json:"data"`
+	Kind StonithItemKind `json:"kind"`
+	Meta NodeMeta        `json:"meta"`
+}
+
+// StonithItemKind defines model for StonithItem.Kind.
+type StonithItemKind string
+
+// StonithItems defines model for StonithItems.
+type StonithItems = []StonithItem
+
+// StonithList defines model for StonithList.
+type StonithList struct {
+	Items StonithItems    `json:"items"`
+	Kind  StonithListKind `json:"kind"`
+}
+
+// StonithListKind defines model for StonithList.Kind.
+type StonithListKind string
+
 // SubsetConfig defines model for SubsetConfig.
 type SubsetConfig = instance.SubsetConfig
 
@@ -1389,7 +1805,11 @@ type UserIdentity struct {
 	Grant     map[string][]string `json:"grant"`
 	Name      string              `json:"name"`
 	Namespace string              `json:"namespace"`
-	RawGrant  string              `json:"raw_grant"`
+
+	// Permissions the effective permissions of the user grants, with the custom
+	// roles resolved.
+	Permissions *[]UserPermission `json:"permissions,omitempty"`
+	RawGrant    string            `json:"raw_grant"`
 }
 
 // UserItem defines model for UserItem.
@@ -1414,6 +1834,23 @@ type UserList struct {
 // UserListKind defines model for UserList.Kind.
 type UserListKind string
 
+// UserPermission defines model for UserPermission.
+type UserPermission struct {
+	// Grant the grant giving the permission
+	Grant string `json:"grant"`
+
+	// Namespaces the namespace patterns of the objects the operations are
+	// allowed on. All namespaces if not set.
+	Namespaces *[]string `json:"namespaces,omitempty"`
+
+	// Operations the allowed api operation patterns
+	Operations []string `json:"operations"`
+
+	// Selector the path patterns of the objects the operations are allowed
+	// on. All objects if not set.
+	Selector *[]string `json:"selector,omitempty"`
+}
+
 // DRBDConfigName defines model for DRBDConfigName.
 type DRBDConfigName = string
 
@@ -1480,6 +1917,9 @@ type Roles = []Role
 // SelectorOptional defines model for SelectorOptional.
 type SelectorOptional = string
 
+// InHeaderLastEventID defines model for inHeaderLastEventID.
+type InHeaderLastEventID = uint64
+
 // InPathKind defines model for inPathKind.
 type InPathKind = Kind
 
@@ -1507,6 +1947,9 @@ type InQueryDisableRollback = bool
 // InQueryEvaluate Dereference, scope and convert the keyword raw value.
 type InQueryEvaluate = bool
 
+// InQueryEventSince defines model for inQueryEventSince.
+type InQueryEventSince = uint64
+
 // InQueryForce defines model for inQueryForce.
 type InQueryForce = bool
 
@@ -1543,6 +1986,12 @@ type InQueryTag = string
 // InQueryTo defines model for inQueryTo.
 type InQueryTo = string
 
+// InQueryTokenID defines model for inQueryTokenID.
+type InQueryTokenID = string
+
+// InQueryTokenUser defines model for inQueryTokenUser.
+type InQueryTokenUser = string
+
 // InQueryUnsets defines model for inQueryUnsets.
 type InQueryUnsets = []string
 
@@ -1567,12 +2016,39 @@ type N408 = Problem
 // N409 defines model for 409.
 type N409 = Problem
 
+// N410 defines model for 410.
+type N410 = Problem
+
 // N500 defines model for 500.
 type N500 = Problem
 
 // N503 defines model for 503.
 type N503 = Problem
 
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	// Since only report the records more recent than this duration
+	Since *string `form:"since,omitempty" json:"since,omitempty"`
+
+	// Node only report the records of requests served by this node
+	Node *string `form:"node,omitempty" json:"node,omitempty"`
+
+	// User only report the records of requests of this user
+	User *string `form:"user,omitempty" json:"user,omitempty"`
+
+	// Path only report the records of requests targeting this object
+	Path *string `form:"path,omitempty" json:"path,omitempty"`
+
+	// Limit limit items count
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostAuthRefreshParams defines parameters for PostAuthRefresh.
+type PostAuthRefreshParams struct {
+	// Duration max token duration, maximum value 24h
+	Duration *string `form:"duration,omitempty" json:"duration,omitempty"`
+}
+
 // PostAuthTokenParams defines parameters for PostAuthToken.
 type PostAuthTokenParams struct {
 	// Role list of api role
@@ -1580,6 +2056,43 @@ type PostAuthTokenParams struct {
 
 	// Duration max token duration, maximum value 24h
 	Duration *string `form:"duration,omitempty" json:"duration,omitempty"`
+
+	// Grant limit the token grants to this subset of the caller grants
+	Grant *[]string `form:"grant,omitempty" json:"grant,omitempty"`
+
+	// Namespace limit the token grants to these namespaces. A root caller gets
+	// the admin grant on these namespaces.
+	Namespace *[]string `form:"namespace,omitempty" json:"namespace,omitempty"`
+
+	// Refresh also return a refresh token
+	Refresh *bool `form:"refresh,omitempty" json:"refresh,omitempty"`
+
+	// RefreshDuration max refresh token duration, maximum value 720h
+	RefreshDuration *string `form:"refresh_duration,omitempty" json:"refresh_duration,omitempty"`
+}
+
+// DeleteAuthTokensParams defines parameters for DeleteAuthTokens.
+type DeleteAuthTokensParams struct {
+	// Id the token id
+	Id *InQueryTokenID `form:"id,omitempty" json:"id,omitempty"`
+
+	// User the token user
+	User *InQueryTokenUser `form:"user,omitempty" json:"user,omitempty"`
+}
+
+// GetAuthTokensParams defines parameters for GetAuthTokens.
+type GetAuthTokensParams struct {
+	// Id the token id
+	Id *InQueryTokenID `form:"id,omitempty" json:"id,omitempty"`
+
+	// User the token user
+	User *InQueryTokenUser `form:"user,omitempty" json:"user,omitempty"`
+}
+
+// GetClusterStonithParams defines parameters for GetClusterStonith.
+type GetClusterStonithParams struct {
+	// Peer the name of a fenced node
+	Peer *string `form:"peer,omitempty" json:"peer,omitempty"`
 }
 
 // PostDaemonJoinParams defines parameters for PostDaemonJoin.
@@ -1594,6 +2107,12 @@ type PostDaemonLeaveParams struct {
 	Node string `form:"node" json:"node"`
 }
 
+// GetDaemonStatsParams defines parameters for GetDaemonStats.
+type GetDaemonStatsParams struct {
+	// Local only report the local node statistics
+	Local *bool `form:"local,omitempty" json:"local,omitempty"`
+}
+
 // GetDaemonStatusParams defines parameters for GetDaemonStatus.
 type GetDaemonStatusParams struct {
 	// Namespace namespace
@@ -1612,6 +2131,12 @@ type GetInstancesParams struct {
 	Node *NodeOptional `form:"node,omitempty" json:"node,omitempty"`
 }
 
+// GetJobParams defines parameters for GetJob.
+type GetJobParams struct {
+	// Local only report the local node view of the job
+	Local *bool `form:"local,omitempty" json:"local,omitempty"`
+}
+
 // GetNetworksParams defines parameters for GetNetworks.
 type GetNetworksParams struct {
 	// Name the name of a cluster backend network
@@ -1713,6 +2238,23 @@ type GetDaemonEventsParams struct {
 
 	// Selector selector
 	Selector *SelectorOptional `form:"selector,omitempty" json:"selector,omitempty"`
+
+	// Since the id of the last event received, to stream the events
+	// published after it. The Last-Event-ID header has precedence.
+	Since *InQueryEventSince `form:"since,omitempty" json:"since,omitempty"`
+
+	// LastEventID the id of the last event received, to stream the events
+	// published after it
+	LastEventID *InHeaderLastEventID `json:"Last-Event-ID,omitempty"`
+}
+
+// DeleteDaemonHeartbeatFaultParams defines parameters for DeleteDaemonHeartbeatFault.
+type DeleteDaemonHeartbeatFaultParams struct {
+	// Hb the heartbeat stream id
+	Hb string `form:"hb" json:"hb"`
+
+	// Peer the peer node of the fault to clear. All the stream faults are cleared if not set.
+	Peer *string `form:"peer,omitempty" json:"peer,omitempty"`
 }
 
 // GetNodeDRBDConfigParams defines parameters for GetNodeDRBDConfig.
@@ -2017,6 +2559,15 @@ type GetResourcesParams struct {
 	Resource *RidOptional `form:"resource,omitempty" json:"resource,omitempty"`
 }
 
+// PostAuditJSONRequestBody defines body for PostAudit for application/json ContentType.
+type PostAuditJSONRequestBody = AuditRecordItems
+
+// PostAuthTokensReplicaJSONRequestBody defines body for PostAuthTokensReplica for application/json ContentType.
+type PostAuthTokensReplicaJSONRequestBody = AuthTokenRegistry
+
+// PostClusterActionRollingRestartJSONRequestBody defines body for PostClusterActionRollingRestart for application/json ContentType.
+type PostClusterActionRollingRestartJSONRequestBody = PostClusterActionRollingRestart
+
 // PostDaemonLogsControlJSONRequestBody defines body for PostDaemonLogsControl for application/json ContentType.
 type PostDaemonLogsControlJSONRequestBody = PostDaemonLogsControl
 
@@ -2029,6 +2580,9 @@ type PostInstanceProgressJSONRequestBody = PostInstanceProgress
 // PostInstanceStatusJSONRequestBody defines body for PostInstanceStatus for application/json ContentType.
 type PostInstanceStatusJSONRequestBody = InstanceStatus
 
+// PostDaemonHeartbeatFaultJSONRequestBody defines body for PostDaemonHeartbeatFault for application/json ContentType.
+type PostDaemonHeartbeatFaultJSONRequestBody = PostDaemonHeartbeatFault
+
 // PostNodeDRBDConfigJSONRequestBody defines body for PostNodeDRBDConfig for application/json ContentType.
 type PostNodeDRBDConfigJSONRequestBody = PostNodeDRBDConfigRequest
 
@@ -2043,3 +2597,6 @@ type PatchObjectKVStoreJSONRequestBody = PatchKVStoreEntries
 
 // PostRelayMessageJSONRequestBody defines body for PostRelayMessage for application/json ContentType.
 type PostRelayMessageJSONRequestBody = PostRelayMessage
+
+// PostRelayReplicaJSONRequestBody defines body for PostRelayReplica for application/json ContentType.
+type PostRelayReplicaJSONRequestBody = RelayMessage
@@ -347,9 +347,18 @@ func (t ObjectData) Unstructured() map[string]any {
 		"up_instances_count": t.UpInstancesCount,
 		"updated_at":         t.UpdatedAt,
 	}
+	if t.PlacementViolations != nil {
+		m["placement_violations"] = *t.PlacementViolations
+	}
 	if t.Pool != nil {
 		m["pool"] = *t.Pool
 	}
+	if t.Scale != nil {
+		m["scale"] = *t.Scale
+	}
+	if t.ScalerSlices != nil {
+		m["scaler_slices"] = *t.ScalerSlices
+	}
 	if t.Size != nil {
 		m["size"] = *t.Size
 	}
@@ -552,3 +561,37 @@ func (t ResourceInfoItem) Unstructured() map[string]any {
 		"value":  t.Value,
 	}
 }
+
+func (t DaemonStats) GetItems() any {
+	return t.Nodes
+}
+
+func (t DaemonStatsNode) Unstructured() map[string]any {
+	return map[string]any{
+		"api":        t.Api,
+		"goroutines": t.Goroutines,
+		"heartbeats": t.Heartbeats,
+		"memory":     t.Memory.Unstructured(),
+		"node":       t.Node,
+		"objects":    t.Objects,
+		"pubsub":     t.Pubsub.Unstructured(),
+		"subsystems": t.Subsystems,
+		"time":       t.Time,
+	}
+}
+
+func (t DaemonStatsMemory) Unstructured() map[string]any {
+	return map[string]any{
+		"alloc":      t.Alloc,
+		"gc":         t.Gc,
+		"heap_inuse": t.HeapInuse,
+		"sys":        t.Sys,
+	}
+}
+
+func (t DaemonStatsPubsub) Unstructured() map[string]any {
+	return map[string]any{
+		"dropped":       t.Dropped,
+		"subscriptions": t.Subscriptions,
+	}
+}
@@ -0,0 +1,114 @@
+package arbitrator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cvaroqui/ini"
+)
+
+type (
+	// Config is the arbitrator server configuration, loaded from an ini
+	// file like:
+	//
+	//	[listener]
+	//	addr = :1216
+	//	cert = /etc/opensvc/arbitrator.crt
+	//	key = /etc/opensvc/arbitrator.key
+	//
+	//	[vote]
+	//	exclusive = true
+	//	ttl = 1m
+	//
+	//	[cluster#cluster1]
+	//	secret = 3dc6e3c0ba6f4d5aa41d34dc0a5b3bd2
+	Config struct {
+		// Addr is the listener address.
+		Addr string
+
+		// CertFile and KeyFile are the listener tls certificate chain and
+		// private key files.
+		CertFile string
+		KeyFile  string
+
+		// Exclusive enables the single partition vote mode: once granted to
+		// a partition of a cluster, the vote is refused to the nodes of the
+		// other partitions until TTL elapsed since the last vote request of
+		// the granted partition.
+		Exclusive bool
+		TTL       time.Duration
+
+		// Clusters is the secrets map, indexed by cluster name.
+		Clusters map[string]string
+	}
+)
+
+var (
+	// DefaultAddr is the default arbitrator listener address.
+	DefaultAddr = ":1216"
+
+	// DefaultTTL is the default duration of an exclusive vote grant.
+	DefaultTTL = time.Minute
+)
+
+// LoadConfig returns the arbitrator server configuration read from the ini
+// file <p>.
+func LoadConfig(p string) (*Config, error) {
+	f, err := ini.LoadSources(ini.LoadOptions{SpaceBeforeInlineComment: true}, p)
+	if err != nil {
+		return nil, err
+	}
+	return parseConfig(f)
+}
+
+func parseConfig(f *ini.File) (*Config, error) {
+	cfg := &Config{
+		Addr:     DefaultAddr,
+		TTL:      DefaultTTL,
+		Clusters: make(map[string]string),
+	}
+	if s, err := f.GetSection("listener"); err == nil {
+		if v := s.Key("addr").String(); v != "" {
+			cfg.Addr = v
+		}
+		cfg.CertFile = s.Key("cert").String()
+		cfg.KeyFile = s.Key("key").String()
+	}
+	if s, err := f.GetSection("vote"); err == nil {
+		if s.HasKey("exclusive") {
+			if v, err := s.Key("exclusive").Bool(); err != nil {
+				return nil, fmt.Errorf("vote.exclusive: %w", err)
+			} else {
+				cfg.Exclusive = v
+			}
+		}
+		if s.HasKey("ttl") {
+			if v, err := time.ParseDuration(s.Key("ttl").String()); err != nil {
+				return nil, fmt.Errorf("vote.ttl: %w", err)
+			} else if v <= 0 {
+				return nil, fmt.Errorf("vote.ttl: must be positive")
+			} else {
+				cfg.TTL = v
+			}
+		}
+	}
+	for _, s := range f.Sections() {
+		name, ok := strings.CutPrefix(s.Name(), "cluster#")
+		if !ok {
+			continue
+		}
+		secret := s.Key("secret").String()
+		if name == "" || secret == "" {
+			return nil, fmt.Errorf("%s: empty cluster name or secret", s.Name())
+		}
+		cfg.Clusters[name] = secret
+	}
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, fmt.Errorf("listener.cert and listener.key are required")
+	}
+	if len(cfg.Clusters) == 0 {
+		return nil, fmt.Errorf("no cluster#<name> section")
+	}
+	return cfg, nil
+}
@@ -0,0 +1,244 @@
+/*
+Package arbitrator implements a standalone arbitrator server.
+
+The arbitrator runs on a third site, without a cluster node installation,
+and gives its vote to the nodes of split clusters. A single arbitrator
+server can serve many clusters, each cluster authenticating with its own
+secret.
+
+The nodes send requests to the arbitrator uri, using the http basic
+authentication with the cluster name as username and the cluster
+arbitrator secret as password:
+
+  - GET: verify the arbitrator is reachable and the credentials are valid.
+  - POST: ask for a vote, with a VoteRequest json body.
+
+In the exclusive mode, the vote is granted to a single partition of a split
+cluster: the partition of the first node asking for a vote. The nodes of
+other partitions are refused the vote until the grant expires.
+*/
+package arbitrator
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	golog "log"
+	"net"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	// T is the arbitrator server.
+	T struct {
+		cfg *Config
+		log *plog.Logger
+
+		// grants is the exclusive mode vote grants, indexed by cluster name.
+		grants map[string]grant
+		mu     sync.Mutex
+
+		// now is the time source, replaced by tests.
+		now func() time.Time
+	}
+
+	grant struct {
+		nodes    []string
+		expireAt time.Time
+	}
+
+	// VoteRequest is the body of a vote request.
+	VoteRequest struct {
+		// Node is the name of the node asking for a vote.
+		Node string `json:"node"`
+
+		// Partition is the list of the nodes the requester can reach,
+		// including itself.
+		Partition []string `json:"partition"`
+	}
+
+	// VoteResponse is the body of a vote or a check response.
+	VoteResponse struct {
+		Granted bool `json:"granted"`
+
+		// Holder is the list of the nodes of the partition holding the
+		// exclusive vote grant.
+		Holder []string `json:"holder,omitempty"`
+	}
+)
+
+const (
+	// maxBodySize is the maximum accepted request body size.
+	maxBodySize = 64 * 1024
+)
+
+// New returns an arbitrator server using the <cfg> configuration.
+func New(cfg *Config) *T {
+	return &T{
+		cfg:    cfg,
+		grants: make(map[string]grant),
+		now:    time.Now,
+		log: plog.NewDefaultLogger().
+			Attr("pkg", "daemon/arbitrator").
+			WithPrefix("arbitrator: "),
+	}
+}
+
+// Run serves the arbitrator requests until <ctx> is done.
+func (t *T) Run(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:    t.cfg.Addr,
+		Handler: t,
+		TLSConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+		},
+		ReadHeaderTimeout: 5 * time.Second,
+		ReadTimeout:       10 * time.Second,
+		WriteTimeout:      10 * time.Second,
+		IdleTimeout:       60 * time.Second,
+		MaxHeaderBytes:    16 * 1024,
+		ErrorLog:          golog.New(t.log.Logger(), "", 0),
+	}
+	lsnr, err := net.Listen("tcp", t.cfg.Addr)
+	if err != nil {
+		return err
+	}
+	errC := make(chan error, 1)
+	go func() {
+		errC <- srv.ServeTLS(lsnr, t.cfg.CertFile, t.cfg.KeyFile)
+	}()
+	t.log.Infof("listening on %s, serving clusters %s, exclusive vote %v", lsnr.Addr(), t.clusterNames(), t.cfg.Exclusive)
+	select {
+	case <-ctx.Done():
+		t.log.Infof("stopping")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdownCtx)
+	case err := <-errC:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+func (t *T) clusterNames() []string {
+	l := make([]string, 0, len(t.cfg.Clusters))
+	for name := range t.cfg.Clusters {
+		l = append(l, name)
+	}
+	sort.Strings(l)
+	return l
+}
+
+// ServeHTTP implements the http.Handler interface for T.
+func (t *T) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	clusterName, ok := t.authenticate(r)
+	if !ok {
+		t.log.Warnf("%s %s from %s: authentication failed", r.Method, r.URL.Path, r.RemoteAddr)
+		w.Header().Set("WWW-Authenticate", `Basic realm="arbitrator"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		node := r.URL.Query().Get("node")
+		t.log.Infof("cluster %s node %s from %s: check", clusterName, node, r.RemoteAddr)
+		t.writeResponse(w, http.StatusOK, VoteResponse{Granted: true, Holder: t.holder(clusterName)})
+	case http.MethodPost:
+		var req VoteRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodySize)).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("invalid vote request: %s", err), http.StatusBadRequest)
+			return
+		}
+		if req.Node == "" {
+			http.Error(w, "invalid vote request: empty node", http.StatusBadRequest)
+			return
+		}
+		resp := t.vote(clusterName, req)
+		if resp.Granted {
+			t.log.Infof("cluster %s node %s from %s: vote granted (partition %s)", clusterName, req.Node, r.RemoteAddr, req.Partition)
+			t.writeResponse(w, http.StatusOK, resp)
+		} else {
+			t.log.Warnf("cluster %s node %s from %s: vote refused (partition %s, holder %s)", clusterName, req.Node, r.RemoteAddr, req.Partition, resp.Holder)
+			t.writeResponse(w, http.StatusConflict, resp)
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (t *T) writeResponse(w http.ResponseWriter, code int, resp VoteResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// authenticate returns the name of the cluster matching the request basic
+// authentication credentials.
+func (t *T) authenticate(r *http.Request) (string, bool) {
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return "", false
+	}
+	secret, ok := t.cfg.Clusters[username]
+	if !ok {
+		return "", false
+	}
+	if subtle.ConstantTimeCompare([]byte(password), []byte(secret)) != 1 {
+		return "", false
+	}
+	return username, true
+}
+
+// vote returns the response to the <req> vote request of a <clusterName>
+// node.
+//
+// Outside the exclusive mode, the vote is always granted. In the exclusive
+// mode, the vote is granted to the requester partition if no other partition
+// holds an unexpired grant. A granted vote request extends the grant.
+func (t *T) vote(clusterName string, req VoteRequest) VoteResponse {
+	if !t.cfg.Exclusive {
+		return VoteResponse{Granted: true}
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	g, ok := t.grants[clusterName]
+	if ok && now.Before(g.expireAt) && !slices.Contains(g.nodes, req.Node) {
+		return VoteResponse{Granted: false, Holder: g.nodes}
+	}
+	if !ok || !now.Before(g.expireAt) {
+		nodes := append([]string{}, req.Partition...)
+		if !slices.Contains(nodes, req.Node) {
+			nodes = append(nodes, req.Node)
+		}
+		sort.Strings(nodes)
+		g = grant{nodes: nodes}
+		t.log.Infof("cluster %s: grant the vote to partition %s for %s", clusterName, nodes, t.cfg.TTL)
+	}
+	g.expireAt = now.Add(t.cfg.TTL)
+	t.grants[clusterName] = g
+	return VoteResponse{Granted: true, Holder: g.nodes}
+}
+
+// holder returns the nodes of the partition holding the unexpired
+// exclusive vote grant of <clusterName>.
+func (t *T) holder(clusterName string) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if g, ok := t.grants[clusterName]; ok && t.now().Before(g.expireAt) {
+		return g.nodes
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package arbitrator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cvaroqui/ini"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfig(t *testing.T) {
+	f, err := ini.Load([]byte(`
+[listener]
+cert = /tmp/arbitrator.crt
+key = /tmp/arbitrator.key
+
+[vote]
+exclusive = true
+ttl = 30s
+
+[cluster#c1]
+secret = s1
+
+[cluster#c2]
+secret = s2
+`))
+	require.NoError(t, err)
+	cfg, err := parseConfig(f)
+	require.NoError(t, err)
+	require.Equal(t, DefaultAddr, cfg.Addr)
+	require.True(t, cfg.Exclusive)
+	require.Equal(t, 30*time.Second, cfg.TTL)
+	require.Equal(t, map[string]string{"c1": "s1", "c2": "s2"}, cfg.Clusters)
+
+	f, err = ini.Load([]byte(`
+[listener]
+cert = /tmp/arbitrator.crt
+key = /tmp/arbitrator.key
+`))
+	require.NoError(t, err)
+	_, err = parseConfig(f)
+	require.ErrorContains(t, err, "no cluster#<name> section")
+}
+
+func TestServeHTTP(t *testing.T) {
+	now := time.Now()
+	a := New(&Config{
+		Exclusive: true,
+		TTL:       time.Minute,
+		Clusters:  map[string]string{"c1": "s1", "c2": "s2"},
+	})
+	a.now = func() time.Time { return now }
+
+	do := func(method, username, password string, body any) (int, VoteResponse) {
+		var b []byte
+		if body != nil {
+			b, _ = json.Marshal(body)
+		}
+		req := httptest.NewRequest(method, "/?node=n1", bytes.NewReader(b))
+		req.SetBasicAuth(username, password)
+		w := httptest.NewRecorder()
+		a.ServeHTTP(w, req)
+		var resp VoteResponse
+		_ = json.NewDecoder(w.Body).Decode(&resp)
+		return w.Code, resp
+	}
+
+	t.Run("check with invalid credentials", func(t *testing.T) {
+		code, _ := do(http.MethodGet, "c1", "s2", nil)
+		require.Equal(t, http.StatusUnauthorized, code)
+		code, _ = do(http.MethodGet, "c3", "s1", nil)
+		require.Equal(t, http.StatusUnauthorized, code)
+	})
+
+	t.Run("check", func(t *testing.T) {
+		code, resp := do(http.MethodGet, "c1", "s1", nil)
+		require.Equal(t, http.StatusOK, code)
+		require.True(t, resp.Granted)
+	})
+
+	t.Run("vote is granted to the first partition", func(t *testing.T) {
+		code, resp := do(http.MethodPost, "c1", "s1", VoteRequest{Node: "n1", Partition: []string{"n2", "n1"}})
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, []string{"n1", "n2"}, resp.Holder)
+
+		code, _ = do(http.MethodPost, "c1", "s1", VoteRequest{Node: "n2", Partition: []string{"n1", "n2"}})
+		require.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("vote is refused to the other partitions", func(t *testing.T) {
+		code, resp := do(http.MethodPost, "c1", "s1", VoteRequest{Node: "n3", Partition: []string{"n3"}})
+		require.Equal(t, http.StatusConflict, code)
+		require.False(t, resp.Granted)
+		require.Equal(t, []string{"n1", "n2"}, resp.Holder)
+	})
+
+	t.Run("vote grants are per cluster", func(t *testing.T) {
+		code, _ := do(http.MethodPost, "c2", "s2", VoteRequest{Node: "n3", Partition: []string{"n3"}})
+		require.Equal(t, http.StatusOK, code)
+	})
+
+	t.Run("vote is granted to another partition after grant expire", func(t *testing.T) {
+		now = now.Add(2 * time.Minute)
+		code, resp := do(http.MethodPost, "c1", "s1", VoteRequest{Node: "n3", Partition: []string{"n3"}})
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, []string{"n3"}, resp.Holder)
+	})
+
+	t.Run("invalid vote request", func(t *testing.T) {
+		code, _ := do(http.MethodPost, "c1", "s1", VoteRequest{})
+		require.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("unsupported method", func(t *testing.T) {
+		code, _ := do(http.MethodDelete, "c1", "s1", nil)
+		require.Equal(t, http.StatusMethodNotAllowed, code)
+	})
+}
@@ -0,0 +1,207 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	// Forwarder opens the audit trail on start, and forwards the records of
+	// the requests served by this node to the peer nodes.
+	Forwarder struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		log    *plog.Logger
+		wg     sync.WaitGroup
+
+		localhost string
+
+		// peers is the forward queues, indexed by peer nodename.
+		peers map[string]*forwardPeer
+	}
+
+	forwardPeer struct {
+		c      chan api.AuditRecord
+		cancel context.CancelFunc
+	}
+)
+
+var (
+	// forwardQueueSize is the size of the forward queues. When a queue is
+	// full, the record is not forwarded.
+	forwardQueueSize = 1000
+
+	// forwardMaxPending is the maximum number of records kept for a peer
+	// node not reachable. The oldest records are dropped first.
+	forwardMaxPending = 10000
+
+	// forwardBatchSize is the maximum number of records of a forward post.
+	forwardBatchSize = 500
+
+	// forwardInterval is the interval between two forward posts to a peer,
+	// and between two peer list updates.
+	forwardInterval = time.Second
+
+	forwardTimeout = 5 * time.Second
+)
+
+func NewForwarder() *Forwarder {
+	return &Forwarder{
+		localhost: hostname.Hostname(),
+		log: plog.NewDefaultLogger().
+			Attr("pkg", "daemon/audit").
+			WithPrefix("daemon: audit: "),
+		peers: make(map[string]*forwardPeer),
+	}
+}
+
+// Start opens the audit trail and launches the forward worker goroutine.
+func (t *Forwarder) Start(parent context.Context) error {
+	t.ctx, t.cancel = context.WithCancel(parent)
+	store, err := Open(Dir())
+	if err != nil {
+		return fmt.Errorf("open audit store: %w", err)
+	}
+	forwardC := make(chan api.AuditRecord, forwardQueueSize)
+	Trail.open(store, forwardC)
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		t.worker(forwardC)
+	}()
+	return nil
+}
+
+func (t *Forwarder) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return Trail.close()
+}
+
+func (t *Forwarder) worker(forwardC <-chan api.AuditRecord) {
+	ticker := time.NewTicker(forwardInterval)
+	defer ticker.Stop()
+	defer t.stopPeers()
+	t.updatePeers()
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+			t.updatePeers()
+		case r := <-forwardC:
+			for _, peer := range t.peers {
+				select {
+				case peer.c <- r:
+				default:
+				}
+			}
+		}
+	}
+}
+
+// updatePeers starts the forward goroutines of the new cluster nodes, and
+// stops the ones of the nodes no longer in the cluster.
+func (t *Forwarder) updatePeers() {
+	nodes := cluster.ConfigData.Get().Nodes
+	for nodename, peer := range t.peers {
+		if !nodes.Contains(nodename) {
+			peer.cancel()
+			delete(t.peers, nodename)
+		}
+	}
+	for _, nodename := range nodes {
+		if _, ok := t.peers[nodename]; ok || nodename == t.localhost {
+			continue
+		}
+		ctx, cancel := context.WithCancel(t.ctx)
+		peer := &forwardPeer{
+			c:      make(chan api.AuditRecord, forwardQueueSize),
+			cancel: cancel,
+		}
+		t.peers[nodename] = peer
+		t.wg.Add(1)
+		go t.peerWorker(ctx, nodename, peer.c)
+	}
+}
+
+func (t *Forwarder) stopPeers() {
+	for nodename, peer := range t.peers {
+		peer.cancel()
+		delete(t.peers, nodename)
+	}
+}
+
+// peerWorker posts the queued records to the <nodename> peer. The records
+// are kept until posted, so a peer node down receives the records on
+// recovery. The forward errors are logged on the first error and on
+// recovery only, to not flood the logs when a peer is down.
+func (t *Forwarder) peerWorker(ctx context.Context, nodename string, c <-chan api.AuditRecord) {
+	defer t.wg.Done()
+	var (
+		lastErr error
+		pending []api.AuditRecord
+	)
+	ticker := time.NewTicker(forwardInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case r := <-c:
+			pending = append(pending, r)
+			if n := len(pending) - forwardMaxPending; n > 0 {
+				pending = pending[n:]
+			}
+		case <-ticker.C:
+			if len(pending) == 0 {
+				continue
+			}
+			batch := pending[:min(len(pending), forwardBatchSize)]
+			err := t.forward(ctx, nodename, batch)
+			switch {
+			case err != nil && lastErr == nil:
+				t.log.Warnf("forward to %s: %s", nodename, err)
+			case err == nil && lastErr != nil:
+				t.log.Infof("forward to %s: recovered", nodename)
+			}
+			lastErr = err
+			if err == nil {
+				pending = pending[len(batch):]
+			}
+		}
+	}
+}
+
+func (t *Forwarder) forward(ctx context.Context, nodename string, l []api.AuditRecord) error {
+	cli, err := client.New(
+		client.WithURL(nodename),
+		client.WithUsername(t.localhost),
+		client.WithPassword(cluster.ConfigData.Get().Secret()),
+		client.WithTimeout(forwardTimeout),
+	)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, forwardTimeout)
+	defer cancel()
+	resp, err := cli.PostAudit(ctx, l)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Package audit implements the audit trail of the mutating api requests.
+
+The api middleware records each POST, PUT, PATCH and DELETE request served
+by the daemon, with the authenticated user, the target and the outcome. The
+records are appended to a local rotated store, and forwarded to the peer
+nodes, so any node can answer the audit queries for the whole cluster.
+*/
+package audit
+
+import (
+	"errors"
+	"path/filepath"
+	"sync"
+
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	// T is an audit trail.
+	T struct {
+		mu    sync.RWMutex
+		store *Store
+
+		// forwardC receives the records of the requests served by this
+		// node, for the forwarder to send to the peer nodes.
+		forwardC chan api.AuditRecord
+	}
+)
+
+var (
+	// Trail is the daemon audit trail. It is opened and closed by the
+	// Forwarder.
+	Trail = &T{}
+
+	ErrClosed = errors.New("audit trail is closed")
+)
+
+// Dir returns the audit store directory.
+func Dir() string {
+	return filepath.Join(rawconfig.Paths.Var, "audit")
+}
+
+func (t *T) open(store *Store, forwardC chan api.AuditRecord) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.store = store
+	t.forwardC = forwardC
+}
+
+func (t *T) close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.store == nil {
+		return nil
+	}
+	err := t.store.Close()
+	t.store = nil
+	t.forwardC = nil
+	return err
+}
+
+// Record stores the record <r> of a request served by this node, and queues
+// it for the peer nodes. The record is not forwarded if the queue is full.
+func (t *T) Record(r api.AuditRecord) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.store == nil {
+		return ErrClosed
+	}
+	if err := t.store.Append(r); err != nil {
+		return err
+	}
+	select {
+	case t.forwardC <- r:
+	default:
+	}
+	return nil
+}
+
+// StoreForwarded stores the records <l> forwarded by a peer node.
+func (t *T) StoreForwarded(l []api.AuditRecord) error {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.store == nil {
+		return ErrClosed
+	}
+	return t.store.Append(l...)
+}
+
+// Query returns the records matching <f>, the oldest first.
+func (t *T) Query(f Filter) (api.AuditRecordItems, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.store == nil {
+		return nil, ErrClosed
+	}
+	return t.store.Query(f)
+}
@@ -0,0 +1,182 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	// Store is an append-only store of audit records. The records are
+	// written as json lines in a file rotated on size.
+	Store struct {
+		mu     sync.Mutex
+		dir    string
+		writer *lumberjack.Logger
+	}
+
+	// Filter selects the records returned by a Store query. The zero value
+	// fields don't filter.
+	Filter struct {
+		Since time.Time
+		Node  string
+		User  string
+		Path  string
+
+		// Limit is the maximum number of records returned. The most recent
+		// records are kept.
+		Limit int
+	}
+)
+
+var (
+	// MaxSize is the size in megabytes of the audit file before rotation.
+	MaxSize = 10
+
+	// MaxBackups is the number of rotated audit files to keep.
+	MaxBackups = 10
+
+	// maxLineSize is the maximum size of a record line read by the queries.
+	maxLineSize = 1024 * 1024
+)
+
+const (
+	fileBasename = "audit"
+	fileExt      = ".jsonl"
+)
+
+// Open returns the audit Store of the directory <dir>.
+func Open(dir string) (*Store, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	p := filepath.Join(dir, fileBasename+fileExt)
+
+	// create the file with restricted permissions, the rotated files
+	// inherit them.
+	if f, err := os.OpenFile(p, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600); err != nil {
+		return nil, err
+	} else if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return &Store{
+		dir: dir,
+		writer: &lumberjack.Logger{
+			Filename:   p,
+			MaxSize:    MaxSize,
+			MaxBackups: MaxBackups,
+		},
+	}, nil
+}
+
+// Append writes the records <l> to the store.
+func (s *Store) Append(l ...api.AuditRecord) error {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	for _, r := range l {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err := s.writer.Write(b.Bytes())
+	return err
+}
+
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.writer.Close()
+}
+
+// files returns the store files, the oldest first.
+func (s *Store) files() ([]string, error) {
+	l, err := filepath.Glob(filepath.Join(s.dir, fileBasename+"-*"+fileExt))
+	if err != nil {
+		return nil, err
+	}
+	// the rotated files are suffixed with their rotation time
+	sort.Strings(l)
+	return append(l, filepath.Join(s.dir, fileBasename+fileExt)), nil
+}
+
+// Query returns the records matching <f>, the oldest first. The records
+// stored more than once, by a retried forward, are returned once.
+func (s *Store) Query(f Filter) (api.AuditRecordItems, error) {
+	files, err := s.files()
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	l := make(api.AuditRecordItems, 0)
+	for _, p := range files {
+		if !f.Since.IsZero() {
+			if info, err := os.Stat(p); err == nil && info.ModTime().Before(f.Since) {
+				continue
+			}
+		}
+		if err := s.scan(p, func(r api.AuditRecord) {
+			id := r.Id.String()
+			if seen[id] || !f.match(r) {
+				return
+			}
+			seen[id] = true
+			l = append(l, r)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	sort.SliceStable(l, func(i, j int) bool { return l[i].At.Before(l[j].At) })
+	if f.Limit > 0 && len(l) > f.Limit {
+		l = l[len(l)-f.Limit:]
+	}
+	return l, nil
+}
+
+// scan calls fn for each record of the file <p>. The malformed lines, like
+// a line being written, are skipped.
+func (s *Store) scan(p string, fn func(api.AuditRecord)) error {
+	file, err := os.Open(p)
+	if os.IsNotExist(err) {
+		// rotated by a concurrent write
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		var r api.AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			continue
+		}
+		fn(r)
+	}
+	return scanner.Err()
+}
+
+func (f Filter) match(r api.AuditRecord) bool {
+	switch {
+	case !f.Since.IsZero() && r.At.Before(f.Since):
+		return false
+	case f.Node != "" && r.Node != f.Node:
+		return false
+	case f.User != "" && r.User != f.User:
+		return false
+	case f.Path != "" && (r.TargetPath == nil || *r.TargetPath != f.Path):
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/daemon/api"
+)
+
+func newRecord(node, user, path string, at time.Time) api.AuditRecord {
+	r := api.AuditRecord{
+		Id:     uuid.New(),
+		At:     at,
+		Node:   node,
+		User:   user,
+		Method: "POST",
+		Params: map[string][]string{},
+		Status: 200,
+	}
+	if path != "" {
+		r.TargetPath = &path
+	}
+	return r
+}
+
+func TestStore(t *testing.T) {
+	dir := t.TempDir()
+	s, err := Open(dir)
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+
+	info, err := os.Stat(filepath.Join(dir, "audit.jsonl"))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	now := time.Now()
+	r1 := newRecord("n1", "root", "ns1/svc/s1", now.Add(-2*time.Hour))
+	r2 := newRecord("n2", "alice", "", now.Add(-time.Minute))
+	r3 := newRecord("n1", "alice", "ns1/svc/s1", now.Add(-2*time.Minute))
+	require.NoError(t, s.Append(r1))
+	require.NoError(t, s.Append(r2, r3))
+
+	t.Logf("a forward retry stores a record twice")
+	require.NoError(t, s.Append(r2))
+
+	ids := func(l api.AuditRecordItems) []uuid.UUID {
+		out := make([]uuid.UUID, len(l))
+		for i, r := range l {
+			out[i] = r.Id
+		}
+		return out
+	}
+	cases := map[string]struct {
+		filter   Filter
+		expected []uuid.UUID
+	}{
+		"all, oldest first":  {filter: Filter{}, expected: []uuid.UUID{r1.Id, r3.Id, r2.Id}},
+		"since":              {filter: Filter{Since: now.Add(-time.Hour)}, expected: []uuid.UUID{r3.Id, r2.Id}},
+		"node":               {filter: Filter{Node: "n1"}, expected: []uuid.UUID{r1.Id, r3.Id}},
+		"user":               {filter: Filter{User: "alice"}, expected: []uuid.UUID{r3.Id, r2.Id}},
+		"path":               {filter: Filter{Path: "ns1/svc/s1"}, expected: []uuid.UUID{r1.Id, r3.Id}},
+		"limit keeps recent": {filter: Filter{Limit: 1}, expected: []uuid.UUID{r2.Id}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l, err := s.Query(tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, ids(l))
+		})
+	}
+}
+
+func TestStoreRotatedFiles(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	r1 := newRecord("n1", "root", "", now.Add(-time.Hour))
+	r2 := newRecord("n1", "root", "", now)
+
+	// simulate a rotated file, with a malformed line
+	s, err := Open(dir)
+	require.NoError(t, err)
+	require.NoError(t, s.Append(r1))
+	require.NoError(t, s.Close())
+	rotated := filepath.Join(dir, "audit-2024-01-01T00-00-00.000.jsonl")
+	require.NoError(t, os.Rename(filepath.Join(dir, "audit.jsonl"), rotated))
+	f, err := os.OpenFile(rotated, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString("{\"id\": \"trunc")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	s, err = Open(dir)
+	require.NoError(t, err)
+	defer func() { _ = s.Close() }()
+	require.NoError(t, s.Append(r2))
+
+	l, err := s.Query(Filter{})
+	require.NoError(t, err)
+	require.Len(t, l, 2)
+	require.Equal(t, r1.Id, l[0].Id)
+	require.Equal(t, r2.Id, l[1].Id)
+}
@@ -0,0 +1,314 @@
+/*
+Package authtoken implements the registry of the tokens issued by the
+cluster nodes, and of their revocations.
+
+The jwt auth strategy refuses the revoked tokens. The registry is persisted,
+and replicated to the peer nodes by the Replicator. The replication merge is
+a union of the issued tokens and revocations, so the registries converge
+whatever the node posting its registry.
+*/
+package authtoken
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	Info       = api.AuthTokenInfo
+	Revocation = api.AuthTokenRevocation
+
+	// T is a token registry.
+	T struct {
+		mu sync.RWMutex
+
+		// issued is the issued tokens, indexed by token id.
+		issued map[string]Info
+
+		// revoked is the revocations, indexed by revocationKey.
+		revoked map[string]Revocation
+
+		// file is the registry file. The registry is not persisted if
+		// empty.
+		file string
+
+		// changed is signaled on local changes, for the replicator to
+		// post the registry to the peer nodes.
+		changed chan struct{}
+	}
+)
+
+const (
+	UseAccess  = api.Access
+	UseRefresh = api.Refresh
+)
+
+var (
+	// Registry is the daemon token registry.
+	Registry = New()
+
+	// MaxDuration is the maximum duration of the tokens. It is the
+	// retention of the user revocations.
+	MaxDuration = 30 * 24 * time.Hour
+
+	// RevocationSkew is the margin added to the user revocation time. The
+	// tokens issued less than RevocationSkew after a user revocation are
+	// also revoked, as the token issue time has a second resolution and
+	// the issuing node clock may be late on the revoking node clock.
+	RevocationSkew = 5 * time.Second
+
+	ErrInvalidRevocation = errors.New("a revocation requires a token id or a user")
+)
+
+func New() *T {
+	return &T{
+		issued:  make(map[string]Info),
+		revoked: make(map[string]Revocation),
+		changed: make(chan struct{}, 1),
+	}
+}
+
+// Dir returns the registry persistence directory.
+func Dir() string {
+	return filepath.Join(rawconfig.Paths.Var, "auth")
+}
+
+// revocationKey returns the key of the revocation <r>. The user revocations
+// share the same key, as the most recent revocation of a user covers the
+// previous ones.
+func revocationKey(r Revocation) string {
+	if r.Id != nil && *r.Id != "" {
+		return "id:" + *r.Id
+	}
+	if r.User != nil && *r.User != "" {
+		return "user:" + *r.User
+	}
+	return ""
+}
+
+// Changed returns the channel signaled on local changes.
+func (t *T) Changed() <-chan struct{} {
+	return t.changed
+}
+
+func (t *T) notify() {
+	select {
+	case t.changed <- struct{}{}:
+	default:
+	}
+}
+
+// Add registers the issued token <info>.
+func (t *T) Add(info Info) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.issued[info.Id] = info
+	t.notify()
+	return t.persist()
+}
+
+// Lookup returns the issued token <id>.
+func (t *T) Lookup(id string) (Info, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	info, ok := t.issued[id]
+	return info, ok
+}
+
+// Revoke registers the revocation <r>.
+func (t *T) Revoke(r Revocation) error {
+	key := revocationKey(r)
+	if key == "" {
+		return ErrInvalidRevocation
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.mergeRevocation(key, r)
+	t.notify()
+	return t.persist()
+}
+
+// mergeRevocation stores <r> if more recent than the stored revocation with
+// the same key, and returns true if stored.
+func (t *T) mergeRevocation(key string, r Revocation) bool {
+	if v, ok := t.revoked[key]; ok && !r.RevokedAt.After(v.RevokedAt) {
+		return false
+	}
+	t.revoked[key] = r
+	return true
+}
+
+// IsRevoked returns true if the token <id> of <user> issued at <issuedAt> is
+// revoked by id, or by a user revocation more recent than <issuedAt>, give
+// or take RevocationSkew.
+func (t *T) IsRevoked(id, user string, issuedAt time.Time) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if id != "" {
+		if _, ok := t.revoked["id:"+id]; ok {
+			return true
+		}
+	}
+	if r, ok := t.revoked["user:"+user]; ok && issuedAt.Before(r.RevokedAt.Add(RevocationSkew)) {
+		return true
+	}
+	return false
+}
+
+// List returns the active tokens, the oldest first. The empty <id> and
+// <user> don't filter.
+func (t *T) List(id, user string) []Info {
+	now := time.Now()
+	t.mu.RLock()
+	l := make([]Info, 0)
+	for _, info := range t.issued {
+		switch {
+		case id != "" && info.Id != id:
+		case user != "" && info.User != user:
+		case !info.ExpiredAt.After(now):
+		default:
+			l = append(l, info)
+		}
+	}
+	t.mu.RUnlock()
+	active := l[:0]
+	for _, info := range l {
+		if !t.IsRevoked(info.Id, info.User, info.IssuedAt) {
+			active = append(active, info)
+		}
+	}
+	sort.Slice(active, func(i, j int) bool { return active[i].IssuedAt.Before(active[j].IssuedAt) })
+	return active
+}
+
+// Snapshot returns the issued tokens and revocations not expired.
+func (t *T) Snapshot() api.AuthTokenRegistry {
+	now := time.Now()
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	data := api.AuthTokenRegistry{
+		Issued:  make([]Info, 0, len(t.issued)),
+		Revoked: make([]Revocation, 0, len(t.revoked)),
+	}
+	for _, info := range t.issued {
+		if info.ExpiredAt.After(now) {
+			data.Issued = append(data.Issued, info)
+		}
+	}
+	for _, r := range t.revoked {
+		if r.ExpiredAt.After(now) {
+			data.Revoked = append(data.Revoked, r)
+		}
+	}
+	return data
+}
+
+// Merge adds the issued tokens and revocations of <data> replicated from a
+// peer node. The merge is not signaled as a local change.
+func (t *T) Merge(data api.AuthTokenRegistry) error {
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var changed bool
+	for _, info := range data.Issued {
+		if _, ok := t.issued[info.Id]; ok || info.Id == "" || !info.ExpiredAt.After(now) {
+			continue
+		}
+		t.issued[info.Id] = info
+		changed = true
+	}
+	for _, r := range data.Revoked {
+		key := revocationKey(r)
+		if key == "" || !r.ExpiredAt.After(now) {
+			continue
+		}
+		if t.mergeRevocation(key, r) {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return t.persist()
+}
+
+// Prune forgets the expired tokens and revocations.
+func (t *T) Prune() error {
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var changed bool
+	for id, info := range t.issued {
+		if !info.ExpiredAt.After(now) {
+			delete(t.issued, id)
+			changed = true
+		}
+	}
+	for key, r := range t.revoked {
+		if !r.ExpiredAt.After(now) {
+			delete(t.revoked, key)
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return t.persist()
+}
+
+// Load enables the registry persistence in <file>, and loads the registry
+// persisted in <file>.
+func (t *T) Load(file string) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.file = file
+	t.mu.Unlock()
+	b, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var data api.AuthTokenRegistry
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+	return t.Merge(data)
+}
+
+// persist writes the registry file. The file is written to a temporary file
+// then renamed, so a daemon crash can't leave a partially written file.
+func (t *T) persist() error {
+	if t.file == "" {
+		return nil
+	}
+	data := api.AuthTokenRegistry{
+		Issued:  make([]Info, 0, len(t.issued)),
+		Revoked: make([]Revocation, 0, len(t.revoked)),
+	}
+	for _, info := range t.issued {
+		data.Issued = append(data.Issued, info)
+	}
+	for _, r := range t.revoked {
+		data.Revoked = append(data.Revoked, r)
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	tmp := t.file + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, t.file)
+}
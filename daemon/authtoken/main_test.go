@@ -0,0 +1,122 @@
+package authtoken
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/daemon/api"
+)
+
+func newInfo(id, user string, issuedAt time.Time) Info {
+	return Info{
+		Id:        id,
+		User:      user,
+		Use:       UseAccess,
+		Grants:    []string{"guest:ns1"},
+		Issuer:    "node1",
+		IssuedAt:  issuedAt,
+		ExpiredAt: issuedAt.Add(time.Hour),
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	now := time.Now()
+	r := New()
+	require.NoError(t, r.Add(newInfo("id1", "alice", now.Add(-2*time.Minute))))
+	require.NoError(t, r.Add(newInfo("id2", "alice", now.Add(-time.Minute))))
+	require.NoError(t, r.Add(newInfo("id3", "bob", now)))
+	expired := newInfo("id4", "bob", now.Add(-2*time.Hour))
+	require.NoError(t, r.Add(expired))
+
+	t.Run("list skips the expired tokens", func(t *testing.T) {
+		l := r.List("", "")
+		require.Len(t, l, 3)
+		require.Equal(t, "id1", l[0].Id)
+		require.Len(t, r.List("", "bob"), 1)
+		require.Len(t, r.List("id2", ""), 1)
+	})
+
+	t.Run("revoke by id", func(t *testing.T) {
+		id := "id3"
+		require.NoError(t, r.Revoke(Revocation{Id: &id, RevokedAt: now, ExpiredAt: now.Add(time.Hour)}))
+		require.True(t, r.IsRevoked("id3", "bob", now))
+		require.Len(t, r.List("", "bob"), 0)
+	})
+
+	t.Run("revoke by user only revokes the tokens issued until the revocation", func(t *testing.T) {
+		user := "alice"
+		at := now.Add(-90 * time.Second)
+		require.NoError(t, r.Revoke(Revocation{User: &user, RevokedAt: at, ExpiredAt: now.Add(time.Hour)}))
+		require.True(t, r.IsRevoked("id1", "alice", now.Add(-2*time.Minute)))
+		require.False(t, r.IsRevoked("id2", "alice", now.Add(-time.Minute)))
+		require.False(t, r.IsRevoked("", "alice", now))
+	})
+
+	t.Run("revoke by user revokes the tokens issued in the skew margin", func(t *testing.T) {
+		user := "carol"
+		at := now.Add(-time.Minute)
+		require.NoError(t, r.Revoke(Revocation{User: &user, RevokedAt: at, ExpiredAt: now.Add(time.Hour)}))
+		require.True(t, r.IsRevoked("", "carol", at.Truncate(time.Second)), "same second issue time")
+		require.True(t, r.IsRevoked("", "carol", at.Add(RevocationSkew-time.Second)), "late issuer clock")
+		require.False(t, r.IsRevoked("", "carol", at.Add(RevocationSkew)))
+	})
+
+	t.Run("revoke requires an id or a user", func(t *testing.T) {
+		require.ErrorIs(t, r.Revoke(Revocation{RevokedAt: now}), ErrInvalidRevocation)
+	})
+}
+
+func TestRegistryMerge(t *testing.T) {
+	now := time.Now()
+	user := "alice"
+	r := New()
+	require.NoError(t, r.Merge(api.AuthTokenRegistry{
+		Issued: []Info{newInfo("id1", "alice", now.Add(-time.Minute))},
+		Revoked: []Revocation{
+			{User: &user, RevokedAt: now.Add(-2 * time.Minute), ExpiredAt: now.Add(time.Hour)},
+		},
+	}))
+	require.Len(t, r.List("", ""), 1)
+	select {
+	case <-r.Changed():
+		t.Fatal("merge must not be signaled as a local change")
+	default:
+	}
+
+	t.Run("older user revocation is ignored", func(t *testing.T) {
+		require.NoError(t, r.Merge(api.AuthTokenRegistry{
+			Revoked: []Revocation{
+				{User: &user, RevokedAt: now.Add(-time.Hour), ExpiredAt: now.Add(time.Hour)},
+			},
+		}))
+		require.False(t, r.IsRevoked("", "alice", now.Add(-time.Minute)))
+	})
+
+	t.Run("more recent user revocation replaces", func(t *testing.T) {
+		require.NoError(t, r.Merge(api.AuthTokenRegistry{
+			Revoked: []Revocation{
+				{User: &user, RevokedAt: now, ExpiredAt: now.Add(time.Hour)},
+			},
+		}))
+		require.True(t, r.IsRevoked("", "alice", now.Add(-time.Minute)))
+		require.Len(t, r.List("", ""), 0)
+	})
+}
+
+func TestRegistryLoad(t *testing.T) {
+	now := time.Now()
+	file := filepath.Join(t.TempDir(), "auth", "tokens.json")
+	r := New()
+	require.NoError(t, r.Load(file))
+	require.NoError(t, r.Add(newInfo("id1", "alice", now)))
+	id := "id2"
+	require.NoError(t, r.Revoke(Revocation{Id: &id, RevokedAt: now, ExpiredAt: now.Add(time.Hour)}))
+
+	loaded := New()
+	require.NoError(t, loaded.Load(file))
+	require.Len(t, loaded.List("", ""), 1)
+	require.True(t, loaded.IsRevoked("id2", "bob", now))
+}
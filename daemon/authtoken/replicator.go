@@ -0,0 +1,142 @@
+package authtoken
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	// Replicator loads the persisted token registry on start, and posts the
+	// registry to the peer nodes on local changes and periodically, so a
+	// node down during a revocation receives it on recovery.
+	Replicator struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		log    *plog.Logger
+		wg     sync.WaitGroup
+
+		localhost string
+
+		// lastErr is the last replication error, indexed by peer nodename.
+		lastErr map[string]error
+		mu      sync.Mutex
+	}
+)
+
+var (
+	// replicateInterval is the interval between two periodic replications
+	// and registry prunes.
+	replicateInterval = time.Minute
+
+	replicateTimeout = 5 * time.Second
+)
+
+func NewReplicator() *Replicator {
+	return &Replicator{
+		localhost: hostname.Hostname(),
+		log: plog.NewDefaultLogger().
+			Attr("pkg", "daemon/authtoken").
+			WithPrefix("daemon: authtoken: "),
+		lastErr: make(map[string]error),
+	}
+}
+
+// Start loads the token registry and launches the replication goroutine.
+func (t *Replicator) Start(parent context.Context) error {
+	t.ctx, t.cancel = context.WithCancel(parent)
+	if err := Registry.Load(filepath.Join(Dir(), "tokens.json")); err != nil {
+		return fmt.Errorf("load token registry: %w", err)
+	}
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		t.worker()
+	}()
+	return nil
+}
+
+func (t *Replicator) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+func (t *Replicator) worker() {
+	ticker := time.NewTicker(replicateInterval)
+	defer ticker.Stop()
+	t.replicate()
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+			if err := Registry.Prune(); err != nil {
+				t.log.Warnf("prune: %s", err)
+			}
+			t.replicate()
+		case <-Registry.Changed():
+			t.replicate()
+		}
+	}
+}
+
+// replicate posts the registry to the peer nodes. The replication errors are
+// logged on the first error and on recovery only.
+func (t *Replicator) replicate() {
+	data := Registry.Snapshot()
+	var wg sync.WaitGroup
+	for _, nodename := range cluster.ConfigData.Get().Nodes {
+		if nodename == t.localhost {
+			continue
+		}
+		wg.Add(1)
+		go func(nodename string) {
+			defer wg.Done()
+			err := t.post(nodename, data)
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			lastErr := t.lastErr[nodename]
+			switch {
+			case err != nil && lastErr == nil:
+				t.log.Warnf("replicate to %s: %s", nodename, err)
+			case err == nil && lastErr != nil:
+				t.log.Infof("replicate to %s: recovered", nodename)
+			}
+			t.lastErr[nodename] = err
+		}(nodename)
+	}
+	wg.Wait()
+}
+
+func (t *Replicator) post(nodename string, data api.AuthTokenRegistry) error {
+	cli, err := client.New(
+		client.WithURL(nodename),
+		client.WithUsername(t.localhost),
+		client.WithPassword(cluster.ConfigData.Get().Secret()),
+		client.WithTimeout(replicateTimeout),
+	)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(t.ctx, replicateTimeout)
+	defer cancel()
+	resp, err := cli.PostAuthTokensReplica(ctx, data)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -6,6 +6,11 @@
 //	  => cluster.ConfigData update => .cluster.config
 //	  => clusternode update (for node selector, clusternodes dereference)
 //	  => publication of msgbus.ClusterConfigUpdated for local node
+//
+// It subscribes on msgbus.DaemonHeartbeatUpdated to drive the cluster
+// secret rotation from the nodes rotation progress:
+//
+//	=> publication of msgbus.ClusterSecretRotationUpdated for local node
 package ccfg
 
 import (
@@ -17,6 +22,7 @@ import (
 
 	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/hostname"
 	"github.com/opensvc/om3/util/plog"
@@ -45,6 +51,13 @@ type (
 		localhost   string
 		change      bool
 
+		// secretRotation is the localhost cluster secret rotation progress
+		secretRotation daemonsubsystem.HeartbeatSecretRotation
+
+		// secretRotationSwitchedAt is the time all cluster nodes were first
+		// seen switched to the next secret
+		secretRotationSwitchedAt time.Time
+
 		sub   *pubsub.Subscription
 		subQS pubsub.QueueSizer
 
@@ -97,6 +110,7 @@ func (t *Manager) Stop() error {
 func (t *Manager) startSubscriptions() {
 	sub := t.bus.Sub("daemon.ccfg", t.subQS)
 	sub.AddFilter(&msgbus.ConfigFileUpdated{}, pubsub.Label{"path", "cluster"})
+	sub.AddFilter(&msgbus.DaemonHeartbeatUpdated{})
 	sub.Start()
 	t.sub = sub
 }
@@ -107,6 +121,9 @@ func (t *Manager) worker() {
 
 	t.startedAt = time.Now()
 
+	secretRotationTicker := time.NewTicker(secretRotationCheckInterval)
+	defer secretRotationTicker.Stop()
+
 	for {
 		select {
 		case <-t.ctx.Done():
@@ -115,12 +132,19 @@ func (t *Manager) worker() {
 			switch c := i.(type) {
 			case *msgbus.ConfigFileUpdated:
 				t.onConfigFileUpdated(c)
+			case *msgbus.DaemonHeartbeatUpdated:
+				t.onDaemonHeartbeatUpdated(c)
 			}
+		case <-secretRotationTicker.C:
+			t.onSecretRotationCheck()
 		}
 	}
 }
 
-// AuthenticateNode returns nil if nodename is a cluster node and password is cluster secret
+// AuthenticateNode returns nil if nodename is a cluster node and password is cluster secret.
+//
+// During a cluster secret rotation, both the current and the next secrets
+// are accepted.
 func (*NodeDB) AuthenticateNode(nodename, password string) error {
 	if nodename == "" {
 		return fmt.Errorf("can't authenticate: nodename is empty")
@@ -133,8 +157,13 @@ func (*NodeDB) AuthenticateNode(nodename, password string) error {
 	if clusterSecret == "" {
 		return fmt.Errorf("can't authenticate: empty cluster secret")
 	}
-	if clusterSecret != password {
-		return fmt.Errorf("can't authenticate: %s has wrong password", nodename)
+	if clusterSecret == password {
+		return nil
 	}
-	return nil
+	for _, altSecret := range clu.AltSecrets() {
+		if altSecret == password {
+			return nil
+		}
+	}
+	return fmt.Errorf("can't authenticate: %s has wrong password", nodename)
 }
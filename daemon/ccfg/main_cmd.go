@@ -21,6 +21,13 @@ func (t *Manager) onConfigFileUpdated(c *msgbus.ConfigFileUpdated) {
 	t.pubClusterConfig()
 }
 
+func (t *Manager) onDaemonHeartbeatUpdated(c *msgbus.DaemonHeartbeatUpdated) {
+	if t.secretRotation.State == "" {
+		return
+	}
+	t.onSecretRotationCheck()
+}
+
 func (t *Manager) pubClusterConfig() {
 	previousNodes := t.state.Nodes
 	state, err := object.SetClusterConfig()
@@ -33,6 +40,7 @@ func (t *Manager) pubClusterConfig() {
 
 	}
 	t.handleConfigChanges()
+	t.loadSecretRotation(&state)
 
 	t.state = *state.DeepCopy()
 	labelLocalNode := pubsub.Label{"node", t.localhost}
@@ -0,0 +1,223 @@
+package ccfg
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+var (
+	// secretRotationGracePeriod is the delay the old cluster secret is still
+	// accepted after all nodes have switched to the next secret.
+	secretRotationGracePeriod = time.Minute
+
+	// secretRotationCheckInterval is the interval of the secret rotation
+	// progress checks.
+	secretRotationCheckInterval = 10 * time.Second
+)
+
+// secretFingerprint returns a short digest identifying a secret, safe to
+// expose in the daemon status.
+func secretFingerprint(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// loadSecretRotation sets the rotation state of the newly loaded cluster
+// config <state> from the localhost rotation progress.
+//
+// A next secret not yet known starts a new rotation in the "accepting" state.
+// The localhost progress is reset when no rotation is in progress.
+func (t *Manager) loadSecretRotation(state *cluster.Config) {
+	var rotation daemonsubsystem.HeartbeatSecretRotation
+	nextSecret := state.NextSecret()
+	switch {
+	case nextSecret == "" || nextSecret == state.Secret():
+	case t.secretRotation.Key == secretFingerprint(nextSecret):
+		rotation = t.secretRotation
+	default:
+		rotation = daemonsubsystem.HeartbeatSecretRotation{
+			State:     daemonsubsystem.SecretRotationAccepting,
+			Key:       secretFingerprint(nextSecret),
+			UpdatedAt: time.Now(),
+		}
+	}
+	state.SetSecretSwitched(rotation.State == daemonsubsystem.SecretRotationSwitched)
+	t.setSecretRotation(rotation)
+}
+
+func (t *Manager) setSecretRotation(rotation daemonsubsystem.HeartbeatSecretRotation) {
+	if rotation == t.secretRotation {
+		return
+	}
+	switch rotation.State {
+	case "":
+		t.log.Infof("cluster secret rotation: done")
+	default:
+		t.log.Infof("cluster secret rotation to %s: %s", rotation.Key, rotation.State)
+	}
+	t.secretRotation = rotation
+	t.secretRotationSwitchedAt = time.Time{}
+	t.bus.Pub(&msgbus.ClusterSecretRotationUpdated{Node: t.localhost, Value: rotation}, pubsub.Label{"node", t.localhost})
+}
+
+// onSecretRotationCheck advances the localhost cluster secret rotation:
+//
+//   - accepting => switched, when all cluster nodes accept the next secret.
+//   - switched => done, when all cluster nodes are switched for longer than
+//     the grace period. Only the speaker re-encrypts the sec and usr keys
+//     values with the next secret, then commits the cluster config change
+//     replacing the secret with the next secret, which is then replicated
+//     to the peers.
+func (t *Manager) onSecretRotationCheck() {
+	switch t.secretRotation.State {
+	case daemonsubsystem.SecretRotationAccepting:
+		if !t.secretRotationReached(daemonsubsystem.SecretRotationAccepting, daemonsubsystem.SecretRotationSwitched) {
+			return
+		}
+		t.switchSecret()
+	case daemonsubsystem.SecretRotationSwitched:
+		if !t.secretRotationReached(daemonsubsystem.SecretRotationSwitched) {
+			t.secretRotationSwitchedAt = time.Time{}
+			return
+		}
+		if t.secretRotationSwitchedAt.IsZero() {
+			t.log.Infof("cluster secret rotation to %s: all nodes switched, retire the old secret in %s", t.secretRotation.Key, secretRotationGracePeriod)
+			t.secretRotationSwitchedAt = time.Now()
+			return
+		}
+		if time.Since(t.secretRotationSwitchedAt) < secretRotationGracePeriod {
+			return
+		}
+		if !t.isSpeaker() {
+			return
+		}
+		t.retireSecret()
+	}
+}
+
+// secretRotationReached returns true if all cluster nodes report a rotation
+// to the localhost rotation key, in one of the <states>.
+func (t *Manager) secretRotationReached(states ...string) bool {
+	for _, nodename := range t.state.Nodes {
+		var rotation daemonsubsystem.HeartbeatSecretRotation
+		if nodename == t.localhost {
+			rotation = t.secretRotation
+		} else if v := daemonsubsystem.DataHeartbeat.Get(nodename); v != nil {
+			rotation = v.SecretRotation
+		} else {
+			return false
+		}
+		if rotation.Key != t.secretRotation.Key {
+			return false
+		}
+		var found bool
+		for _, state := range states {
+			if rotation.State == state {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// switchSecret starts encrypting the heartbeat and inter-node payloads with
+// the next secret.
+func (t *Manager) switchSecret() {
+	t.state.SetSecretSwitched(true)
+	cluster.ConfigData.Set(t.state.DeepCopy())
+	t.setSecretRotation(daemonsubsystem.HeartbeatSecretRotation{
+		State:     daemonsubsystem.SecretRotationSwitched,
+		Key:       t.secretRotation.Key,
+		UpdatedAt: time.Now(),
+	})
+}
+
+// isSpeaker returns true if the localhost is the cluster speaker.
+func (t *Manager) isSpeaker() bool {
+	if v := node.StatusData.Get(t.localhost); v != nil {
+		return v.IsLeader
+	}
+	return false
+}
+
+// reencryptKeystores encrypts again with the next secret the local sec and
+// usr keys values still encrypted with the committed secret. The changes are
+// replicated to the peers like any object config change.
+func (t *Manager) reencryptKeystores() error {
+	paths, err := naming.InstalledPaths()
+	if err != nil {
+		return fmt.Errorf("list installed objects: %w", err)
+	}
+	var errs error
+	for _, p := range paths {
+		if p.Kind != naming.KindSec && p.Kind != naming.KindUsr {
+			continue
+		}
+		ks, err := object.NewKeystore(p)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", p, err))
+			continue
+		}
+		if names, err := ks.Reencrypt(); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: re-encrypt keys: %w", p, err))
+		} else if len(names) > 0 {
+			t.log.Infof("cluster secret rotation: %s: re-encrypted keys %s", p, names)
+		}
+	}
+	return errs
+}
+
+// retireSecret re-encrypts the keystores with the next secret, and commits
+// the cluster config change completing the rotation: the next secret
+// replaces the secret. The commit is retried on next check if a keystore
+// can't be re-encrypted, so no key value becomes undecodable.
+func (t *Manager) retireSecret() {
+	var (
+		keySecret     = key.New("cluster", "secret")
+		keyNextSecret = key.New("cluster", "next_secret")
+	)
+	nextSecret := t.state.NextSecret()
+	if nextSecret == "" {
+		return
+	}
+	if err := t.reencryptKeystores(); err != nil {
+		t.log.Errorf("cluster secret rotation: %s", err)
+		return
+	}
+	t.log.Infof("cluster secret rotation to %s: retire the old secret", t.secretRotation.Key)
+	clu, err := object.NewCluster(object.WithVolatile(false))
+	if err != nil {
+		t.log.Errorf("cluster secret rotation: %s", err)
+		return
+	}
+	c := clu.Config()
+	if err := c.PrepareSet(*keyop.New(keySecret, keyop.Set, nextSecret, 0)); err != nil {
+		t.log.Errorf("cluster secret rotation: %s", err)
+		return
+	}
+	if err := c.PrepareUnset(keyNextSecret); err != nil {
+		t.log.Errorf("cluster secret rotation: %s", err)
+		return
+	}
+	if err := c.Commit(); err != nil {
+		t.log.Errorf("cluster secret rotation: %s", err)
+		return
+	}
+}
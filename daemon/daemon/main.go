@@ -18,6 +18,8 @@ import (
 	"github.com/retailnext/cannula"
 
 	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/daemon/audit"
+	"github.com/opensvc/om3/daemon/authtoken"
 	"github.com/opensvc/om3/daemon/ccfg"
 	"github.com/opensvc/om3/daemon/collector"
 	"github.com/opensvc/om3/daemon/cstat"
@@ -29,13 +31,18 @@ import (
 	"github.com/opensvc/om3/daemon/daemonvip"
 	"github.com/opensvc/om3/daemon/discover"
 	"github.com/opensvc/om3/daemon/dns"
+	"github.com/opensvc/om3/daemon/eventjournal"
 	"github.com/opensvc/om3/daemon/hb"
 	"github.com/opensvc/om3/daemon/hbcache"
 	"github.com/opensvc/om3/daemon/imon"
 	"github.com/opensvc/om3/daemon/istat"
+	"github.com/opensvc/om3/daemon/job"
 	"github.com/opensvc/om3/daemon/listener"
+	"github.com/opensvc/om3/daemon/metrics"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/daemon/nmon"
+	"github.com/opensvc/om3/daemon/notify"
+	"github.com/opensvc/om3/daemon/relay"
 	"github.com/opensvc/om3/daemon/runner"
 	"github.com/opensvc/om3/daemon/scheduler"
 	"github.com/opensvc/om3/util/converters"
@@ -171,9 +178,15 @@ func (t *T) Start(ctx context.Context) error {
 
 	t.ctx = daemonapi.WithSubQS(t.ctx, qsMedium)
 	for _, s := range []startStopper{
+		eventjournal.NewJournaler(qsHuge),
 		hbcache.New(2 * daemonenv.DrainChanDuration),
 		cstat.New(qsMedium),
 		istat.New(qsLarge),
+		job.NewTracker(qsSmall),
+		metrics.New(),
+		relay.NewReplicator(qsSmall),
+		audit.NewForwarder(),
+		authtoken.NewReplicator(),
 		listener.New(),
 		nmon.NewManager(daemonenv.DrainChanDuration, qsMedium),
 		dns.NewManager(daemonenv.DrainChanDuration, qsMedium),
@@ -182,6 +195,7 @@ func (t *T) Start(ctx context.Context) error {
 			WithImonStarter(imonFactory),
 		hb.New(t.ctx),
 		collector.New(t.ctx, qsHuge),
+		notify.NewManager(qsHuge),
 		scheduler.New(qsHuge),
 		daemonvip.New(qsSmall),
 		runner.NewDefault(qsSmall),
@@ -0,0 +1,56 @@
+package daemonapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/shaj13/go-guardian/v2/auth"
+
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/authtoken"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// DeleteAuthTokens revokes the token with the id parameter, or all the tokens
+// issued until now to the user parameter. The non-root callers can only
+// revoke their own tokens.
+func (a *DaemonAPI) DeleteAuthTokens(ctx echo.Context, params api.DeleteAuthTokensParams) error {
+	log := LogHandler(ctx, "DeleteAuthTokens")
+	username := ctx.Get("user").(auth.Info).GetUserName()
+	isRoot := grantsFromContext(ctx).HasGrant(rbac.GrantRoot)
+	now := time.Now()
+	r := authtoken.Revocation{
+		RevokedAt: now,
+		RevokedBy: username,
+	}
+	switch {
+	case params.Id != nil && *params.Id != "":
+		// the revocation is kept until the token expires. The expiration
+		// of a token not yet replicated is unknown, so the revocation is
+		// kept for the maximum token duration.
+		r.Id = params.Id
+		r.ExpiredAt = now.Add(authtoken.MaxDuration)
+		if info, ok := authtoken.Registry.Lookup(*params.Id); ok {
+			if !isRoot && info.User != username {
+				return JSONForbiddenMissingGrant(ctx, rbac.GrantRoot)
+			}
+			r.ExpiredAt = info.ExpiredAt
+		} else if !isRoot {
+			return JSONProblemf(ctx, http.StatusNotFound, "Not found", "token %s", *params.Id)
+		}
+	case params.User != nil && *params.User != "":
+		if !isRoot && *params.User != username {
+			return JSONForbiddenMissingGrant(ctx, rbac.GrantRoot)
+		}
+		r.User = params.User
+		r.ExpiredAt = now.Add(authtoken.MaxDuration)
+	default:
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "The id or user parameter is required")
+	}
+	if err := authtoken.Registry.Revoke(r); err != nil {
+		log.Errorf("revoke: %s", err)
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Revoke", "%s", err)
+	}
+	return JSONProblemf(ctx, http.StatusOK, "revoked", "")
+}
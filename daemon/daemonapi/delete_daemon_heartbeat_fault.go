@@ -0,0 +1,52 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/clusternode"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+func (a *DaemonAPI) DeleteDaemonHeartbeatFault(ctx echo.Context, nodename string, params api.DeleteDaemonHeartbeatFaultParams) error {
+	if nodename == a.localhost {
+		return a.localDeleteDaemonHeartbeatFault(ctx, params)
+	} else if !clusternode.Has(nodename) {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid nodename", "field 'nodename' with value '%s' is not a cluster node", nodename)
+	}
+	c, err := newProxyClient(ctx, nodename)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+	}
+	resp, err := c.DeleteDaemonHeartbeatFaultWithResponse(ctx.Request().Context(), nodename, &params)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+	} else if len(resp.Body) > 0 {
+		return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+	}
+	return nil
+}
+
+func (a *DaemonAPI) localDeleteDaemonHeartbeatFault(ctx echo.Context, params api.DeleteDaemonHeartbeatFaultParams) error {
+	if v, err := assertRole(ctx, rbac.RoleRoot); err != nil {
+		return err
+	} else if !v {
+		return nil
+	}
+	log := LogHandler(ctx, "DeleteDaemonHeartbeatFault")
+	log.Debugf("starting")
+
+	fault := daemonsubsystem.HeartbeatFault{ID: params.Hb}
+	if params.Peer != nil {
+		fault.Peer = *params.Peer
+	}
+	log.Infof("ask to clear faults on %s peer '%s'", fault.ID, fault.Peer)
+	a.EventBus.Pub(&msgbus.HbFaultCtl{Action: "clear", Value: fault},
+		pubsub.Label{"id", fault.ID}, labelAPI, a.LabelNode)
+	return JSONProblemf(ctx, http.StatusOK, "heartbeat fault clear queued", "%s", fault.ID)
+}
@@ -16,7 +16,7 @@ import (
 func (a *DaemonAPI) DeleteObjectKVStoreEntry(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.DeleteObjectKVStoreEntryParams) error {
 	log := LogHandler(ctx, "DeleteObjectKVStoreEntry")
 
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpObjectKVStoreUpdate, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 
@@ -0,0 +1,46 @@
+package daemonapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/audit"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/converters"
+)
+
+// GetAudit returns the audit records of the cluster nodes, from the local
+// audit trail fed by the peer nodes.
+func (a *DaemonAPI) GetAudit(ctx echo.Context, params api.GetAuditParams) error {
+	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+		return err
+	}
+	var filter audit.Filter
+	if params.Since != nil {
+		if v, err := converters.Duration.Convert(*params.Since); err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "field 'since' with value '%s' validation error: %s", *params.Since, err)
+		} else {
+			filter.Since = time.Now().Add(-*v.(*time.Duration))
+		}
+	}
+	if params.Node != nil {
+		filter.Node = *params.Node
+	}
+	if params.User != nil {
+		filter.User = *params.User
+	}
+	if params.Path != nil {
+		filter.Path = *params.Path
+	}
+	if params.Limit != nil {
+		filter.Limit = int(*params.Limit)
+	}
+	items, err := audit.Trail.Query(filter)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Audit query", "%s", err)
+	}
+	return ctx.JSON(http.StatusOK, api.AuditRecordList{Kind: "AuditRecordList", Items: items})
+}
@@ -17,12 +17,16 @@ func (a *DaemonAPI) GetAuthInfo(ctx echo.Context) error {
 	}
 
 	if config.Listener.OpenIDWellKnown != "" {
+		clientID := config.Listener.OpenIDClientID
+		if clientID == "" {
+			clientID = config.Name
+		}
 		data.Methods = append(data.Methods, "openid")
 		data.Openid = &struct {
 			ClientId     string `json:"client_id"`
 			WellKnownUri string `json:"well_known_uri"`
 		}{
-			ClientId:     config.Name,
+			ClientId:     clientID,
 			WellKnownUri: config.Listener.OpenIDWellKnown,
 		}
 	}
@@ -0,0 +1,35 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/shaj13/go-guardian/v2/auth"
+
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/authtoken"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// GetAuthTokens lists the active tokens. The non-root callers only see their
+// own tokens.
+func (a *DaemonAPI) GetAuthTokens(ctx echo.Context, params api.GetAuthTokensParams) error {
+	var id, user string
+	if params.Id != nil {
+		id = *params.Id
+	}
+	if params.User != nil {
+		user = *params.User
+	}
+	if !grantsFromContext(ctx).HasGrant(rbac.GrantRoot) {
+		username := ctx.Get("user").(auth.Info).GetUserName()
+		if user != "" && user != username {
+			return JSONForbiddenMissingGrant(ctx, rbac.GrantRoot)
+		}
+		user = username
+	}
+	return ctx.JSON(http.StatusOK, api.AuthTokenInfoList{
+		Kind:  "AuthTokenInfoList",
+		Items: authtoken.Registry.List(id, user),
+	})
+}
@@ -0,0 +1,31 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+// GetClusterStonith returns the stonith history of the cluster nodes, from
+// the replicated node status data.
+func (a *DaemonAPI) GetClusterStonith(ctx echo.Context, params api.GetClusterStonithParams) error {
+	items := make(api.StonithItems, 0)
+	for _, e := range node.StatusData.GetAll() {
+		for _, record := range e.Value.Stonith {
+			if params.Peer != nil && *params.Peer != record.Peer {
+				continue
+			}
+			items = append(items, api.StonithItem{
+				Kind: "StonithItem",
+				Meta: api.NodeMeta{
+					Node: e.Node,
+				},
+				Data: record,
+			})
+		}
+	}
+	return ctx.JSON(http.StatusOK, api.StonithList{Kind: "StonithList", Items: items})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/core/objectselector"
 	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/eventjournal"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/daemon/rbac"
 	"github.com/opensvc/om3/util/converters"
@@ -32,6 +33,12 @@ type (
 	}
 )
 
+var (
+	// journalReadMax is the maximum number of events read from the journal
+	// at once by an event stream.
+	journalReadMax = 1000
+)
+
 // GetDaemonEvents feeds node daemon event publications in rss format.
 func (a *DaemonAPI) GetDaemonEvents(ctx echo.Context, nodename string, params api.GetDaemonEventsParams) error {
 	if nodename == a.localhost || nodename == "localhost" {
@@ -78,7 +85,9 @@ func (a *DaemonAPI) getPeerDaemonEvents(ctx echo.Context, nodename string, param
 	if err != nil {
 		return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
 	} else if resp.StatusCode != http.StatusOK {
-		return JSONProblemf(ctx, resp.StatusCode, "Request peer", "%s: %s", nodename, err)
+		// relay the peer status, like the 410 status of an evicted event id
+		_ = resp.Body.Close()
+		return JSONProblemf(ctx, resp.StatusCode, "Request peer", "%s: %s", nodename, resp.Status)
 	}
 	w := ctx.Response()
 	if request.Header.Get("accept") == "text/event-stream" {
@@ -118,7 +127,9 @@ func (a *DaemonAPI) getPeerDaemonEvents(ctx echo.Context, nodename string, param
 	}
 }
 
-// getLocalDaemonEvents feeds publications in rss format.
+// getLocalDaemonEvents feeds the journaled publications in rss format. The
+// stream starts after the event id from the Last-Event-ID header or the since
+// parameter, or after the last journaled event if none is set.
 // TODO: Honor subscribers params.
 func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonEventsParams) error {
 	if v, err := assertRole(ctx, rbac.RoleRoot, rbac.RoleJoin); err != nil {
@@ -132,6 +143,11 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		eventCount  uint64
 		err         error
 
+		// cursor is the id of the last journaled event read
+		cursor = eventjournal.Journal.LastID()
+		// streamFilters is the filters of the events to forward
+		streamFilters []Filter
+
 		// hasSelector is true when param.Selector is defined and not ""
 		hasSelector bool
 		// pathL list of all cluster object paths
@@ -143,8 +159,9 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		// pathSelected is a map of currently selected object paths
 		pathSelected naming.M
 		// filterM is a map indexed on requested filter identifiers.
-		// It is used to differentiate requested filters from extra filters
-		// added for object selection but not for response event stream.
+		// It is used to decide if the object creations and deletions
+		// tracked for object selection must be forwarded to the response
+		// event stream.
 		filterM = make(map[string]any)
 
 		evCtx  = ctx.Request().Context()
@@ -193,12 +210,98 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		return true
 	}
 
+	// isStreamed returns true when msg matches one of the stream filters,
+	// or when there is no stream filter.
+	isStreamed := func(msg pubsub.Messager) bool {
+		if len(streamFilters) == 0 {
+			return true
+		}
+		for _, filter := range streamFilters {
+			if filter.match(msg) {
+				return true
+			}
+		}
+		return false
+	}
+
+	// needForward returns true when the journaled msg must be forwarded to
+	// the response event stream. When a selector is set, it also updates
+	// the selected paths on object creations and deletions, forwarded or
+	// not.
+	needForward := func(msg pubsub.Messager) (bool, error) {
+		if !hasSelector {
+			return isStreamed(msg), nil
+		}
+		switch ev := msg.(type) {
+		case *msgbus.ObjectCreated:
+			s := ev.Path.String()
+			if !pathM.Has(s) {
+				pathL = pathL.Merge([]naming.Path{ev.Path})
+				pathM[s] = nil
+				selector.SetPaths(pathL)
+				if selected, err := getSelectedMap(); err != nil {
+					log.Errorf("can't filter on object created")
+					return false, err
+				} else if selected.Has(s) {
+					log.Debugf("add created object %s to selection", s)
+					pathSelected[s] = nil
+				}
+			}
+			if !needForwardEvent("ObjectCreated", ev) {
+				// not required on response stream
+				return false, nil
+			}
+			// message is forwarded if for a selected path
+			return isSelected(ev), nil
+		case *msgbus.ObjectDeleted:
+			notAnymoreSelected := false
+			if ev.GetLabels()["node"] == a.localhost {
+				s := ev.Path.String()
+				if pathSelected.Has(s) {
+					notAnymoreSelected = true
+					log.Debugf("remove deleted object %s from selection", s)
+					delete(pathSelected, s)
+				}
+				if _, ok := pathM[s]; ok {
+					delete(pathM, s)
+					// TODO implement naming.Paths.Drop(p naming.Path)
+					newPathL := make(naming.Paths, 0)
+					for _, p := range pathL {
+						if p.Equal(ev.Path) {
+							continue
+						}
+						newPathL = append(newPathL, p)
+					}
+					pathL = newPathL
+				}
+			}
+			if !needForwardEvent("ObjectDeleted", ev) {
+				// not required on response stream
+				return false, nil
+			}
+			if notAnymoreSelected {
+				// message from a previously selected path, that will
+				// be now discarted, we have to send this last message
+				return true, nil
+			}
+			// message is forwarded if for a selected path
+			return isSelected(ev), nil
+		default:
+			return isStreamed(msg) && isSelected(msg), nil
+		}
+	}
+
 	if params.Selector != nil && *params.Selector != "" {
 		hasSelector = true
 	}
 	if params.Limit != nil {
 		limit = uint64(*params.Limit)
 	}
+	if params.LastEventID != nil {
+		cursor = *params.LastEventID
+	} else if params.Since != nil {
+		cursor = *params.Since
+	}
 	if params.Duration != nil {
 		if v, err := converters.Duration.Convert(*params.Duration); err != nil {
 			log.Infof("Invalid parameter: field 'duration' with value '%s' validation error: %s", *params.Duration, err)
@@ -215,6 +318,11 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameter", "field 'filter' with value '%s' validation error: %s", *params.Filter, err)
 	}
 
+	if _, err := eventjournal.Journal.After(cursor, 0); err != nil {
+		log.Infof("Event id %d: %s", cursor, err)
+		return JSONProblemf(ctx, http.StatusGone, "Event id", "%d: %s", cursor, err)
+	}
+
 	r := ctx.Request()
 	w := ctx.Response()
 	if r.Header.Get("accept") == "text/event-stream" {
@@ -229,8 +337,6 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 	a.announceSub(name)
 	defer a.announceUnsub(name)
 
-	sub := a.EventBus.Sub(name, pubsub.Timeout(time.Second), a.SubQS)
-
 	for _, filter := range filters {
 		if filter.Kind == nil {
 			log.Debugf("filtering %v %v", filter.Kind, filter.Labels)
@@ -242,7 +348,7 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 			log.Warnf("skip filtering of %s %v", reflect.TypeOf(filter.Kind), filter.Labels)
 			continue
 		}
-		sub.AddFilter(filter.Kind, filter.Labels...)
+		streamFilters = append(streamFilters, filter)
 	}
 	if hasSelector && len(filterM) == 0 {
 		// no filters => all events must be forwarded, add ObjectCreated &
@@ -251,30 +357,6 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 		filterM["ObjectDeleted:{node="+a.localhost+"}"] = nil
 	}
 
-	if hasSelector {
-		// when request filters don't match ObjectCreated or
-		// ObjectDeleted,node=<localhost>. We create "hidden" filter for them.
-		// "hidden" because such messages don't require to be forwarded
-		// to response event stream.
-		createdMsg := &msgbus.ObjectCreated{}
-		createdMsg.AddLabels(a.LabelNode)
-		if !needForwardEvent("ObjectCreated", createdMsg) {
-			log.Debugf("add hidden filtering: ObjectCreated")
-			sub.AddFilter(&msgbus.ObjectCreated{})
-		}
-		deleteMsg := &msgbus.ObjectDeleted{}
-		deleteMsg.AddLabels(a.LabelNode)
-		if !needForwardEvent("ObjectDeleted", deleteMsg) {
-			log.Debugf("add hidden filtering: ObjectDeleted,node=%s", a.localhost)
-			sub.AddFilter(&msgbus.ObjectDeleted{}, pubsub.Label{"node", a.localhost})
-		}
-	}
-	sub.Start()
-	defer func() {
-		if err := sub.Stop(); err != nil {
-			log.Debugf("sub.Stop: %s", err)
-		}
-	}()
 	if hasSelector {
 		pathL = object.StatusData.GetPaths()
 		pathM = pathL.StrMap()
@@ -302,91 +384,59 @@ func (a *DaemonAPI) getLocalDaemonEvents(ctx echo.Context, params api.GetDaemonE
 	w.Flush()
 
 	sseWriter := sseevent.NewWriter(w)
-	evCounter := uint64(0)
 	for {
-		select {
-		case <-evCtx.Done():
+		// get the notification channel before reading the journal, so an
+		// event appended after the read is not missed.
+		notifyC := eventjournal.Journal.Notify()
+		entries, err := eventjournal.Journal.After(cursor, journalReadMax)
+		if err != nil {
+			// the stream can't follow the publication rate. End the
+			// stream, so the client knows from its resume attempt that
+			// events are lost.
+			log.Warnf("read events after %d: %s", cursor, err)
 			return nil
-		case i := <-sub.C:
-			if hasSelector {
-				switch ev := i.(type) {
-				case *msgbus.ObjectCreated:
-					s := ev.Path.String()
-					if !pathM.Has(s) {
-						pathL = pathL.Merge([]naming.Path{ev.Path})
-						pathM[s] = nil
-						selector.SetPaths(pathL)
-						if selected, err := getSelectedMap(); err != nil {
-							log.Errorf("can't filter on object created")
-							return err
-						} else if selected.Has(s) {
-							log.Debugf("add created object %s to selection", s)
-							pathSelected[s] = nil
-						}
-					}
-					if !needForwardEvent("ObjectCreated", ev) {
-						// not required on response stream
-						continue
-					}
-					if !isSelected(ev) {
-						// message is not for selected path
-						continue
-					}
-					// message will be forwarded
-				case *msgbus.ObjectDeleted:
-					notAnymoreSelected := false
-					if ev.GetLabels()["node"] == a.localhost {
-						s := ev.Path.String()
-						if pathSelected.Has(s) {
-							notAnymoreSelected = true
-							log.Debugf("remove deleted object %s from selection", s)
-							delete(pathSelected, s)
-						}
-						if _, ok := pathM[s]; ok {
-							delete(pathM, s)
-							// TODO implement naming.Paths.Drop(p naming.Path)
-							newPathL := make(naming.Paths, 0)
-							for _, p := range pathL {
-								if p.Equal(ev.Path) {
-									continue
-								}
-								newPathL = append(newPathL, p)
-							}
-							pathL = newPathL
-						}
-					}
-					if !needForwardEvent("ObjectDeleted", ev) {
-						// not required on response stream
-						continue
-					}
-					if notAnymoreSelected {
-						// message from a previously selected path, that will
-						// be now discarted, we have to send this last message
-					} else if !isSelected(ev) {
-						// message is not for selected path
-						continue
-					}
-					// message will be forwarded
-				case pubsub.Messager:
-					if !isSelected(ev) {
-						// message is not for selected path
-						continue
-					}
-					// message will be forwarded
-				}
+		}
+		for _, entry := range entries {
+			cursor = entry.ID
+			if ok, err := needForward(entry.Msg); err != nil {
+				return err
+			} else if !ok {
+				continue
 			}
-			ev := event.ToEvent(i, evCounter)
-			evCounter++
+			ev := event.ToEvent(entry.Msg, entry.ID)
 			if _, err := sseWriter.Write(ev); err != nil {
 				log.Debugf("write event %s: %s", ev.Kind, err)
 				return nil
 			}
 			w.Flush()
+			eventCount++
 			if limit > 0 && eventCount >= limit {
 				return nil
 			}
 		}
+		if len(entries) > 0 && evCtx.Err() == nil {
+			continue
+		}
+		select {
+		case <-evCtx.Done():
+			return nil
+		case <-notifyC:
+		}
+	}
+}
+
+// match returns true when the message msg has the filter kind and labels.
+func (f Filter) match(msg pubsub.Messager) bool {
+	if f.Kind != nil && reflect.TypeOf(f.Kind) != reflect.TypeOf(msg) {
+		return false
+	}
+	labels := msg.GetLabels()
+	for _, label := range f.Labels {
+		if v, ok := labels[label[0]]; !ok || v != label[1] {
+			return false
+		}
 	}
+	return true
 }
 
 // parseFilters return filters from b.Filter
@@ -1,13 +1,24 @@
 package daemonapi
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/require"
 
 	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/eventjournal"
 	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/plog"
 	"github.com/opensvc/om3/util/pubsub"
 )
 
@@ -122,3 +133,97 @@ func TestGetDaemonEventsBadParams(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLocalDaemonEventsResume(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bus := pubsub.NewBus(t.Name())
+	bus.Start(ctx)
+	defer bus.Stop()
+	a := &DaemonAPI{EventBus: bus, localhost: "node1"}
+
+	journal := eventjournal.Journal
+	defer func() { eventjournal.Journal = journal }()
+	eventjournal.Journal = eventjournal.New(3)
+
+	start := eventjournal.Journal.LastID()
+	publish := func(name string) uint64 {
+		return eventjournal.Journal.Append(&msgbus.ClientSubscribed{Name: name})
+	}
+	for i := 1; i <= 5; i++ {
+		publish(fmt.Sprintf("client%d", i))
+	}
+
+	idRegexp := regexp.MustCompile(`(?m)^id: (\d+)$`)
+
+	// getEvents calls the handler and returns the response status and the
+	// ids of the streamed events.
+	getEvents := func(t *testing.T, params api.GetDaemonEventsParams) (int, []uint64) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/node/name/node1/daemon/event", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.Set("logger", plog.NewDefaultLogger())
+		c.Set("uuid", uuid.New())
+		c.Set("grants", rbac.NewGrants("root"))
+		require.NoError(t, a.getLocalDaemonEvents(c, params))
+		var ids []uint64
+		for _, match := range idRegexp.FindAllStringSubmatch(rec.Body.String(), -1) {
+			id, err := strconv.ParseUint(match[1], 10, 64)
+			require.NoError(t, err)
+			ids = append(ids, id)
+		}
+		return rec.Code, ids
+	}
+
+	t.Run("an evicted Last-Event-ID is gone", func(t *testing.T) {
+		id := start + 1
+		code, ids := getEvents(t, api.GetDaemonEventsParams{LastEventID: &id})
+		require.Equal(t, http.StatusGone, code)
+		require.Empty(t, ids)
+	})
+
+	t.Run("an evicted since is gone", func(t *testing.T) {
+		id := start
+		code, ids := getEvents(t, api.GetDaemonEventsParams{Since: &id})
+		require.Equal(t, http.StatusGone, code)
+		require.Empty(t, ids)
+	})
+
+	t.Run("an unknown Last-Event-ID is gone", func(t *testing.T) {
+		id := eventjournal.Journal.LastID() + 1
+		code, _ := getEvents(t, api.GetDaemonEventsParams{LastEventID: &id})
+		require.Equal(t, http.StatusGone, code)
+	})
+
+	t.Run("the stream resumes after Last-Event-ID", func(t *testing.T) {
+		id := start + 3
+		limit := int64(2)
+		code, ids := getEvents(t, api.GetDaemonEventsParams{LastEventID: &id, Limit: &limit})
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, []uint64{start + 4, start + 5}, ids)
+	})
+
+	t.Run("Last-Event-ID has precedence over since", func(t *testing.T) {
+		id := start + 4
+		since := start
+		limit := int64(1)
+		code, ids := getEvents(t, api.GetDaemonEventsParams{LastEventID: &id, Since: &since, Limit: &limit})
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, []uint64{start + 5}, ids)
+	})
+
+	t.Run("the resumed stream follows the new events", func(t *testing.T) {
+		id := start + 4
+		limit := int64(3)
+		duration := "5s"
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			publish("client6")
+			publish("client7")
+		}()
+		code, ids := getEvents(t, api.GetDaemonEventsParams{Since: &id, Limit: &limit, Duration: &duration})
+		require.Equal(t, http.StatusOK, code)
+		require.Equal(t, []uint64{start + 5, start + 6, start + 7}, ids)
+	})
+}
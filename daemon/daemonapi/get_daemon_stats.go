@@ -0,0 +1,46 @@
+package daemonapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/daemonstats"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// GetDaemonStats returns the runtime statistics of the daemons of the
+// reachable cluster nodes, or of the local daemon only if the 'local'
+// parameter is set.
+func (a *DaemonAPI) GetDaemonStats(ctx echo.Context, params api.GetDaemonStatsParams) error {
+	if v, err := assertRole(ctx, rbac.RoleRoot); err != nil {
+		return err
+	} else if !v {
+		return nil
+	}
+	l := []api.DaemonStatsNode{daemonstats.Local(a.EventBus)}
+	if params.Local == nil || !*params.Local {
+		l = append(l, a.getPeersDaemonStats(ctx)...)
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].Node < l[j].Node })
+	return ctx.JSON(http.StatusOK, api.DaemonStats{Nodes: l})
+}
+
+// getPeersDaemonStats returns the statistics of the reachable peer nodes.
+func (a *DaemonAPI) getPeersDaemonStats(ctx echo.Context) []api.DaemonStatsNode {
+	local := true
+	return getFromPeers(ctx, a.localhost, func(reqCtx context.Context, c *client.T) ([]api.DaemonStatsNode, error) {
+		resp, err := c.GetDaemonStatsWithResponse(reqCtx, &api.GetDaemonStatsParams{Local: &local})
+		if err != nil {
+			return nil, err
+		} else if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected get daemon stats status %s", resp.Status())
+		}
+		return resp.JSON200.Nodes, nil
+	})
+}
@@ -0,0 +1,67 @@
+package daemonapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/job"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// GetJob returns the cluster view of a job, merging the local view and the
+// views of the peer nodes, or the local view only if the 'local' parameter is
+// set.
+func (a *DaemonAPI) GetJob(ctx echo.Context, id uuid.UUID, params api.GetJobParams) error {
+	l := make([]api.Job, 0)
+	if v, ok := job.Jobs.Get(id); ok {
+		l = append(l, v)
+	}
+	if params.Local == nil || !*params.Local {
+		l = append(l, a.getPeersJob(ctx, id)...)
+	}
+	merged, ok := job.Merge(l)
+	if !ok {
+		return JSONProblemf(ctx, http.StatusNotFound, "Job not found", "%s", id)
+	}
+	if !canViewJob(ctx, merged) {
+		// don't reveal the job existence
+		return JSONProblemf(ctx, http.StatusNotFound, "Job not found", "%s", id)
+	}
+	return ctx.JSON(http.StatusOK, merged)
+}
+
+// getPeersJob returns the views of the job <id> of the reachable peer nodes
+// having it.
+func (a *DaemonAPI) getPeersJob(ctx echo.Context, id uuid.UUID) []api.Job {
+	local := true
+	return getFromPeers(ctx, a.localhost, func(reqCtx context.Context, c *client.T) ([]api.Job, error) {
+		resp, err := c.GetJobWithResponse(reqCtx, id, &api.GetJobParams{Local: &local})
+		if err != nil {
+			return nil, err
+		} else if resp.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected get job status %s", resp.Status())
+		}
+		return []api.Job{*resp.JSON200}, nil
+	})
+}
+
+// canViewJob returns true if the user has a guest or admin grant on the job
+// object namespace. The jobs not related to an object are reserved to root.
+func canViewJob(ctx echo.Context, j api.Job) bool {
+	grants := grantsFromContext(ctx)
+	if grants.HasRole(rbac.RoleRoot) {
+		return true
+	}
+	p, err := naming.ParsePath(j.Path)
+	if err != nil {
+		return false
+	}
+	return grants.Has(rbac.RoleGuest, p.Namespace) || grants.Has(rbac.RoleAdmin, p.Namespace)
+}
@@ -83,7 +83,7 @@ func (a *DaemonAPI) getPeerNodeLogs(ctx echo.Context, nodename string, params ap
 }
 
 func (a *DaemonAPI) getLocalNodeLogs(ctx echo.Context, params api.GetNodeLogsParams) error {
-	if v, err := assertRole(ctx, rbac.RoleRoot); err != nil {
+	if v, err := assertPermission(ctx, rbac.OpNodeLogs, naming.Path{}); err != nil {
 		return err
 	} else if !v {
 		return nil
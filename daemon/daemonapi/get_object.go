@@ -80,6 +80,18 @@ func (a *DaemonAPI) getObjects(ctx echo.Context, pathSelector *string) (api.Obje
 				UpdatedAt:        ostat.UpdatedAt.String(),
 			},
 		}
+		if len(ostat.PlacementViolations) > 0 {
+			d.Data.PlacementViolations = &ostat.PlacementViolations
+		}
+		if ostat.Scale != nil {
+			scale := *ostat.Scale
+			scalerSlices := make(map[string]api.Status, len(ostat.ScalerSlices))
+			for slicePath, avail := range ostat.ScalerSlices {
+				scalerSlices[slicePath] = api.Status(avail.String())
+			}
+			d.Data.Scale = &scale
+			d.Data.ScalerSlices = &scalerSlices
+		}
 		for nodename, config := range instance.ConfigData.GetByPath(p) {
 			monitor := instance.MonitorData.Get(p, nodename)
 			status := instance.StatusData.Get(p, nodename)
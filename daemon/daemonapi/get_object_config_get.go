@@ -16,7 +16,7 @@ import (
 func (a *DaemonAPI) GetObjectConfigGet(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.GetObjectConfigGetParams) error {
 	log := LogHandler(ctx, "GetObjectConfigGet")
 
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleGuest, namespace), rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpObjectConfigGet, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 
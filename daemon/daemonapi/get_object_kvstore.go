@@ -16,7 +16,7 @@ import (
 func (a *DaemonAPI) GetObjectKVStore(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.GetObjectKVStoreParams) error {
 	log := LogHandler(ctx, "GetObjectKVStore")
 
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpObjectKVStoreGet, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 
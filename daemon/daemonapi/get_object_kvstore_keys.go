@@ -17,7 +17,7 @@ import (
 func (a *DaemonAPI) GetObjectKVStoreKeys(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
 	log := LogHandler(ctx, "GetObjectKVStore")
 
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpObjectKVStoreGet, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 
@@ -23,7 +23,7 @@ func (a *DaemonAPI) GetRelayMessage(ctx echo.Context, params api.GetRelayMessage
 	if slot, ok := relay.Map.Load(username, params.ClusterID, params.Nodename); !ok {
 		return JSONProblem(ctx, http.StatusNotFound, "Not found", "")
 	} else {
-		message := slot.Value.(api.RelayMessage)
+		message := slot.Value
 		message.Relay = a.localhost
 		return ctx.JSON(http.StatusOK, message)
 	}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -45,9 +46,11 @@ func (a *DaemonAPI) getRelayStatusLocal(ctx echo.Context, params api.GetRelaySta
 		username := userFromContext(ctx).GetUserName()
 		slots = relay.Map.List(username)
 	}
+	now := time.Now()
 	for _, slot := range slots {
-		v := slot.Value.(api.RelayMessage)
+		v := slot.Value
 		item := api.RelayStatusItem{
+			Age:         now.Sub(v.UpdatedAt).Round(time.Millisecond).String(),
 			ClusterID:   v.ClusterID,
 			ClusterName: v.ClusterName,
 			MsgLen:      len(v.Msg),
@@ -58,6 +61,12 @@ func (a *DaemonAPI) getRelayStatusLocal(ctx echo.Context, params api.GetRelaySta
 			UpdatedAt:   v.UpdatedAt,
 			Username:    v.Username,
 		}
+		if slot.ReplicatedFrom != "" {
+			replicatedFrom := slot.ReplicatedFrom
+			replicationLag := slot.ReceivedAt.Sub(v.UpdatedAt).Round(time.Millisecond).String()
+			item.ReplicatedFrom = &replicatedFrom
+			item.ReplicationLag = &replicationLag
+		}
 		data.Items = append(data.Items, item)
 	}
 	return ctx.JSON(http.StatusOK, data)
@@ -85,53 +94,54 @@ func (a *DaemonAPI) getRelayStatusRemote(ctx echo.Context, params api.GetRelaySt
 		if hbType != "relay" {
 			continue
 		}
-		hbRelay := config.GetString(key.New(section, "relay"))
-		if len(relayMap) > 0 {
-			// some relay filtering is on
-			if _, ok := relayMap[hbRelay]; !ok {
-				// filtered out
-				continue
-			}
-		}
 		insecure := config.GetBool(key.New(section, "insecure"))
 		username := config.GetString(key.New(section, "username"))
 		password, err := configSectionPassword(config, section)
 		if err != nil {
-			return JSONProblemf(ctx, http.StatusInternalServerError, "configSectionPassword", "%s: %s", hbRelay, err)
+			return JSONProblemf(ctx, http.StatusInternalServerError, "configSectionPassword", "%s: %s", section, err)
 		}
-		cli, err := client.New(
-			client.WithURL(hbRelay),
-			client.WithUsername(username),
-			client.WithPassword(password),
-			client.WithInsecureSkipVerify(insecure),
-		)
-		if err != nil {
-			return JSONProblemf(ctx, http.StatusInternalServerError, "new client", "%s: %s", hbRelay, err)
-		}
-		params.Remote = &falseValue
-		resp, err := cli.GetRelayStatusWithResponse(context.Background(), &params)
-		if err != nil {
-			// add a placeholder data, so the user can see something went wrong
-			clusterConfigData := cluster.ConfigData.Get()
-			items = append(items, api.RelayStatusItem{
-				ClusterID:   clusterConfigData.ID,
-				ClusterName: clusterConfigData.Name,
-				Relay:       hbRelay,
-				Username:    username,
-				Status:      fmt.Sprint(err),
-			})
-		} else if resp.StatusCode() != http.StatusOK {
-			// add a placeholder data, so the user can see something went wrong
-			clusterConfigData := cluster.ConfigData.Get()
-			items = append(items, api.RelayStatusItem{
-				ClusterID:   clusterConfigData.ID,
-				ClusterName: clusterConfigData.Name,
-				Relay:       hbRelay,
-				Username:    username,
-				Status:      resp.Status(),
-			})
-		} else {
-			items = append(items, resp.JSON200.Items...)
+		for _, hbRelay := range config.GetStrings(key.New(section, "relay")) {
+			if len(relayMap) > 0 {
+				// some relay filtering is on
+				if _, ok := relayMap[hbRelay]; !ok {
+					// filtered out
+					continue
+				}
+			}
+			cli, err := client.New(
+				client.WithURL(hbRelay),
+				client.WithUsername(username),
+				client.WithPassword(password),
+				client.WithInsecureSkipVerify(insecure),
+			)
+			if err != nil {
+				return JSONProblemf(ctx, http.StatusInternalServerError, "new client", "%s: %s", hbRelay, err)
+			}
+			params.Remote = &falseValue
+			resp, err := cli.GetRelayStatusWithResponse(context.Background(), &params)
+			if err != nil {
+				// add a placeholder data, so the user can see something went wrong
+				clusterConfigData := cluster.ConfigData.Get()
+				items = append(items, api.RelayStatusItem{
+					ClusterID:   clusterConfigData.ID,
+					ClusterName: clusterConfigData.Name,
+					Relay:       hbRelay,
+					Username:    username,
+					Status:      fmt.Sprint(err),
+				})
+			} else if resp.StatusCode() != http.StatusOK {
+				// add a placeholder data, so the user can see something went wrong
+				clusterConfigData := cluster.ConfigData.Get()
+				items = append(items, api.RelayStatusItem{
+					ClusterID:   clusterConfigData.ID,
+					ClusterName: clusterConfigData.Name,
+					Relay:       hbRelay,
+					Username:    username,
+					Status:      resp.Status(),
+				})
+			} else {
+				items = append(items, resp.JSON200.Items...)
+			}
 		}
 	}
 	sort.Slice(items, func(i, j int) bool {
@@ -146,6 +156,10 @@ func (a *DaemonAPI) getRelayStatusRemote(ctx echo.Context, params api.GetRelaySt
 			return false
 		case items[i].Nodename < items[j].Nodename:
 			return true
+		case items[i].Nodename > items[j].Nodename:
+			return false
+		case items[i].Relay < items[j].Relay:
+			return true
 		default:
 			return false
 		}
@@ -35,5 +35,20 @@ func (a *DaemonAPI) Getwhoami(ctx echo.Context) error {
 			}
 		}
 	}
+	permissions := make([]api.UserPermission, 0)
+	for _, perm := range grants.Permissions(customRoles()) {
+		p := api.UserPermission{
+			Grant:      string(perm.Grant),
+			Operations: perm.Operations,
+		}
+		if len(perm.Namespaces) > 0 {
+			p.Namespaces = &perm.Namespaces
+		}
+		if len(perm.Selector) > 0 {
+			p.Selector = &perm.Selector
+		}
+		permissions = append(permissions, p)
+	}
+	data.Permissions = &permissions
 	return ctx.JSON(http.StatusOK, data)
 }
@@ -1,7 +1,10 @@
 package daemonapi
 
 import (
+	"context"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/labstack/echo/v4"
 
@@ -9,10 +12,21 @@ import (
 	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/clusternode"
 	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/daemon/daemonauth"
 	"github.com/opensvc/om3/util/funcopt"
 	"github.com/opensvc/om3/util/hostname"
 )
 
+var (
+	// proxyTokenDuration is the validity of the tokens created to proxy the
+	// requests of the local users authenticated by the uxsock strategy.
+	proxyTokenDuration = time.Minute
+
+	// peerRequestTimeout is the timeout of the requests sent to each peer
+	// node by getFromPeers.
+	peerRequestTimeout = 5 * time.Second
+)
+
 func (a *DaemonAPI) proxy(ctx echo.Context, nodename string, fn func(*client.T) (*http.Response, error)) error {
 	if data := node.StatusData.Get(nodename); data == nil {
 		return JSONProblemf(ctx, http.StatusNotFound, "node status data not found", "%s", nodename)
@@ -35,15 +49,62 @@ func newProxyClient(ctx echo.Context, nodename string, opts ...funcopt.O) (*clie
 		client.WithURL(nodename),
 	}
 	authHeader := ctx.Request().Header.Get("authorization")
+	user := userFromContext(ctx)
 	if authHeader != "" {
 		options = append(options, client.WithAuthorization(authHeader))
-	} else if userFromContext(ctx).GetUserName() == "root" {
+	} else if user.GetUserName() == "root" {
 		// uxsock auth must be translated to root:<secret>
 		options = append(options,
 			client.WithUsername(hostname.Hostname()),
 			client.WithPassword(cluster.ConfigData.Get().Secret()),
 		)
+	} else if user.GetExtensions().Get("strategy") == "ux" {
+		// uxsock auth of the other local users must be translated to a
+		// short-lived token with the user grants
+		tk, _, err := (&daemonauth.JWTCreator{}).CreateUserToken(user, proxyTokenDuration, nil)
+		if err != nil {
+			return nil, err
+		} else if tk != "" {
+			options = append(options, client.WithBearer(tk))
+		}
 	}
 	options = append(options, opts...)
+	// the peer node executing the request records it in the audit trail
+	ctx.Set("proxied", true)
 	return client.New(options...)
 }
+
+// getFromPeers calls fn in parallel for each peer node with a known status,
+// and returns the concatenation of the returned items. The unreachable peers
+// and the peers returning an error are ignored.
+func getFromPeers[E any](ctx echo.Context, localhost string, fn func(context.Context, *client.T) ([]E, error)) []E {
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+		l  []E
+	)
+	for _, nodename := range cluster.ConfigData.Get().Nodes {
+		if nodename == localhost || node.StatusData.Get(nodename) == nil {
+			continue
+		}
+		c, err := newProxyClient(ctx, nodename)
+		if err != nil {
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reqCtx, cancel := context.WithTimeout(ctx.Request().Context(), peerRequestTimeout)
+			defer cancel()
+			items, err := fn(reqCtx, c)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			l = append(l, items...)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return l
+}
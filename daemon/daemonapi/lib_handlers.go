@@ -9,6 +9,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/shaj13/go-guardian/v2/auth"
 
+	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/daemonauth"
 	"github.com/opensvc/om3/daemon/daemondata"
@@ -79,6 +80,13 @@ func JSONForbiddenMissingRole(ctx echo.Context, missing ...rbac.Role) error {
 	return JSONProblemf(ctx, http.StatusForbidden, "Missing grants", "not allowed, need one of %v role", missing)
 }
 
+func JSONForbiddenMissingPermission(ctx echo.Context, op rbac.Operation, p naming.Path) error {
+	if p.IsZero() {
+		return JSONProblemf(ctx, http.StatusForbidden, "Missing permission", "not allowed, need the %s permission", op)
+	}
+	return JSONProblemf(ctx, http.StatusForbidden, "Missing permission", "not allowed, need the %s permission on %s", op, p)
+}
+
 func setStreamHeaders(w http.ResponseWriter) {
 	w.Header().Set("Content-Type", "text/event-stream")
 	w.Header().Set("Cache-control", "no-store")
@@ -3,12 +3,15 @@ package daemonapi
 import (
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/opensvc/om3/core/env"
 	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/job"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/command"
 	"github.com/opensvc/om3/util/hostname"
@@ -16,6 +19,8 @@ import (
 	"github.com/opensvc/om3/util/pubsub"
 )
 
+// apiExec starts the om command <args> and returns its session id, also used
+// as the id of the exec job recording the command exit code and output.
 func (a *DaemonAPI) apiExec(ctx echo.Context, p naming.Path, requesterSid uuid.UUID, args []string, log *plog.Logger) (uuid.UUID, error) {
 	execname, err := os.Executable()
 	if err != nil {
@@ -26,6 +31,8 @@ func (a *DaemonAPI) apiExec(ctx echo.Context, p naming.Path, requesterSid uuid.U
 		command.WithName(execname),
 		command.WithArgs(args),
 		command.WithLogger(log),
+		command.WithBufferedStdout(),
+		command.WithBufferedStderr(),
 		command.WithVarEnv(
 			env.OriginSetenvArg(env.ActionOriginDaemonAPI),
 			"OSVC_SESSION_ID="+sid.String(),
@@ -44,14 +51,21 @@ func (a *DaemonAPI) apiExec(ctx echo.Context, p naming.Path, requesterSid uuid.U
 	log.Infof("-> exec %s", cmd)
 	msg := msgbus.Exec{Command: cmd.String(), Node: hostname.Hostname(), Origin: "api"}
 	a.EventBus.Pub(&msg, labels...)
+	action := strings.Join(args, " ")
+	job.Jobs.Submit(sid, api.Exec, p, action)
+	execIndex := job.Jobs.ExecStarted(sid, p, action, cmd.String())
 	startTime := time.Now()
 	if err = cmd.Start(); err != nil {
 		log.Errorf("exec StartProcess: %s", err)
+		job.Jobs.ExecEnded(sid, execIndex, -1, nil, nil, err)
+		job.Jobs.End(sid)
 		return sid, fmt.Errorf("instance action failed: %w", err)
 	}
 	go func() {
 		err := cmd.Wait()
 		log.Infof("<- exec %s", cmd)
+		job.Jobs.ExecEnded(sid, execIndex, cmd.ExitCode(), cmd.Stdout(), cmd.Stderr(), err)
+		job.Jobs.End(sid)
 		duration := time.Now().Sub(startTime)
 		if err != nil {
 			msg := msgbus.ExecFailed{Command: cmd.String(), Duration: duration, ErrS: err.Error(), Node: hostname.Hostname(), Origin: "api"}
@@ -2,10 +2,12 @@ package daemonapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"github.com/opensvc/om3/daemon/daemonauth"
 	"net/http"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/allenai/go-swaggerui"
 	"github.com/google/uuid"
@@ -13,8 +15,14 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/shaj13/go-guardian/v2/auth"
 
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/audit"
+	"github.com/opensvc/om3/daemon/authtoken"
+	"github.com/opensvc/om3/daemon/daemonauth"
 	"github.com/opensvc/om3/daemon/daemonctx"
 	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/hostname"
 	"github.com/opensvc/om3/util/plog"
 )
 
@@ -25,6 +33,16 @@ type (
 )
 
 var (
+	// auditIgnoredPaths are the mutating request routes not recorded in the
+	// audit trail: the heartbeat, audit records and token registry
+	// exchanges between nodes.
+	auditIgnoredPaths = map[string]bool{
+		"/audit":               true,
+		"/auth/tokens/replica": true,
+		"/relay/message":       true,
+		"/relay/replica":       true,
+	}
+
 	// logRequestLevelPerPath defines logRequestMiddleWare log level per path.
 	// The default value is LevelInfo
 	logRequestLevelPerPath = map[string]zerolog.Level{
@@ -112,8 +130,14 @@ func AuthMiddleware(parent context.Context) echo.MiddlewareFunc {
 				r := c.Request()
 				log.Errorf("authenticating request from %s: %s", r.RemoteAddr, err)
 				code := http.StatusUnauthorized
+				recordAudit(c, newAuditRecord(c, time.Now(), nil, code))
 				return JSONProblem(c, code, http.StatusText(code), err.Error())
 			}
+			if slices.Contains(user.GetExtensions()["token_use"], string(authtoken.UseRefresh)) && c.Path() != "/auth/refresh" {
+				code := http.StatusUnauthorized
+				recordAudit(c, newAuditRecord(c, time.Now(), user, code))
+				return JSONProblem(c, code, http.StatusText(code), "a refresh token is only accepted by /auth/refresh")
+			}
 			log.Debugf("user %s authenticated", user.GetUserName())
 			c.Set("user", user)
 			c.Set("grants", rbac.NewGrants(user.GetExtensions()["grant"]...))
@@ -160,6 +184,89 @@ func LogRequestMiddleWare(parent context.Context) echo.MiddlewareFunc {
 	}
 }
 
+// AuditMiddleware records the mutating requests in the audit trail, with
+// their outcome. The requests proxied to a peer node are recorded by the
+// peer node executing them.
+func AuditMiddleware(parent context.Context) echo.MiddlewareFunc {
+	isAudited := func(c echo.Context) bool {
+		switch c.Request().Method {
+		case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			return !auditIgnoredPaths[c.Path()]
+		default:
+			return false
+		}
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !isAudited(c) {
+				return next(c)
+			}
+			at := time.Now()
+			err := next(c)
+			if proxied, _ := c.Get("proxied").(bool); proxied {
+				return err
+			}
+			status := c.Response().Status
+			if err != nil {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					status = httpErr.Code
+				} else {
+					status = http.StatusInternalServerError
+				}
+			}
+			recordAudit(c, newAuditRecord(c, at, userFromContext(c), status))
+			return err
+		}
+	}
+}
+
+// newAuditRecord returns the audit record of the request received at <at>
+// by the authenticated user, or by an unknown user if <authUser> is nil.
+func newAuditRecord(c echo.Context, at time.Time, authUser auth.Info, status int) api.AuditRecord {
+	r := c.Request()
+	record := api.AuditRecord{
+		Id:     uuid.New(),
+		At:     at,
+		Node:   hostname.Hostname(),
+		Grants: []string{},
+		Source: r.RemoteAddr,
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Action: c.Path(),
+		Params: make(map[string][]string),
+		Status: status,
+	}
+	if authUser != nil {
+		extensions := authUser.GetExtensions()
+		record.User = authUser.GetUserName()
+		record.Strategy = extensions.Get("strategy")
+		record.Grants = append(record.Grants, extensions.Values("grant")...)
+	}
+	for k, v := range c.QueryParams() {
+		record.Params[k] = v
+	}
+	for i, k := range c.ParamNames() {
+		record.Params[k] = []string{c.ParamValues()[i]}
+	}
+	if s := c.Param("nodename"); s != "" {
+		record.TargetNode = &s
+	}
+	if kind := c.Param("kind"); kind != "" {
+		if p, err := naming.NewPathFromStrings(c.Param("namespace"), kind, c.Param("name")); err == nil {
+			s := p.String()
+			record.TargetPath = &s
+		}
+	}
+	return record
+}
+
+func recordAudit(c echo.Context, record api.AuditRecord) {
+	if err := audit.Trail.Record(record); err != nil {
+		GetLogger(c).Warnf("audit record: %s", err)
+	}
+}
+
 func UIMiddleware(_ context.Context) echo.MiddlewareFunc {
 	uiHandler := http.StripPrefix("/public/ui", swaggerui.Handler("/public/openapi"))
 	echoUI := echo.WrapHandler(uiHandler)
@@ -13,15 +13,20 @@ import (
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/job"
 	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/daemon/rbac"
 	"github.com/opensvc/om3/util/pubsub"
 )
 
-func (a *DaemonAPI) postObjectAction(eCtx echo.Context, namespace string, kind naming.Kind, name string, globalExpect instance.MonitorGlobalExpect, fn func(c *client.T) (*http.Response, error)) error {
+func (a *DaemonAPI) postObjectAction(eCtx echo.Context, namespace string, kind naming.Kind, name string, op rbac.Operation, globalExpect instance.MonitorGlobalExpect, fn func(c *client.T) (*http.Response, error)) error {
 	p, err := naming.NewPath(namespace, kind, name)
 	if err != nil {
 		return JSONProblem(eCtx, http.StatusBadRequest, "Invalid parameters", err.Error())
 	}
+	if v, err := assertPermission(eCtx, op, p); !v {
+		return err
+	}
 
 	if instMon := instance.MonitorData.Get(p, a.localhost); instMon != nil {
 		ctx, cancel := context.WithTimeout(eCtx.Request().Context(), 500*time.Millisecond)
@@ -31,11 +36,7 @@ func (a *DaemonAPI) postObjectAction(eCtx echo.Context, namespace string, kind n
 			GlobalExpect:             &globalExpect,
 			CandidateOrchestrationID: uuid.New(),
 		}
-		msg, setImonErr := msgbus.NewSetInstanceMonitorWithErr(ctx, p, a.localhost, value)
-
-		a.EventBus.Pub(msg, pubsub.Label{"path", p.String()}, labelAPI)
-
-		return JSONFromSetInstanceMonitorError(eCtx, &value, setImonErr.Receive())
+		return a.queueOrchestration(ctx, eCtx, p, value)
 	}
 	for nodename, _ := range instance.MonitorData.GetByPath(p) {
 		if nodename == a.localhost {
@@ -46,6 +47,25 @@ func (a *DaemonAPI) postObjectAction(eCtx echo.Context, namespace string, kind n
 	return JSONProblem(eCtx, http.StatusNotFound, "object not found", "")
 }
 
+// queueOrchestration submits the instance monitor update <value> to the
+// local imon of the object <p>, and records the orchestration job, identified
+// by the candidate orchestration id. The job is forgotten if the update is
+// refused.
+func (a *DaemonAPI) queueOrchestration(ctx context.Context, eCtx echo.Context, p naming.Path, value instance.MonitorUpdate) error {
+	jobID := value.CandidateOrchestrationID
+	job.Jobs.Submit(jobID, api.Orchestration, p, value.GlobalExpect.String())
+
+	msg, setImonErr := msgbus.NewSetInstanceMonitorWithErr(ctx, p, a.localhost, value)
+
+	a.EventBus.Pub(msg, pubsub.Label{"path", p.String()}, labelAPI)
+
+	err := setImonErr.Receive()
+	if err != nil {
+		job.Jobs.Forget(jobID)
+	}
+	return JSONFromSetInstanceMonitorError(eCtx, &value, err)
+}
+
 // JSONFromSetInstanceMonitorError sends a JSON response where status code depends
 // on SetMonitorUpdate error value.
 //   - StatusOK: expectation value accepted
@@ -55,7 +75,10 @@ func JSONFromSetInstanceMonitorError(eCtx echo.Context, value *instance.MonitorU
 	// TODO: is 408 Request Timeout correct ? it may caused from slow imon
 	switch {
 	case err == nil:
-		return eCtx.JSON(http.StatusOK, api.OrchestrationQueued{OrchestrationID: value.CandidateOrchestrationID})
+		return eCtx.JSON(http.StatusOK, api.OrchestrationQueued{
+			JobID:           &value.CandidateOrchestrationID,
+			OrchestrationID: value.CandidateOrchestrationID,
+		})
 	case errors.Is(err, context.DeadlineExceeded):
 		return JSONProblemf(eCtx, http.StatusRequestTimeout, "set instance monitor", "timeout publishing %s", *value)
 	case errors.Is(err, context.Canceled):
@@ -5,7 +5,10 @@ import (
 	"strings"
 
 	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/daemon/rbac"
 )
 
@@ -23,6 +26,25 @@ func assertRole(ctx echo.Context, roles ...rbac.Role) (bool, error) {
 	return true, nil
 }
 
+// assertPermission returns true if the user grants allow the operation <op>
+// on the object <p>, using the custom roles of the cluster config. The node
+// operations use a zero <p>.
+func assertPermission(ctx echo.Context, op rbac.Operation, p naming.Path) (bool, error) {
+	if !grantsFromContext(ctx).Allows(customRoles(), op, p) {
+		return false, JSONForbiddenMissingPermission(ctx, op, p)
+	}
+	return true, nil
+}
+
+// customRoles returns the custom roles defined in the cluster config.
+func customRoles() rbac.CustomRoles {
+	roles := make(rbac.CustomRoles)
+	for name, role := range cluster.ConfigData.Get().Roles {
+		roles[name] = rbac.CustomRole(role)
+	}
+	return roles
+}
+
 func keyopStringRbac(op string) error {
 	kop := keyop.Parse(op)
 	if kop == nil {
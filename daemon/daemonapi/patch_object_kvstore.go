@@ -16,7 +16,7 @@ import (
 func (a *DaemonAPI) PatchObjectKVStore(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
 	log := LogHandler(ctx, "PatchObjectKVStore")
 
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpObjectKVStoreUpdate, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 
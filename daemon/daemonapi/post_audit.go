@@ -0,0 +1,29 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/audit"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// PostAudit stores the audit records forwarded by a peer node.
+func (a *DaemonAPI) PostAudit(ctx echo.Context) error {
+	var value api.AuditRecordItems
+	log := LogHandler(ctx, "PostAudit")
+
+	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+		return err
+	}
+	if err := ctx.Bind(&value); err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "%s", err)
+	}
+	if err := audit.Trail.StoreForwarded(value); err != nil {
+		log.Warnf("store %d forwarded records: %s", len(value), err)
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Audit store", "%s", err)
+	}
+	return JSONProblemf(ctx, http.StatusOK, "stored", "%d records", len(value))
+}
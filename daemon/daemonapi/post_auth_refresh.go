@@ -0,0 +1,45 @@
+package daemonapi
+
+import (
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/shaj13/go-guardian/v2/auth"
+
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/authtoken"
+)
+
+// PostAuthRefresh create a new token with the grants of the refresh token
+// used as bearer.
+func (a *DaemonAPI) PostAuthRefresh(ctx echo.Context, params api.PostAuthRefreshParams) error {
+	var (
+		// duration define the default token duration
+		duration = time.Minute * 10
+
+		// duration define the maximum token duration
+		durationMax = time.Hour * 24
+	)
+	name := "PostAuthRefresh"
+	log := LogHandler(ctx, name)
+	user := ctx.Get("user").(auth.Info)
+	extensions := user.GetExtensions()
+	if !slices.Contains(extensions["token_use"], string(authtoken.UseRefresh)) {
+		return JSONProblemf(ctx, http.StatusForbidden, "Forbidden", "The bearer is not a refresh token")
+	}
+	if v, err := parseTokenDuration(params.Duration, duration, durationMax); err != nil {
+		log.Infof("%s: invalid duration: %s: %s", name, *params.Duration, err)
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "Invalid duration: %s", *params.Duration)
+	} else {
+		duration = v
+	}
+	username := user.GetUserName()
+	user = auth.NewUserInfo(username, username, nil, auth.Extensions{"grant": extensions["refresh_grant"]})
+	tk, err := a.createToken(user, authtoken.UseAccess, duration, nil)
+	if err != nil {
+		return tokenProblem(ctx, log, name, err)
+	}
+	return ctx.JSON(http.StatusOK, tk)
+}
@@ -1,30 +1,38 @@
 package daemonapi
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/shaj13/go-guardian/v2/auth"
 
 	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/authtoken"
 	"github.com/opensvc/om3/daemon/daemonenv"
 	"github.com/opensvc/om3/daemon/rbac"
 	"github.com/opensvc/om3/util/converters"
+	"github.com/opensvc/om3/util/plog"
 )
 
-// PostAuthToken create a new token for a user
+var (
+	errJWTDisabled = errors.New("create token error: jwt auth is not enabled")
+)
+
+// PostAuthToken create a new token for a user.
 //
 // When role parameter exists a new user is created with grants from role and
-// extra claims may be added to token
+// extra claims may be added to token. It requires the root role.
+//
+// Else the token has the caller grants, limited by the grant and namespace
+// parameters. Any authenticated user can create such a token.
 func (a *DaemonAPI) PostAuthToken(ctx echo.Context, params api.PostAuthTokenParams) error {
-	if v, err := assertRole(ctx, rbac.RoleRoot); err != nil {
-		return err
-	} else if !v {
-		return nil
-	}
 	var (
 		// duration define the default token duration
 		duration = time.Minute * 10
@@ -32,24 +40,37 @@ func (a *DaemonAPI) PostAuthToken(ctx echo.Context, params api.PostAuthTokenPara
 		// duration define the maximum token duration
 		durationMax = time.Hour * 24
 
+		// refreshDuration define the default refresh token duration
+		refreshDuration = time.Hour * 24
+
 		xClaims = make(map[string]interface{})
 	)
 	name := "PostAuthToken"
 	log := LogHandler(ctx, name)
-	if params.Duration != nil {
-		if v, err := converters.Duration.Convert(*params.Duration); err != nil {
-			log.Infof("%s: invalid duration: %s: %s", name, *params.Duration, err)
-			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "Invalid duration: %s", *params.Duration)
-		} else {
-			duration = *v.(*time.Duration)
-			if duration > durationMax {
-				duration = durationMax
-			}
+	if params.Role != nil {
+		if v, err := assertRole(ctx, rbac.RoleRoot); err != nil {
+			return err
+		} else if !v {
+			return nil
 		}
+		if params.Grant != nil || params.Namespace != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "The role parameter can't be combined with the grant and namespace parameters")
+		}
+	}
+	if v, err := parseTokenDuration(params.Duration, duration, durationMax); err != nil {
+		log.Infof("%s: invalid duration: %s: %s", name, *params.Duration, err)
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "Invalid duration: %s", *params.Duration)
+	} else {
+		duration = v
+	}
+	if v, err := parseTokenDuration(params.RefreshDuration, refreshDuration, authtoken.MaxDuration); err != nil {
+		log.Infof("%s: invalid refresh duration: %s: %s", name, *params.RefreshDuration, err)
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid parameters", "Invalid refresh duration: %s", *params.RefreshDuration)
+	} else {
+		refreshDuration = v
 	}
 	user := ctx.Get("user").(auth.Info)
 	username := user.GetUserName()
-	// TODO verify if user is allowed to create token => 403 Forbidden
 	if params.Role != nil {
 		var err error
 		user, xClaims, err = userXClaims(params, user)
@@ -57,21 +78,148 @@ func (a *DaemonAPI) PostAuthToken(ctx echo.Context, params api.PostAuthTokenPara
 			log.Errorf("%s: userXClaims: %s", name, err)
 			return JSONProblemf(ctx, http.StatusServiceUnavailable, "Invalid user claims", "user name: %s", username)
 		}
+	} else if params.Grant != nil || params.Namespace != nil {
+		var grants, namespaces []string
+		if params.Grant != nil {
+			grants = *params.Grant
+		}
+		if params.Namespace != nil {
+			namespaces = *params.Namespace
+		}
+		limited, err := limitGrants(grantsFromContext(ctx), grants, namespaces)
+		if err != nil {
+			log.Infof("%s: %s", name, err)
+			return JSONProblemf(ctx, http.StatusForbidden, "Invalid token scope", "%s", err)
+		}
+		user = auth.NewUserInfo(username, username, nil, auth.Extensions{"grant": limited})
 	}
 
-	tk, expireAt, err := a.JWTcreator.CreateUserToken(user, duration, xClaims)
+	tk, err := a.createToken(user, authtoken.UseAccess, duration, xClaims)
 	if err != nil {
-		log.Errorf("%s: can't create token: %s", name, err)
-		return JSONProblemf(ctx, http.StatusInternalServerError, "Unexpected error", "%s", err)
+		return tokenProblem(ctx, log, name, err)
+	}
+	if params.Refresh != nil && *params.Refresh {
+		refreshTk, err := a.createToken(user, authtoken.UseRefresh, refreshDuration, map[string]interface{}{
+			"token_use": string(authtoken.UseRefresh),
+		})
+		if err != nil {
+			return tokenProblem(ctx, log, name, err)
+		}
+		tk.RefreshToken = &refreshTk.Token
+		tk.RefreshTokenId = refreshTk.TokenId
+		tk.RefreshExpiredAt = &refreshTk.ExpiredAt
+	}
+	return ctx.JSON(http.StatusOK, tk)
+}
+
+// createToken returns a new token for <user>, registered in the token
+// registry so it can be listed and revoked.
+func (a *DaemonAPI) createToken(user auth.Info, use api.AuthTokenInfoUse, duration time.Duration, xClaims map[string]interface{}) (api.AuthToken, error) {
+	now := time.Now()
+	id := uuid.New().String()
+	claims := map[string]interface{}{
+		"jti": id,
+		"iat": now.Unix(),
+	}
+	for c, v := range xClaims {
+		claims[c] = v
+	}
+	tk, expireAt, err := a.JWTcreator.CreateUserToken(user, duration, claims)
+	if err != nil {
+		return api.AuthToken{}, err
 	} else if tk == "" {
-		err := fmt.Errorf("create token error: jwt auth is not enabled")
-		log.Warnf("%s: %s", name, err)
-		return JSONProblemf(ctx, http.StatusNotImplemented, err.Error(), "")
+		return api.AuthToken{}, errJWTDisabled
+	}
+	grants := user.GetExtensions()["grant"]
+	if grants == nil {
+		grants = []string{}
 	}
-	return ctx.JSON(http.StatusOK, api.AuthToken{
+	if err := authtoken.Registry.Add(authtoken.Info{
+		ExpiredAt: expireAt,
+		Grants:    grants,
+		Id:        id,
+		IssuedAt:  now,
+		Issuer:    a.localhost,
+		Use:       use,
+		User:      user.GetUserName(),
+	}); err != nil {
+		return api.AuthToken{}, fmt.Errorf("register token: %w", err)
+	}
+	return api.AuthToken{
 		ExpiredAt: expireAt,
 		Token:     tk,
-	})
+		TokenId:   &id,
+	}, nil
+}
+
+// tokenProblem logs the token creation error <err> and returns the matching
+// problem response.
+func tokenProblem(ctx echo.Context, log *plog.Logger, name string, err error) error {
+	if errors.Is(err, errJWTDisabled) {
+		log.Warnf("%s: %s", name, err)
+		return JSONProblemf(ctx, http.StatusNotImplemented, err.Error(), "")
+	}
+	log.Errorf("%s: can't create token: %s", name, err)
+	return JSONProblemf(ctx, http.StatusInternalServerError, "Unexpected error", "%s", err)
+}
+
+// parseTokenDuration returns the duration <s>, or <def> if <s> is nil. The
+// duration is capped to <max>.
+func parseTokenDuration(s *string, def, max time.Duration) (time.Duration, error) {
+	if s == nil {
+		return def, nil
+	}
+	v, err := converters.Duration.Convert(*s)
+	if err != nil {
+		return 0, err
+	}
+	return min(*v.(*time.Duration), max), nil
+}
+
+// limitGrants returns the <grants> subset of the caller grants <has>, limited
+// to the <namespaces>. The empty <grants> selects all the caller grants. A
+// root caller can request any grant, and gets the admin grant on the
+// <namespaces>. The grants not scoped to a namespace are dropped by the
+// namespace limit.
+func limitGrants(has rbac.Grants, grants, namespaces []string) ([]string, error) {
+	isRoot := has.HasGrant(rbac.GrantRoot)
+	var l []string
+	if len(grants) == 0 {
+		for _, g := range has {
+			l = append(l, string(g))
+		}
+	} else {
+		for _, g := range grants {
+			if !isRoot && !has.HasGrant(rbac.Grant(g)) {
+				return nil, fmt.Errorf("grant %s is not a caller grant", g)
+			}
+			l = append(l, g)
+		}
+	}
+	if len(namespaces) == 0 {
+		return l, nil
+	}
+	limited := make([]string, 0)
+	add := func(g string) {
+		if !slices.Contains(limited, g) {
+			limited = append(limited, g)
+		}
+	}
+	for _, g := range l {
+		role, ns := rbac.SplitGrant(rbac.Grant(g))
+		switch {
+		case role == rbac.RoleRoot:
+			for _, ns := range namespaces {
+				add(string(rbac.NewGrant(rbac.RoleAdmin, ns)))
+			}
+		case ns != "" && slices.Contains(namespaces, ns):
+			add(g)
+		}
+	}
+	if len(limited) == 0 {
+		return nil, fmt.Errorf("no caller grant on namespaces %s", strings.Join(namespaces, ", "))
+	}
+	return limited, nil
 }
 
 // userXClaims returns new user and Claims from p and current user
@@ -0,0 +1,65 @@
+package daemonapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+func TestLimitGrants(t *testing.T) {
+	cases := map[string]struct {
+		has        []string
+		grants     []string
+		namespaces []string
+		expected   []string
+		err        bool
+	}{
+		"no limit": {
+			has:      []string{"operator:ns1", "guest:ns2"},
+			expected: []string{"operator:ns1", "guest:ns2"},
+		},
+		"grant subset": {
+			has:      []string{"operator:ns1", "guest:ns2"},
+			grants:   []string{"guest:ns2"},
+			expected: []string{"guest:ns2"},
+		},
+		"grant not held": {
+			has:    []string{"guest:ns2"},
+			grants: []string{"admin:ns2"},
+			err:    true,
+		},
+		"root can request any grant": {
+			has:      []string{"root"},
+			grants:   []string{"admin:ns2"},
+			expected: []string{"admin:ns2"},
+		},
+		"namespace limit": {
+			has:        []string{"operator:ns1", "guest:ns2", "prioritizer"},
+			namespaces: []string{"ns2"},
+			expected:   []string{"guest:ns2"},
+		},
+		"root namespace limit": {
+			has:        []string{"root"},
+			namespaces: []string{"ns1", "ns2"},
+			expected:   []string{"admin:ns1", "admin:ns2"},
+		},
+		"no grant in namespace": {
+			has:        []string{"guest:ns2"},
+			namespaces: []string{"ns1"},
+			err:        true,
+		},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			l, err := limitGrants(rbac.NewGrants(c.has...), c.grants, c.namespaces)
+			if c.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, c.expected, l)
+		})
+	}
+}
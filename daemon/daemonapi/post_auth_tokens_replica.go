@@ -0,0 +1,29 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/authtoken"
+	"github.com/opensvc/om3/daemon/rbac"
+)
+
+// PostAuthTokensReplica merges the token registry posted by a peer node.
+func (a *DaemonAPI) PostAuthTokensReplica(ctx echo.Context) error {
+	var value api.AuthTokenRegistry
+	log := LogHandler(ctx, "PostAuthTokensReplica")
+
+	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+		return err
+	}
+	if err := ctx.Bind(&value); err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "%s", err)
+	}
+	if err := authtoken.Registry.Merge(value); err != nil {
+		log.Warnf("merge token registry: %s", err)
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Token registry merge", "%s", err)
+	}
+	return JSONProblemf(ctx, http.StatusOK, "merged", "%d tokens, %d revocations", len(value.Issued), len(value.Revoked))
+}
@@ -9,7 +9,9 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/file"
 )
 
 func (a *DaemonAPI) PostClusterActionAbort(ctx echo.Context) error {
@@ -20,6 +22,45 @@ func (a *DaemonAPI) PostClusterActionFreeze(ctx echo.Context) error {
 	return a.PostClusterAction(ctx, node.MonitorGlobalExpectFrozen)
 }
 
+// PostClusterActionRollingRestart sets the "restarted" global expect. The
+// rolling restart request time is preserved on resume, so the nodes already
+// restarted are skipped.
+func (a *DaemonAPI) PostClusterActionRollingRestart(ctx echo.Context) error {
+	var (
+		payload api.PostClusterActionRollingRestart
+	)
+	if err := ctx.Bind(&payload); err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "error: %s", err)
+	}
+	mon := node.MonitorData.Get(a.localhost)
+	if mon == nil {
+		return JSONProblemf(ctx, http.StatusNotFound, "Not found", "node monitor not found: %s", a.localhost)
+	}
+	rollingRestart := node.RollingRestart{ID: uuid.New(), Since: time.Now()}
+	if payload.Resume != nil && *payload.Resume {
+		if mon.RollingRestart.ID == uuid.Nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "no rolling restart to resume")
+		}
+		rollingRestart = mon.RollingRestart
+	}
+	if payload.Binary != nil {
+		rollingRestart.Binary = *payload.Binary
+	}
+	if rollingRestart.Binary != "" {
+		if v, err := file.ExistsAndRegular(rollingRestart.Binary); err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "binary %s: %s", rollingRestart.Binary, err)
+		} else if !v {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "binary %s is not a regular file", rollingRestart.Binary)
+		}
+	}
+	globalExpect := node.MonitorGlobalExpectRestarted
+	return a.postClusterActionUpdate(ctx, node.MonitorUpdate{
+		GlobalExpect:             &globalExpect,
+		RollingRestart:           &rollingRestart,
+		CandidateOrchestrationID: uuid.New(),
+	})
+}
+
 func (a *DaemonAPI) PostClusterActionUnfreeze(ctx echo.Context) error {
 	return a.PostClusterAction(ctx, node.MonitorGlobalExpectThawed)
 }
@@ -28,14 +69,16 @@ func (a *DaemonAPI) PostClusterAction(eCtx echo.Context, globalExpect node.Monit
 	if mon := node.MonitorData.Get(a.localhost); mon == nil {
 		return JSONProblemf(eCtx, http.StatusNotFound, "Not found", "node monitor not found: %s", a.localhost)
 	}
+	return a.postClusterActionUpdate(eCtx, node.MonitorUpdate{
+		GlobalExpect:             &globalExpect,
+		CandidateOrchestrationID: uuid.New(),
+	})
+}
 
+func (a *DaemonAPI) postClusterActionUpdate(eCtx echo.Context, value node.MonitorUpdate) error {
 	ctx, cancel := context.WithTimeout(eCtx.Request().Context(), 300*time.Millisecond)
 	defer cancel()
 
-	value := node.MonitorUpdate{
-		GlobalExpect:             &globalExpect,
-		CandidateOrchestrationID: uuid.New(),
-	}
 	msg, err := msgbus.NewSetNodeMonitorWithErr(ctx, a.localhost, value)
 
 	a.EventBus.Pub(msg, labelAPI, a.LabelNode)
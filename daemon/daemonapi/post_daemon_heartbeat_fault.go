@@ -0,0 +1,89 @@
+package daemonapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/core/clusternode"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/daemon/hb/hbfault"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/util/converters"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+func (a *DaemonAPI) PostDaemonHeartbeatFault(ctx echo.Context, nodename string) error {
+	if nodename == a.localhost {
+		return a.localPostDaemonHeartbeatFault(ctx)
+	} else if !clusternode.Has(nodename) {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid nodename", "field 'nodename' with value '%s' is not a cluster node", nodename)
+	}
+	var payload api.PostDaemonHeartbeatFault
+	if err := ctx.Bind(&payload); err != nil {
+		return JSONProblem(ctx, http.StatusBadRequest, "Invalid body", err.Error())
+	}
+	c, err := newProxyClient(ctx, nodename)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "New client", "%s: %s", nodename, err)
+	}
+	resp, err := c.PostDaemonHeartbeatFaultWithResponse(ctx.Request().Context(), nodename, payload)
+	if err != nil {
+		return JSONProblemf(ctx, http.StatusInternalServerError, "Request peer", "%s: %s", nodename, err)
+	} else if len(resp.Body) > 0 {
+		return ctx.JSONBlob(resp.StatusCode(), resp.Body)
+	}
+	return nil
+}
+
+func (a *DaemonAPI) localPostDaemonHeartbeatFault(ctx echo.Context) error {
+	if v, err := assertRole(ctx, rbac.RoleRoot); err != nil {
+		return err
+	} else if !v {
+		return nil
+	}
+	log := LogHandler(ctx, "PostDaemonHeartbeatFault")
+	log.Debugf("starting")
+
+	var payload api.PostDaemonHeartbeatFault
+	if err := ctx.Bind(&payload); err != nil {
+		log.Warnf("invalid body: %s", err)
+		return JSONProblem(ctx, http.StatusBadRequest, "Invalid body", err.Error())
+	}
+	now := time.Now()
+	fault := daemonsubsystem.HeartbeatFault{
+		ID:        payload.Hb,
+		Action:    string(payload.Action),
+		CreatedAt: now,
+	}
+	if payload.Peer != nil {
+		fault.Peer = *payload.Peer
+		if fault.Peer == a.localhost {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "field 'peer' with value '%s' is the local node", fault.Peer)
+		} else if fault.Peer != "" && !clusternode.Has(fault.Peer) {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "field 'peer' with value '%s' is not a cluster node", fault.Peer)
+		}
+	}
+	if payload.Delay != nil {
+		if v, err := converters.Duration.Convert(*payload.Delay); err != nil {
+			return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "field 'delay' with value '%s' validation error: %s", *payload.Delay, err)
+		} else {
+			fault.Delay = *v.(*time.Duration)
+		}
+	}
+	if v, err := converters.Duration.Convert(payload.Duration); err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "field 'duration' with value '%s' validation error: %s", payload.Duration, err)
+	} else {
+		fault.ExpireAt = now.Add(*v.(*time.Duration))
+	}
+	if err := hbfault.Validate(fault); err != nil {
+		return JSONProblem(ctx, http.StatusBadRequest, "Invalid body", err.Error())
+	}
+	log.Infof("ask to inject fault on %s peer '%s': %s %s until %s", fault.ID, fault.Peer, fault.Action, fault.Delay, fault.ExpireAt)
+	a.EventBus.Pub(&msgbus.HbFaultCtl{Action: "inject", Value: fault},
+		pubsub.Label{"id", fault.ID}, labelAPI, a.LabelNode)
+	return JSONProblemf(ctx, http.StatusOK, "heartbeat fault injection queued", "%s %s until %s", fault.ID, fault.Action, fault.ExpireAt.Format(time.RFC3339))
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/daemon/rbac"
@@ -13,7 +14,7 @@ import (
 // PostDaemonJoin publishes msgbus.JoinRequest{Node: node} with label node=<apinode>.
 // It requires non empty params.Node
 func (a *DaemonAPI) PostDaemonJoin(ctx echo.Context, params api.PostDaemonJoinParams) error {
-	if v, err := assertRole(ctx, rbac.RoleRoot, rbac.RoleJoin); !v {
+	if v, err := assertPermission(ctx, rbac.OpDaemonJoin, naming.Path{}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostDaemonJoin")
@@ -5,6 +5,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/daemon/api"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/daemon/rbac"
@@ -13,7 +14,7 @@ import (
 // PostDaemonLeave publishes msgbus.LeaveRequest{Node: node} with label node=<apinode>.
 // It requires non empty params.Node
 func (a *DaemonAPI) PostDaemonLeave(ctx echo.Context, params api.PostDaemonLeaveParams) error {
-	if v, err := assertRole(ctx, rbac.RoleRoot, rbac.RoleLeave); err != nil {
+	if v, err := assertPermission(ctx, rbac.OpDaemonLeave, naming.Path{}); err != nil {
 		return err
 	} else if !v {
 		return nil
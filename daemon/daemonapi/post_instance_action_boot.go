@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostInstanceActionBoot(ctx echo.Context, nodename, namespace
 }
 
 func (a *DaemonAPI) postLocalInstanceActionBoot(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceActionBootParams) error {
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpInstanceBoot, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 
@@ -52,6 +52,6 @@ func (a *DaemonAPI) postLocalInstanceActionBoot(ctx echo.Context, namespace stri
 	if sid, err := a.apiExec(ctx, p, requesterSid, args, log); err != nil {
 		return JSONProblemf(ctx, http.StatusInternalServerError, "", "%s", err)
 	} else {
-		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{SessionID: sid})
+		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{JobID: sid, SessionID: sid})
 	}
 }
@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostInstanceActionDelete(ctx echo.Context, nodename, namespa
 }
 
 func (a *DaemonAPI) postLocalInstanceActionDelete(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceActionDeleteParams) error {
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpInstanceDelete, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostInstanceActionDelete")
@@ -39,6 +39,6 @@ func (a *DaemonAPI) postLocalInstanceActionDelete(ctx echo.Context, namespace st
 	if sid, err := a.apiExec(ctx, p, requesterSid, args, log); err != nil {
 		return JSONProblemf(ctx, http.StatusInternalServerError, "", "%s", err)
 	} else {
-		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{SessionID: sid})
+		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{JobID: sid, SessionID: sid})
 	}
 }
@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostInstanceActionProvision(ctx echo.Context, nodename, name
 }
 
 func (a *DaemonAPI) postLocalInstanceActionProvision(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceActionProvisionParams) error {
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpInstanceProvision, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostInstanceActionProvision")
@@ -57,6 +57,6 @@ func (a *DaemonAPI) postLocalInstanceActionProvision(ctx echo.Context, namespace
 	if sid, err := a.apiExec(ctx, p, requesterSid, args, log); err != nil {
 		return JSONProblemf(ctx, http.StatusInternalServerError, "", "%s", err)
 	} else {
-		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{SessionID: sid})
+		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{JobID: sid, SessionID: sid})
 	}
 }
@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostInstanceActionPushResourceInfo(ctx echo.Context, nodenam
 }
 
 func (a *DaemonAPI) postLocalInstanceActionPushResourceInfo(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceActionPushResourceInfoParams) error {
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleOperator, namespace), rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpInstancePushResInfo, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostInstanceActionPushResourceInfo")
@@ -39,7 +39,7 @@ func (a *DaemonAPI) postLocalInstanceActionPushResourceInfo(ctx echo.Context, na
 	if sid, err := a.apiExec(ctx, p, requesterSid, args, log); err != nil {
 		return JSONProblemf(ctx, http.StatusInternalServerError, "", "%s", err)
 	} else {
-		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{SessionID: sid})
+		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{JobID: sid, SessionID: sid})
 	}
 
 }
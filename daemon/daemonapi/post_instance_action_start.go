@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostInstanceActionStart(ctx echo.Context, nodename, namespac
 }
 
 func (a *DaemonAPI) postLocalInstanceActionStart(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostInstanceActionStartParams) error {
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleOperator, namespace), rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpInstanceStart, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 
@@ -58,6 +58,6 @@ func (a *DaemonAPI) postLocalInstanceActionStart(ctx echo.Context, namespace str
 	if sid, err := a.apiExec(ctx, p, requesterSid, args, log); err != nil {
 		return JSONProblemf(ctx, http.StatusInternalServerError, "", "%s", err)
 	} else {
-		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{SessionID: sid})
+		return ctx.JSON(http.StatusOK, api.InstanceActionAccepted{JobID: sid, SessionID: sid})
 	}
 }
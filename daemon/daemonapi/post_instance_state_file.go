@@ -34,13 +34,13 @@ func (a *DaemonAPI) PostInstanceStateFile(ctx echo.Context, nodename, namespace
 }
 
 func (a *DaemonAPI) postLocalObjectStateFile(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
-	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
-		return err
-	}
 	p, err := naming.NewPath(namespace, kind, name)
 	if err != nil {
 		return JSONProblemf(ctx, http.StatusBadRequest, "Bad request path", fmt.Sprint(err))
 	}
+	if v, err := assertPermission(ctx, rbac.OpInstanceStateFile, p); !v {
+		return err
+	}
 	if !p.Exists() {
 		return JSONProblemf(ctx, http.StatusNotFound, "Object not found", "")
 	}
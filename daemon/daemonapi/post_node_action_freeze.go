@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostPeerActionFreeze(ctx echo.Context, nodename string, para
 }
 
 func (a *DaemonAPI) localNodeActionFreeze(ctx echo.Context, params api.PostPeerActionFreezeParams) error {
-	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpNodeFreeze, naming.Path{}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostPeerActionFreeze")
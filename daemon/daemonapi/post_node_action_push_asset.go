@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostNodeActionPushAsset(ctx echo.Context, nodename string, p
 }
 
 func (a *DaemonAPI) localNodeActionPushAsset(ctx echo.Context, params api.PostNodeActionPushAssetParams) error {
-	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpNodePushAsset, naming.Path{}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostNodeActionPushAsset")
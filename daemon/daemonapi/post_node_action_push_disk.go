@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostNodeActionPushDisk(ctx echo.Context, nodename string, pa
 }
 
 func (a *DaemonAPI) localNodeActionPushDisk(ctx echo.Context, params api.PostNodeActionPushDiskParams) error {
-	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpNodePushDisk, naming.Path{}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostNodeActionPushDisk")
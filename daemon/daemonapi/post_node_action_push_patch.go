@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostNodeActionPushPatch(ctx echo.Context, nodename string, p
 }
 
 func (a *DaemonAPI) localNodeActionPushPatch(ctx echo.Context, params api.PostNodeActionPushPatchParams) error {
-	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpNodePushPatch, naming.Path{}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostNodeActionPushPatch")
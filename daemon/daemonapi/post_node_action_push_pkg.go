@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostNodeActionPushPkg(ctx echo.Context, nodename string, par
 }
 
 func (a *DaemonAPI) localNodeActionPushPkg(ctx echo.Context, params api.PostNodeActionPushPkgParams) error {
-	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpNodePushPkg, naming.Path{}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostNodeActionPushPkg")
@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostNodeActionScanCapabilities(ctx echo.Context, nodename st
 }
 
 func (a *DaemonAPI) localNodeActionScanCapabilities(ctx echo.Context, params api.PostNodeActionScanCapabilitiesParams) error {
-	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpNodeScanCapabilities, naming.Path{}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostNodeActionScanCapabilities")
@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostNodeActionSysreport(ctx echo.Context, nodename string, p
 }
 
 func (a *DaemonAPI) localNodeActionSysreport(ctx echo.Context, params api.PostNodeActionSysreportParams) error {
-	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpNodeSysreport, naming.Path{}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostNodeActionSysreport")
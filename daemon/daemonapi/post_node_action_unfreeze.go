@@ -22,7 +22,7 @@ func (a *DaemonAPI) PostPeerActionUnfreeze(ctx echo.Context, nodename string, pa
 }
 
 func (a *DaemonAPI) localNodeActionUnfreeze(ctx echo.Context, params api.PostPeerActionUnfreezeParams) error {
-	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpNodeUnfreeze, naming.Path{}); !v {
 		return err
 	}
 	log := LogHandler(ctx, "PostPeerActionUnfreeze")
@@ -7,10 +7,11 @@ import (
 	"github.com/opensvc/om3/core/client"
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/rbac"
 )
 
 func (a *DaemonAPI) PostObjectActionDelete(ctx echo.Context, namespace string, kind naming.Kind, name string) error {
-	return a.postObjectAction(ctx, namespace, kind, name, instance.MonitorGlobalExpectDeleted, func(c *client.T) (*http.Response, error) {
+	return a.postObjectAction(ctx, namespace, kind, name, rbac.OpObjectDelete, instance.MonitorGlobalExpectDeleted, func(c *client.T) (*http.Response, error) {
 		return c.PostObjectActionDelete(ctx.Request().Context(), namespace, kind, name)
 	})
 }
@@ -11,8 +11,7 @@ import (
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/daemon/api"
-	"github.com/opensvc/om3/daemon/msgbus"
-	"github.com/opensvc/om3/util/pubsub"
+	"github.com/opensvc/om3/daemon/rbac"
 )
 
 func (a *DaemonAPI) PostObjectActionRestart(eCtx echo.Context, namespace string, kind naming.Kind, name string) error {
@@ -20,6 +19,9 @@ func (a *DaemonAPI) PostObjectActionRestart(eCtx echo.Context, namespace string,
 	if err != nil {
 		return JSONProblemf(eCtx, http.StatusBadRequest, "Invalid parameters", "%s", err)
 	}
+	if v, err := assertPermission(eCtx, rbac.OpObjectRestart, p); !v {
+		return err
+	}
 	if instMon := instance.MonitorData.Get(p, a.localhost); instMon != nil {
 		var payload api.PostObjectActionRestart
 		if err := eCtx.Bind(&payload); err != nil {
@@ -40,11 +42,7 @@ func (a *DaemonAPI) PostObjectActionRestart(eCtx echo.Context, namespace string,
 			CandidateOrchestrationID: uuid.New(),
 		}
 
-		msg, setInstanceMonitorErr := msgbus.NewSetInstanceMonitorWithErr(ctx, p, a.localhost, value)
-
-		a.EventBus.Pub(msg, pubsub.Label{"path", p.String()}, labelAPI)
-
-		return JSONFromSetInstanceMonitorError(eCtx, &value, setInstanceMonitorErr.Receive())
+		return a.queueOrchestration(ctx, eCtx, p, value)
 	}
 	for nodename, _ := range instance.MonitorData.GetByPath(p) {
 		return a.proxy(eCtx, nodename, func(c *client.T) (*http.Response, error) {
@@ -17,7 +17,7 @@ import (
 func (a *DaemonAPI) PostObjectKVStoreEntry(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PostObjectKVStoreEntryParams) error {
 	log := LogHandler(ctx, "PostObjectKVStoreEntry")
 
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpObjectKVStoreUpdate, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 
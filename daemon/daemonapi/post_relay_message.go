@@ -32,11 +32,16 @@ func (a *DaemonAPI) PostRelayMessage(ctx echo.Context) error {
 	value.ClusterID = payload.ClusterID
 	value.Nodename = payload.Nodename
 	value.Msg = payload.Msg
+	if payload.Seq != nil {
+		value.Seq = *payload.Seq
+	}
 	value.UpdatedAt = time.Now()
 	value.NodeAddr = ctx.Request().RemoteAddr
 	value.Username = username
 
-	relay.Map.Store(username, payload.ClusterID, payload.Nodename, value)
+	if err := relay.Map.Store(username, payload.ClusterID, payload.Nodename, value); err != nil {
+		log.Warnf("persist %s %s: %s", payload.ClusterID, payload.Nodename, err)
+	}
 	log.Debugf("stored %s %s", payload.ClusterID, payload.Nodename)
 	return JSONProblemf(ctx, http.StatusOK, "stored", "at %s from %s", value.UpdatedAt, value.NodeAddr)
 }
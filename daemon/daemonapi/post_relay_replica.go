@@ -0,0 +1,36 @@
+package daemonapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/rbac"
+	"github.com/opensvc/om3/daemon/relay"
+)
+
+func (a *DaemonAPI) PostRelayReplica(ctx echo.Context) error {
+	var value api.RelayMessage
+	log := LogHandler(ctx, "PostRelayReplica")
+	log.Debugf("starting")
+
+	if v, err := assertGrant(ctx, rbac.GrantRoot); !v {
+		return err
+	}
+	if err := ctx.Bind(&value); err != nil {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "%s", err)
+	}
+	if value.Relay == "" || value.Username == "" || value.ClusterID == "" || value.Nodename == "" {
+		return JSONProblemf(ctx, http.StatusBadRequest, "Invalid body", "relay, username, cluster_id and nodename are required")
+	}
+	stored, err := relay.Map.StoreReplica(value, value.Relay)
+	if err != nil {
+		log.Warnf("persist replica %s %s from %s: %s", value.ClusterID, value.Nodename, value.Relay, err)
+	}
+	if !stored {
+		return JSONProblemf(ctx, http.StatusOK, "ignored", "not more recent than the stored message")
+	}
+	log.Debugf("stored replica %s %s from %s", value.ClusterID, value.Nodename, value.Relay)
+	return JSONProblemf(ctx, http.StatusOK, "stored", "at %s from %s", value.UpdatedAt, value.Relay)
+}
@@ -16,7 +16,7 @@ import (
 func (a *DaemonAPI) PostSvcDisable(ctx echo.Context, namespace string, name string, params api.PostSvcDisableParams) error {
 	log := LogHandler(ctx, "PostSvcDisable")
 
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpObjectDisable, naming.Path{Namespace: namespace, Kind: naming.KindSvc, Name: name}); !v {
 		return err
 	}
 
@@ -16,7 +16,7 @@ import (
 func (a *DaemonAPI) PostSvcEnable(ctx echo.Context, namespace string, name string, params api.PostSvcEnableParams) error {
 	log := LogHandler(ctx, "PostSvcEnable")
 
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpObjectEnable, naming.Path{Namespace: namespace, Kind: naming.KindSvc, Name: name}); !v {
 		return err
 	}
 
@@ -18,7 +18,7 @@ import (
 func (a *DaemonAPI) PutObjectKVStoreEntry(ctx echo.Context, namespace string, kind naming.Kind, name string, params api.PutObjectKVStoreEntryParams) error {
 	log := LogHandler(ctx, "PutObjectKVStoreEntry")
 
-	if v, err := assertGrant(ctx, rbac.NewGrant(rbac.RoleAdmin, namespace), rbac.GrantRoot); !v {
+	if v, err := assertPermission(ctx, rbac.OpObjectKVStoreUpdate, naming.Path{Namespace: namespace, Kind: kind, Name: name}); !v {
 		return err
 	}
 
@@ -10,9 +10,12 @@ import (
 
 	"github.com/go-chi/jwtauth/v5"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/shaj13/go-guardian/v2/auth"
 	"github.com/shaj13/go-guardian/v2/auth/strategies/token"
 	"golang.org/x/crypto/ssh"
+
+	"github.com/opensvc/om3/daemon/authtoken"
 )
 
 type (
@@ -22,6 +25,11 @@ type (
 	// apiClaims defines api claims
 	apiClaims struct {
 		Grant []string `json:"grant"`
+
+		// TokenUse is "refresh" for the refresh tokens. The refresh tokens
+		// are only accepted by the token refresh handler.
+		TokenUse string `json:"token_use,omitempty"`
+
 		*jwt.RegisteredClaims
 	}
 
@@ -36,6 +44,10 @@ type (
 var (
 	jwtAuth *jwtauth.JWTAuth
 
+	// jwtCacheMaxTTL is the maximum duration a validated token is cached, so
+	// a token revocation is applied within this delay.
+	jwtCacheMaxTTL = 5 * time.Second
+
 	// jwtVerifyKeySign is the jwt verify key signature initialized during initAuthJWT
 	jwtVerifyKeySign string
 )
@@ -61,9 +73,32 @@ func initJWT(i interface{}) (string, auth.Strategy, error) {
 			return
 		}
 		claims := tk.Claims.(*apiClaims)
+		var issuedAt time.Time
+		if claims.IssuedAt != nil {
+			issuedAt = claims.IssuedAt.Time
+		}
+		if authtoken.Registry.IsRevoked(claims.ID, claims.Subject, issuedAt) {
+			err = fmt.Errorf("strategies/jwt: token is revoked")
+			return
+		}
 		exp = claims.ExpiresAt.Time
+		if maxExp := time.Now().Add(jwtCacheMaxTTL); exp.After(maxExp) {
+			exp = maxExp
+		}
 
-		extensions := authenticatedExtensions("jwt", claims.Grant...)
+		var extensions *auth.Extensions
+		if claims.TokenUse == string(authtoken.UseRefresh) {
+			// a refresh token has no grant, its grants are only given to
+			// the access tokens it refreshes.
+			extensions = authenticatedExtensions("jwt")
+			(*extensions)["token_use"] = []string{claims.TokenUse}
+			(*extensions)["refresh_grant"] = claims.Grant
+		} else {
+			extensions = authenticatedExtensions("jwt", claims.Grant...)
+		}
+		if claims.ID != "" {
+			extensions.Set("token_id", claims.ID)
+		}
 		info = auth.NewUserInfo(claims.Subject, claims.Subject, nil, *extensions)
 		return
 	}
@@ -127,10 +162,13 @@ func (*JWTCreator) CreateUserToken(userInfo auth.Info, duration time.Duration, x
 	if jwtAuth == nil {
 		return
 	}
-	expiredAt = time.Now().Add(duration)
+	now := time.Now()
+	expiredAt = now.Add(duration)
 	claims := map[string]interface{}{
 		"sub":   userInfo.GetUserName(),
 		"exp":   expiredAt.Unix(),
+		"iat":   now.Unix(),
+		"jti":   uuid.New().String(),
 		"grant": userInfo.GetExtensions()["grant"],
 	}
 	for c, v := range xClaims {
@@ -20,9 +20,11 @@ type (
 	AllStrategieser interface {
 		ListenAddresser
 		JWTFiler
+		OpenIDConfiger
 		X509CACertFiler
 		NodeAuthenticater
 		UserGranter
+		UxGranter
 	}
 	contextKey int
 )
@@ -83,6 +85,7 @@ func InitStategies(ctx context.Context, i any) (union.Union, error) {
 	for _, fn := range []func(i interface{}) (string, auth.Strategy, error){
 		initUX,
 		initJWT,
+		initOpenID,
 		initX509,
 		initBasicNode,
 		initBasicUser,
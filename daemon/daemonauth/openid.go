@@ -0,0 +1,278 @@
+package daemonauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/shaj13/go-guardian/v2/auth"
+	"github.com/shaj13/go-guardian/v2/auth/strategies/token"
+)
+
+type (
+	// OpenIDConfiger is the interface for the OpenIDConfig method for
+	// openid auth. The config is read on each token validation, so the
+	// config changes apply without a listener restart.
+	OpenIDConfiger interface {
+		OpenIDConfig() OpenIDConfig
+	}
+
+	// OpenIDConfig is the openid strategy configuration.
+	OpenIDConfig struct {
+		// WellKnown is the url of the openid provider well-known
+		// configuration. The openid tokens are refused if empty.
+		WellKnown string
+
+		// ClientID is the audience expected in the tokens.
+		ClientID string
+
+		// GrantClaim is the claim holding the user grants.
+		GrantClaim string
+	}
+
+	// openIDProvider caches the well-known configuration and the signing
+	// keys of an openid provider.
+	openIDProvider struct {
+		sync.Mutex
+		client *http.Client
+
+		wellKnown string
+		issuer    string
+		keys      jwk.Set
+		fetchedAt time.Time
+
+		// triedAt, triedWellKnown and triedErr are the time, url and
+		// error of the last fetch attempt.
+		triedAt        time.Time
+		triedWellKnown string
+		triedErr       error
+	}
+
+	openIDWellKnown struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+)
+
+var (
+	// openIDKeysTTL is the duration after which the provider keys are
+	// fetched again.
+	openIDKeysTTL = time.Hour
+
+	// openIDRefreshMinInterval is the minimum interval between two fetches
+	// of the provider keys, so tokens signed by unknown keys can't make
+	// the daemon flood the provider.
+	openIDRefreshMinInterval = 10 * time.Second
+
+	openIDFetchTimeout = 10 * time.Second
+
+	openIDValidMethods = []string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "PS256", "PS384", "PS512"}
+
+	errOpenIDNotConfigured = errors.New("openid is not configured")
+)
+
+func initOpenID(i interface{}) (string, auth.Strategy, error) {
+	name := "openid"
+	configer, ok := i.(OpenIDConfiger)
+	if !ok {
+		return name, nil, fmt.Errorf("missing openid config")
+	}
+	provider := &openIDProvider{
+		client: &http.Client{Timeout: openIDFetchTimeout},
+	}
+	validate := func(ctx context.Context, r *http.Request, s string) (auth.Info, time.Time, error) {
+		return provider.validate(ctx, configer.OpenIDConfig(), s)
+	}
+	return name, token.New(validate, cache), nil
+}
+
+// validate verifies the token <s> and returns the user info, with the
+// grants found in the config GrantClaim.
+func (p *openIDProvider) validate(ctx context.Context, config OpenIDConfig, s string) (info auth.Info, exp time.Time, err error) {
+	if config.WellKnown == "" {
+		err = errOpenIDNotConfigured
+		return
+	}
+	issuer, err := p.getIssuer(ctx, config.WellKnown)
+	if err != nil {
+		return
+	}
+	claims := jwt.MapClaims{}
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(openIDValidMethods),
+		jwt.WithIssuer(issuer),
+		jwt.WithExpirationRequired(),
+	}
+	if config.ClientID != "" {
+		options = append(options, jwt.WithAudience(config.ClientID))
+	}
+	keyFunc := func(tk *jwt.Token) (interface{}, error) {
+		kid, _ := tk.Header["kid"].(string)
+		return p.getKey(ctx, config.WellKnown, kid)
+	}
+	if _, err = jwt.ParseWithClaims(s, claims, keyFunc, options...); err != nil {
+		return
+	}
+	if v, e := claims.GetExpirationTime(); e != nil {
+		err = e
+		return
+	} else {
+		exp = v.Time
+	}
+	username := openIDUsername(claims)
+	if username == "" {
+		err = fmt.Errorf("openid token has no preferred_username, name or sub claim")
+		return
+	}
+	grantClaim := config.GrantClaim
+	if grantClaim == "" {
+		grantClaim = "grant"
+	}
+	extensions := authenticatedExtensions("openid", openIDGrants(claims[grantClaim])...)
+	info = auth.NewUserInfo(username, username, nil, *extensions)
+	return
+}
+
+// getIssuer returns the issuer of the <wellKnown> provider, fetching the
+// provider configuration and keys if not cached or expired.
+func (p *openIDProvider) getIssuer(ctx context.Context, wellKnown string) (string, error) {
+	p.Lock()
+	defer p.Unlock()
+	if p.wellKnown != wellKnown || time.Since(p.fetchedAt) > openIDKeysTTL {
+		if err := p.refresh(ctx, wellKnown); err != nil && p.wellKnown != wellKnown {
+			// on refresh error, the expired cache is still used
+			return "", err
+		}
+	}
+	return p.issuer, nil
+}
+
+// getKey returns the public key <kid> of the <wellKnown> provider. The keys
+// are fetched again when <kid> is not found, to support the provider key
+// rotation.
+func (p *openIDProvider) getKey(ctx context.Context, wellKnown, kid string) (interface{}, error) {
+	p.Lock()
+	defer p.Unlock()
+	key, ok := p.lookupKey(kid)
+	if !ok {
+		if err := p.refresh(ctx, wellKnown); err != nil {
+			return nil, err
+		}
+		key, ok = p.lookupKey(kid)
+	}
+	if !ok {
+		return nil, fmt.Errorf("openid key '%s' not found", kid)
+	}
+	var raw interface{}
+	if err := key.Raw(&raw); err != nil {
+		return nil, fmt.Errorf("openid key '%s': %w", kid, err)
+	}
+	return raw, nil
+}
+
+func (p *openIDProvider) lookupKey(kid string) (jwk.Key, bool) {
+	if p.keys == nil {
+		return nil, false
+	}
+	if kid != "" {
+		return p.keys.LookupKeyID(kid)
+	}
+	if p.keys.Len() == 1 {
+		// a token without kid header can only be verified by a single
+		// key provider
+		return p.keys.Get(0)
+	}
+	return nil, false
+}
+
+// refresh fetches the <wellKnown> provider configuration and keys. The
+// fetch is not retried before openIDRefreshMinInterval, the last fetch error
+// is returned instead.
+func (p *openIDProvider) refresh(ctx context.Context, wellKnown string) error {
+	if p.triedWellKnown == wellKnown && time.Since(p.triedAt) < openIDRefreshMinInterval {
+		return p.triedErr
+	}
+	p.triedAt = time.Now()
+	p.triedWellKnown = wellKnown
+	p.triedErr = p.fetchAll(ctx, wellKnown)
+	return p.triedErr
+}
+
+func (p *openIDProvider) fetchAll(ctx context.Context, wellKnown string) error {
+	ctx, cancel := context.WithTimeout(ctx, openIDFetchTimeout)
+	defer cancel()
+	var config openIDWellKnown
+	if err := p.fetch(ctx, wellKnown, &config); err != nil {
+		return fmt.Errorf("openid well-known: %w", err)
+	}
+	if config.Issuer == "" || config.JWKSURI == "" {
+		return fmt.Errorf("openid well-known: missing issuer or jwks_uri")
+	}
+	var b json.RawMessage
+	if err := p.fetch(ctx, config.JWKSURI, &b); err != nil {
+		return fmt.Errorf("openid jwks: %w", err)
+	}
+	keys, err := jwk.Parse(b)
+	if err != nil {
+		return fmt.Errorf("openid jwks: %w", err)
+	}
+	p.wellKnown = wellKnown
+	p.issuer = config.Issuer
+	p.keys = keys
+	p.fetchedAt = time.Now()
+	return nil
+}
+
+func (p *openIDProvider) fetch(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("get %s: unexpected status %s", url, resp.Status)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func openIDUsername(claims jwt.MapClaims) string {
+	for _, k := range []string{"preferred_username", "name", "sub"} {
+		if s, ok := claims[k].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// openIDGrants returns the grants from a claim value, which can be a list
+// of grants or a string of space separated grants.
+func openIDGrants(i any) []string {
+	grants := make([]string, 0)
+	switch v := i.(type) {
+	case string:
+		grants = append(grants, strings.Fields(v)...)
+	case []any:
+		for _, e := range v {
+			if s, ok := e.(string); ok {
+				grants = append(grants, strings.Fields(s)...)
+			}
+		}
+	}
+	return grants
+}
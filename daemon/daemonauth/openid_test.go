@@ -0,0 +1,192 @@
+package daemonauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	// fakeIssuer is a local openid provider serving a well-known
+	// configuration and the public key of its current signing key.
+	fakeIssuer struct {
+		*httptest.Server
+
+		mu           sync.Mutex
+		kid          string
+		key          *rsa.PrivateKey
+		jwksGetCount int
+	}
+)
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	t.Helper()
+	f := &fakeIssuer{}
+	f.rotate(t, "k1")
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(openIDWellKnown{
+			Issuer:  f.URL,
+			JWKSURI: f.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.jwksGetCount++
+		key, err := jwk.New(&f.key.PublicKey)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_ = key.Set(jwk.KeyIDKey, f.kid)
+		set := jwk.NewSet()
+		set.Add(key)
+		_ = json.NewEncoder(w).Encode(set)
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeIssuer) wellKnown() string {
+	return f.URL + "/.well-known/openid-configuration"
+}
+
+// rotate replaces the signing key.
+func (f *fakeIssuer) rotate(t *testing.T, kid string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.kid = kid
+	f.key = key
+}
+
+func (f *fakeIssuer) token(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	tk := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tk.Header["kid"] = f.kid
+	s, err := tk.SignedString(f.key)
+	require.NoError(t, err)
+	return s
+}
+
+func (f *fakeIssuer) claims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":                f.URL,
+		"aud":                "cluster1",
+		"sub":                "8e1b5f8e",
+		"preferred_username": "alice",
+		"exp":                time.Now().Add(time.Minute).Unix(),
+		"entitlements":       []string{"admin:ns1", "guest:ns2"},
+	}
+}
+
+func TestOpenIDValidate(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	config := OpenIDConfig{
+		WellKnown:  issuer.wellKnown(),
+		ClientID:   "cluster1",
+		GrantClaim: "entitlements",
+	}
+	p := &openIDProvider{client: http.DefaultClient}
+	ctx := context.Background()
+
+	t.Run("valid token", func(t *testing.T) {
+		info, exp, err := p.validate(ctx, config, issuer.token(t, issuer.claims()))
+		require.NoError(t, err)
+		require.Equal(t, "alice", info.GetUserName())
+		require.Equal(t, []string{"admin:ns1", "guest:ns2"}, info.GetExtensions()["grant"])
+		require.Equal(t, []string{"openid"}, info.GetExtensions()["strategy"])
+		require.WithinDuration(t, time.Now().Add(time.Minute), exp, 2*time.Second)
+	})
+
+	t.Run("space separated grants", func(t *testing.T) {
+		claims := issuer.claims()
+		claims["entitlements"] = "root guest:ns2"
+		info, _, err := p.validate(ctx, config, issuer.token(t, claims))
+		require.NoError(t, err)
+		require.Equal(t, []string{"root", "guest:ns2"}, info.GetExtensions()["grant"])
+	})
+
+	t.Run("invalid tokens", func(t *testing.T) {
+		cases := map[string]func(jwt.MapClaims){
+			"wrong audience": func(c jwt.MapClaims) { c["aud"] = "cluster2" },
+			"wrong issuer":   func(c jwt.MapClaims) { c["iss"] = "https://other" },
+			"expired":        func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() },
+			"no expiry":      func(c jwt.MapClaims) { delete(c, "exp") },
+		}
+		for name, alter := range cases {
+			t.Run(name, func(t *testing.T) {
+				claims := issuer.claims()
+				alter(claims)
+				_, _, err := p.validate(ctx, config, issuer.token(t, claims))
+				require.Error(t, err)
+			})
+		}
+	})
+
+	t.Run("token signed by an unknown key", func(t *testing.T) {
+		other := newFakeIssuer(t)
+		claims := issuer.claims()
+		_, _, err := p.validate(ctx, config, other.token(t, claims))
+		require.Error(t, err)
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		_, _, err := p.validate(ctx, OpenIDConfig{}, issuer.token(t, issuer.claims()))
+		require.ErrorIs(t, err, errOpenIDNotConfigured)
+	})
+}
+
+func TestOpenIDKeyRotation(t *testing.T) {
+	defer func(d time.Duration) { openIDRefreshMinInterval = d }(openIDRefreshMinInterval)
+	openIDRefreshMinInterval = 0
+
+	issuer := newFakeIssuer(t)
+	config := OpenIDConfig{WellKnown: issuer.wellKnown(), ClientID: "cluster1"}
+	p := &openIDProvider{client: http.DefaultClient}
+	ctx := context.Background()
+
+	_, _, err := p.validate(ctx, config, issuer.token(t, issuer.claims()))
+	require.NoError(t, err)
+	_, _, err = p.validate(ctx, config, issuer.token(t, issuer.claims()))
+	require.NoError(t, err)
+	require.Equal(t, 1, issuer.jwksGetCount, "the keys must be cached")
+
+	issuer.rotate(t, "k2")
+	_, _, err = p.validate(ctx, config, issuer.token(t, issuer.claims()))
+	require.NoError(t, err, "the keys must be fetched again on unknown key id")
+	require.Equal(t, 2, issuer.jwksGetCount)
+}
+
+func TestOpenIDRefreshMinInterval(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	config := OpenIDConfig{WellKnown: issuer.wellKnown(), ClientID: "cluster1"}
+	p := &openIDProvider{client: http.DefaultClient}
+	ctx := context.Background()
+
+	_, _, err := p.validate(ctx, config, issuer.token(t, issuer.claims()))
+	require.NoError(t, err)
+
+	issuer.rotate(t, "k2")
+	for i := 0; i < 3; i++ {
+		_, _, err = p.validate(ctx, config, issuer.token(t, issuer.claims()))
+		require.Error(t, err)
+	}
+	require.Equal(t, 1, issuer.jwksGetCount, "the keys must not be fetched again before the min interval")
+}
@@ -5,35 +5,128 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"os/user"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/shaj13/go-guardian/v2/auth"
+
+	"github.com/opensvc/om3/daemon/daemonctx"
 )
 
 type (
 	uxStrategy struct {
-		getter ListenAddresser
+		getter  ListenAddresser
+		granter UxGranter
 	}
 
 	// ListenAddresser is the interface for ListenAddr method for ux auth.
 	ListenAddresser interface {
 		ListenAddr(context.Context) string
 	}
+
+	// UxGranter is the interface for the ux auth of the local users.
+	UxGranter interface {
+		// PeerCred returns the credentials of the unix socket client.
+		PeerCred(context.Context) (daemonctx.PeerCredentials, bool)
+
+		// UxGrants returns the "user:<name>=<grant>" and
+		// "group:<name>=<grant>" mapping entries.
+		UxGrants() []string
+	}
+
+	// uxIdentity is a local user, with the names and ids to match against
+	// the mapping entries.
+	uxIdentity struct {
+		name   string
+		users  []string
+		groups []string
+	}
 )
 
+// lookupUxIdentity returns the identity of the local user with credentials
+// <cred>. The groups are the peer gid and the user groups.
+var lookupUxIdentity = func(cred daemonctx.PeerCredentials) uxIdentity {
+	uid := strconv.FormatUint(uint64(cred.UID), 10)
+	gids := []string{strconv.FormatUint(uint64(cred.GID), 10)}
+	id := uxIdentity{name: uid, users: []string{uid}}
+	if u, err := user.LookupId(uid); err == nil {
+		id.name = u.Username
+		id.users = append(id.users, u.Username)
+		if l, err := u.GroupIds(); err == nil {
+			gids = append(gids, l...)
+		}
+	}
+	for _, gid := range gids {
+		if slices.Contains(id.groups, gid) {
+			continue
+		}
+		id.groups = append(id.groups, gid)
+		if g, err := user.LookupGroupId(gid); err == nil {
+			id.groups = append(id.groups, g.Name)
+		}
+	}
+	return id
+}
+
 func (t uxStrategy) Authenticate(ctx context.Context, _ *http.Request) (auth.Info, error) {
 	addr := t.getter.ListenAddr(ctx)
 	if _, _, err := net.SplitHostPort(addr); err == nil {
 		return nil, fmt.Errorf("strategies/ux: is a inet address family client (%s)", addr) // How to continue ?
 	}
-	info := auth.NewUserInfo("root", "", nil, *authenticatedExtensions("ux", "root"))
+	cred, ok := t.granter.PeerCred(ctx)
+	if !ok {
+		return nil, fmt.Errorf("strategies/ux: unknown peer credentials")
+	}
+	if cred.UID == 0 {
+		info := auth.NewUserInfo("root", "0", nil, *authenticatedExtensions("ux", "root"))
+		return info, nil
+	}
+	id := lookupUxIdentity(cred)
+	grants := uxGrants(t.granter.UxGrants(), id)
+	if len(grants) == 0 {
+		return nil, fmt.Errorf("strategies/ux: no grant for local user %s (uid %d)", id.name, cred.UID)
+	}
+	info := auth.NewUserInfo(id.name, strconv.FormatUint(uint64(cred.UID), 10), nil, *authenticatedExtensions("ux", grants...))
 	return info, nil
 }
 
+// uxGrants returns the grants of the mapping <entries> matching the user or
+// the groups of <id>. The malformed entries are ignored.
+func uxGrants(entries []string, id uxIdentity) []string {
+	grants := make([]string, 0)
+	for _, entry := range entries {
+		selector, grant, ok := strings.Cut(entry, "=")
+		if !ok || grant == "" {
+			continue
+		}
+		kind, name, ok := strings.Cut(selector, ":")
+		if !ok {
+			continue
+		}
+		switch {
+		case kind == "user" && slices.Contains(id.users, name):
+		case kind == "group" && slices.Contains(id.groups, name):
+		default:
+			continue
+		}
+		if !slices.Contains(grants, grant) {
+			grants = append(grants, grant)
+		}
+	}
+	return grants
+}
+
 func initUX(i interface{}) (string, auth.Strategy, error) {
 	name := "ux auth"
 	fn, ok := i.(ListenAddresser)
 	if !ok {
 		return name, nil, fmt.Errorf("missing ListenAddresser interface")
 	}
-	return name, &uxStrategy{getter: fn}, nil
+	granter, ok := i.(UxGranter)
+	if !ok {
+		return name, nil, fmt.Errorf("missing UxGranter interface")
+	}
+	return name, &uxStrategy{getter: fn, granter: granter}, nil
 }
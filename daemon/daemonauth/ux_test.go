@@ -0,0 +1,114 @@
+package daemonauth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/daemon/daemonctx"
+)
+
+type (
+	fakeUxGranter struct {
+		addr    string
+		entries []string
+	}
+)
+
+func (f fakeUxGranter) ListenAddr(context.Context) string {
+	return f.addr
+}
+
+func (f fakeUxGranter) PeerCred(ctx context.Context) (daemonctx.PeerCredentials, bool) {
+	return daemonctx.PeerCred(ctx)
+}
+
+func (f fakeUxGranter) UxGrants() []string {
+	return f.entries
+}
+
+func TestUxGrants(t *testing.T) {
+	id := uxIdentity{
+		name:   "alice",
+		users:  []string{"1001", "alice"},
+		groups: []string{"1001", "alice", "2000", "ops"},
+	}
+	cases := map[string]struct {
+		entries  []string
+		expected []string
+	}{
+		"group name": {
+			entries:  []string{"group:ops=operator:ns1", "group:dev=admin:ns1"},
+			expected: []string{"operator:ns1"},
+		},
+		"group id and user name": {
+			entries:  []string{"group:2000=operator:ns1", "user:alice=admin:ns2"},
+			expected: []string{"operator:ns1", "admin:ns2"},
+		},
+		"user id and duplicates": {
+			entries:  []string{"user:1001=guest:ns1", "group:alice=guest:ns1"},
+			expected: []string{"guest:ns1"},
+		},
+		"malformed entries": {
+			entries:  []string{"ops=operator:ns1", "group:ops", "group:ops=", "host:ops=root"},
+			expected: []string{},
+		},
+		"no entry": {
+			expected: []string{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, uxGrants(tc.entries, id))
+		})
+	}
+}
+
+func TestUxAuthenticate(t *testing.T) {
+	defer func(fn func(daemonctx.PeerCredentials) uxIdentity) { lookupUxIdentity = fn }(lookupUxIdentity)
+	lookupUxIdentity = func(cred daemonctx.PeerCredentials) uxIdentity {
+		return uxIdentity{name: "alice", users: []string{"1001", "alice"}, groups: []string{"2000", "ops"}}
+	}
+	granter := fakeUxGranter{addr: "/var/lib/opensvc/lsnr/http.sock", entries: []string{"group:ops=operator:ns1"}}
+	_, strategy, err := initUX(granter)
+	require.NoError(t, err)
+
+	t.Run("root", func(t *testing.T) {
+		ctx := daemonctx.WithPeerCred(context.Background(), daemonctx.PeerCredentials{UID: 0})
+		info, err := strategy.Authenticate(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, "root", info.GetUserName())
+		require.Equal(t, []string{"root"}, info.GetExtensions()["grant"])
+	})
+
+	t.Run("mapped user", func(t *testing.T) {
+		ctx := daemonctx.WithPeerCred(context.Background(), daemonctx.PeerCredentials{UID: 1001, GID: 1001})
+		info, err := strategy.Authenticate(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, "alice", info.GetUserName())
+		require.Equal(t, []string{"operator:ns1"}, info.GetExtensions()["grant"])
+		require.Equal(t, []string{"ux"}, info.GetExtensions()["strategy"])
+	})
+
+	t.Run("user without grant", func(t *testing.T) {
+		_, strategy, err := initUX(fakeUxGranter{addr: granter.addr})
+		require.NoError(t, err)
+		ctx := daemonctx.WithPeerCred(context.Background(), daemonctx.PeerCredentials{UID: 1001, GID: 1001})
+		_, err = strategy.Authenticate(ctx, nil)
+		require.ErrorContains(t, err, "no grant")
+	})
+
+	t.Run("unknown peer credentials", func(t *testing.T) {
+		_, err := strategy.Authenticate(context.Background(), nil)
+		require.ErrorContains(t, err, "unknown peer credentials")
+	})
+
+	t.Run("inet client", func(t *testing.T) {
+		_, strategy, err := initUX(fakeUxGranter{addr: "127.0.0.1:1215"})
+		require.NoError(t, err)
+		ctx := daemonctx.WithPeerCred(context.Background(), daemonctx.PeerCredentials{UID: 0})
+		_, err = strategy.Authenticate(ctx, nil)
+		require.ErrorContains(t, err, "inet address family")
+	})
+}
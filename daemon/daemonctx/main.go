@@ -10,6 +10,14 @@ import (
 
 type (
 	contextKey string
+
+	// PeerCredentials are the credentials of the process connected to a
+	// unix socket listener.
+	PeerCredentials struct {
+		PID int32
+		UID uint32
+		GID uint32
+	}
 )
 
 var (
@@ -17,6 +25,7 @@ var (
 	contextUUID           = contextKey("uuid")
 	contextListenAddr     = contextKey("listen-addr")
 	contextLsnrType       = contextKey("lsnr-type")
+	contextPeerCred       = contextKey("peer-cred")
 )
 
 func (c contextKey) String() string {
@@ -81,3 +90,15 @@ func UUID(ctx context.Context) uuid.UUID {
 func WithListenAddr(parent context.Context, addr string) context.Context {
 	return context.WithValue(parent, contextListenAddr, addr)
 }
+
+// PeerCred function returns the unix socket peer credentials from context
+func PeerCred(ctx context.Context) (PeerCredentials, bool) {
+	cred, ok := ctx.Value(contextPeerCred).(PeerCredentials)
+	return cred, ok
+}
+
+// WithPeerCred function returns copy of parent with the unix socket peer
+// credentials.
+func WithPeerCred(parent context.Context, cred PeerCredentials) context.Context {
+	return context.WithValue(parent, contextPeerCred, cred)
+}
@@ -50,6 +50,7 @@ func (d *data) refreshPreviousUpdated(peer string) *remoteInfo {
 	result.daemondataUpdated = c.Daemon.Daemondata.UpdatedAt
 	result.dnsUpdated = c.Daemon.Dns.UpdatedAt
 	result.listenerUpdated = c.Daemon.Listener.UpdatedAt
+	result.notifyUpdated = c.Daemon.Notify.UpdatedAt
 	result.runnerImon = c.Daemon.RunnerImon.UpdatedAt
 	result.scheduler = c.Daemon.Scheduler.UpdatedAt
 
@@ -90,6 +91,7 @@ func (d *data) pubPeerDataChanges(peer string) {
 	d.pubMsgFromNodeDaemondataDiffForNode(peer, current)
 	d.pubMsgFromNodeDnsDiffForNode(peer, current)
 	d.pubMsgFromNodeListenerDiffForNode(peer, current)
+	d.pubMsgFromNodeNotifyDiffForNode(peer, current)
 	d.pubMsgFromNodeRunnerImonDiffForNode(peer, current)
 	d.pubMsgFromNodeSchedulerDiffForNode(peer, current)
 	d.pubMsgFromNodeMonitorDiffForNode(peer, current)
@@ -274,6 +276,22 @@ func (d *data) pubMsgFromNodeListenerDiffForNode(peer string, current *remoteInf
 	}
 }
 
+func (d *data) pubMsgFromNodeNotifyDiffForNode(peer string, current *remoteInfo) {
+	if current == nil {
+		return
+	}
+	prevTimes, hasPrev := d.previousRemoteInfo[peer]
+	if !hasPrev || current.notifyUpdated.After(prevTimes.notifyUpdated) {
+		a := d.clusterData.Cluster.Node[peer].Daemon.Notify
+		daemonsubsystem.DataNotify.Set(peer, a.DeepCopy())
+		d.bus.Pub(&msgbus.DaemonNotifyUpdated{Node: peer, Value: *a.DeepCopy()},
+			pubsub.Label{"node", peer},
+			labelFromPeer,
+		)
+		return
+	}
+}
+
 func (d *data) pubMsgFromNodeRunnerImonDiffForNode(peer string, current *remoteInfo) {
 	if current == nil {
 		return
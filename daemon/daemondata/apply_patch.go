@@ -147,6 +147,9 @@ func (d *data) setCacheAndPublish(ev event.Event) error {
 	case *msgbus.DaemonListenerUpdated:
 		daemonsubsystem.DataListener.Set(c.Node, &c.Value)
 		d.bus.Pub(c, labelFromPeer)
+	case *msgbus.DaemonNotifyUpdated:
+		daemonsubsystem.DataNotify.Set(c.Node, &c.Value)
+		d.bus.Pub(c, labelFromPeer)
 	case *msgbus.DaemonRunnerImonUpdated:
 		daemonsubsystem.DataRunnerImon.Set(c.Node, &c.Value)
 		d.bus.Pub(c, labelFromPeer)
@@ -32,8 +32,9 @@ func (d *data) setDaemonHeartbeat() {
 	}
 
 	subHb := daemonsubsystem.Heartbeat{
-		Streams:      hbcache.Heartbeats(),
-		LastMessages: lastMessages,
+		Streams:        hbcache.Heartbeats(),
+		SecretRotation: d.secretRotation,
+		LastMessages:   lastMessages,
 		LastMessage: daemonsubsystem.HeartbeatLastMessage{
 			From:        d.localNode,
 			PatchLength: d.hbMsgPatchLength[d.localNode],
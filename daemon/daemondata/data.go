@@ -14,6 +14,7 @@ import (
 	"github.com/opensvc/om3/core/event"
 	"github.com/opensvc/om3/core/hbtype"
 	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/durationlog"
 	"github.com/opensvc/om3/util/plog"
@@ -67,6 +68,10 @@ type (
 		// - other nodes associated value is changed during onReceiveHbMsg
 		hbMsgType map[string]string
 
+		// secretRotation is the localhost cluster secret rotation progress,
+		// updated from msgbus.ClusterSecretRotationUpdated
+		secretRotation daemonsubsystem.HeartbeatSecretRotation
+
 		// hbGens holds the cluster nodes gens
 		//
 		// values are used for the choice of next message type choice
@@ -95,6 +100,7 @@ type (
 		daemondataUpdated time.Time
 		dnsUpdated        time.Time
 		listenerUpdated   time.Time
+		notifyUpdated     time.Time
 		runnerImon        time.Time
 		scheduler         time.Time
 
@@ -387,6 +393,7 @@ func gensEqual(a, b gens) bool {
 func (d *data) startSubscriptions(qs pubsub.QueueSizer) {
 	sub := d.bus.Sub("daemon.data", qs)
 	sub.AddFilter(&msgbus.ClusterConfigUpdated{}, d.labelLocalNode)
+	sub.AddFilter(&msgbus.ClusterSecretRotationUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.ClusterStatusUpdated{}, d.labelLocalNode)
 
 	sub.AddFilter(&msgbus.DaemonCollectorUpdated{}, d.labelLocalNode)
@@ -394,6 +401,7 @@ func (d *data) startSubscriptions(qs pubsub.QueueSizer) {
 	sub.AddFilter(&msgbus.DaemonDnsUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonHeartbeatUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonListenerUpdated{}, d.labelLocalNode)
+	sub.AddFilter(&msgbus.DaemonNotifyUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonRunnerImonUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonSchedulerUpdated{}, d.labelLocalNode)
 	sub.AddFilter(&msgbus.DaemonStatusUpdated{}, d.labelLocalNode)
@@ -449,6 +457,7 @@ func localEventMustBeForwarded(i interface{}) bool {
 	case *msgbus.DaemonDnsUpdated:
 	case *msgbus.DaemonHeartbeatUpdated:
 	case *msgbus.DaemonListenerUpdated:
+	case *msgbus.DaemonNotifyUpdated:
 	case *msgbus.DaemonRunnerImonUpdated:
 	case *msgbus.DaemonSchedulerUpdated:
 	case *msgbus.DaemonStatusUpdated:
@@ -491,6 +500,9 @@ func (d *data) updateClusterNodes(added, removed []string) {
 // when event is ClusterConfigUpdated: d.clusterNodes is refreshed and d.dropPeer
 // is called for NodesRemoved
 //
+// when event is ClusterSecretRotationUpdated: the daemon heartbeat is
+// refreshed with the new secret rotation progress
+//
 // finally event is applied to d.clusterData
 func (d *data) onSubEvent(i interface{}) {
 	if localEventMustBeForwarded(i) {
@@ -507,6 +519,11 @@ func (d *data) onSubEvent(i interface{}) {
 		}
 	}
 
+	if ev, ok := i.(*msgbus.ClusterSecretRotationUpdated); ok {
+		d.secretRotation = ev.Value
+		d.setDaemonHeartbeat()
+	}
+
 	if msg, ok := i.(pubsub.Messager); ok {
 		d.clusterData.ApplyMessage(msg)
 	}
@@ -77,7 +77,7 @@ func (o opGetClusterNodeData) call(ctx context.Context, d *data) error {
 // It publish ForgetPeer
 func (d *data) dropPeer(peer string) {
 	d.log.Infof("drop peer node %s", peer)
-	peerLabels := []pubsub.Label{{"node", peer}, {"from", "peer"}}
+	peerLabels := []pubsub.Label{{"node", peer}, labelFromPeer, msgbus.LabelDropPeer}
 
 	hbcache.DropPeer(peer)
 
@@ -107,6 +107,7 @@ func (d *data) dropPeer(peer string) {
 		d.bus.Pub(&msgbus.DaemonDnsUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonHeartbeatUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonListenerUpdated{Node: peer}, peerLabels...)
+		d.bus.Pub(&msgbus.DaemonNotifyUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonRunnerImonUpdated{Node: peer}, peerLabels...)
 		d.bus.Pub(&msgbus.DaemonSchedulerUpdated{Node: peer}, peerLabels...)
 	}
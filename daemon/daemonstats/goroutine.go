@@ -0,0 +1,103 @@
+package daemonstats
+
+import (
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/opensvc/om3/daemon/api"
+)
+
+const (
+	// modulePrefix is the prefix of the functions of the om3 packages in
+	// the goroutine stacks.
+	modulePrefix = "github.com/opensvc/om3/"
+
+	// otherSubsystem is the subsystem of the goroutines not related to an
+	// om3 package, like the go runtime ones.
+	otherSubsystem = "other"
+)
+
+// subsystemStats returns the goroutine counts by subsystem, and the total
+// goroutine count.
+func subsystemStats() ([]api.DaemonStatsSubsystem, int) {
+	return countGoroutines(goroutineStacks())
+}
+
+// goroutineStacks returns the stack traces of all the goroutines.
+func goroutineStacks() string {
+	buf := make([]byte, 1<<20)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return string(buf[:n])
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
+
+// countGoroutines returns the goroutine counts by subsystem of the stack
+// traces <s>, and the total goroutine count.
+func countGoroutines(s string) ([]api.DaemonStatsSubsystem, int) {
+	var total int
+	m := make(map[string]int)
+	for _, stack := range strings.Split(s, "\n\n") {
+		if !strings.HasPrefix(stack, "goroutine ") {
+			continue
+		}
+		total++
+		m[subsystemOf(stack)]++
+	}
+	l := make([]api.DaemonStatsSubsystem, 0, len(m))
+	for name, count := range m {
+		l = append(l, api.DaemonStatsSubsystem{Name: name, Goroutines: count})
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].Name < l[j].Name })
+	return l, total
+}
+
+// subsystemOf returns the om3 package accountable for the goroutine of the
+// stack trace <stack>: the package of the function that created the
+// goroutine, or else the package of the outermost om3 function of the
+// stack, like a request handler run by the net/http server goroutines.
+func subsystemOf(stack string) string {
+	lines := strings.Split(stack, "\n")
+	var funcs []string
+	for _, line := range lines[1:] {
+		if line == "" || strings.HasPrefix(line, "\t") {
+			// the file:line of the previous function
+			continue
+		}
+		if creator, ok := strings.CutPrefix(line, "created by "); ok {
+			creator, _, _ = strings.Cut(creator, " in goroutine ")
+			if pkg, ok := packageOf(creator); ok {
+				return pkg
+			}
+			continue
+		}
+		funcs = append(funcs, line)
+	}
+	for i := len(funcs) - 1; i >= 0; i-- {
+		if pkg, ok := packageOf(funcs[i]); ok {
+			return pkg
+		}
+	}
+	return otherSubsystem
+}
+
+// packageOf returns the om3 package path of a stack trace function, relative
+// to the module path. Example:
+//
+//	github.com/opensvc/om3/daemon/imon.(*Manager).worker(...) => daemon/imon
+func packageOf(fn string) (string, bool) {
+	fn, ok := strings.CutPrefix(fn, modulePrefix)
+	if !ok {
+		return "", false
+	}
+	dir, name := "", fn
+	if i := strings.LastIndex(fn, "/"); i >= 0 {
+		dir, name = fn[:i+1], fn[i+1:]
+	}
+	name, _, _ = strings.Cut(name, ".")
+	return dir + name, true
+}
@@ -0,0 +1,171 @@
+/*
+Package daemonstats computes the runtime statistics of the local daemon:
+goroutines by subsystem, memory, pubsub queues, heartbeat traffic, api
+requests and the resource usage of the running object instances.
+*/
+package daemonstats
+
+import (
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/pg"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+const (
+	// apiRequestsMetric is the counter of the api requests maintained by
+	// the listener prometheus middleware.
+	apiRequestsMetric = "opensvc_api_requests_total"
+)
+
+// Local returns the runtime statistics of the local daemon.
+func Local(bus *pubsub.Bus) api.DaemonStatsNode {
+	localhost := hostname.Hostname()
+	subsystems, goroutines := subsystemStats()
+	return api.DaemonStatsNode{
+		Node:       localhost,
+		Time:       time.Now(),
+		Goroutines: goroutines,
+		Memory:     memoryStats(),
+		Subsystems: subsystems,
+		Pubsub:     pubsubStats(bus.Stats()),
+		Heartbeats: heartbeatStats(daemonsubsystem.DataHeartbeat.Get(localhost)),
+		Api:        apiStats(prometheus.DefaultGatherer),
+		Objects:    objectStats(localhost),
+	}
+}
+
+// memoryStats returns the go runtime memory statistics.
+func memoryStats() api.DaemonStatsMemory {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return api.DaemonStatsMemory{
+		Alloc:     m.Alloc,
+		HeapInuse: m.HeapInuse,
+		Sys:       m.Sys,
+		Gc:        m.NumGC,
+	}
+}
+
+// pubsubStats returns the usage of the bus subscription queues.
+func pubsubStats(stats pubsub.Stats) api.DaemonStatsPubsub {
+	l := make([]api.DaemonStatsSubscription, len(stats.Subscriptions))
+	for i, s := range stats.Subscriptions {
+		l[i] = api.DaemonStatsSubscription{
+			Name:      s.Name,
+			Family:    s.Family,
+			Queued:    s.Queued,
+			QueuedMax: s.QueuedMax,
+			QueueSize: s.QueueSize,
+			Dropped:   s.Dropped,
+		}
+	}
+	return api.DaemonStatsPubsub{
+		Dropped:       stats.Dropped,
+		Subscriptions: l,
+	}
+}
+
+// heartbeatStats returns the bytes received and sent by the heartbeats,
+// merging the rx and tx streams of a heartbeat.
+func heartbeatStats(hb *daemonsubsystem.Heartbeat) []api.DaemonStatsHeartbeat {
+	l := make([]api.DaemonStatsHeartbeat, 0)
+	if hb == nil {
+		return l
+	}
+	m := make(map[string]*api.DaemonStatsHeartbeat)
+	for _, stream := range hb.Streams {
+		name, direction, _ := strings.Cut(stream.ID, ".")
+		v, ok := m[name]
+		if !ok {
+			v = &api.DaemonStatsHeartbeat{Name: name, Type: stream.Type}
+			m[name] = v
+		}
+		switch direction {
+		case "rx":
+			v.RxBytes += stream.Bytes
+		case "tx":
+			v.TxBytes += stream.Bytes
+		}
+	}
+	for _, v := range m {
+		l = append(l, *v)
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].Name < l[j].Name })
+	return l
+}
+
+// apiStats returns the api request counts by method and status code, from
+// the counter of the listener prometheus middleware.
+func apiStats(gatherer prometheus.Gatherer) []api.DaemonStatsAPI {
+	l := make([]api.DaemonStatsAPI, 0)
+	families, err := gatherer.Gather()
+	if err != nil {
+		return l
+	}
+	type key struct {
+		method string
+		code   string
+	}
+	m := make(map[key]uint64)
+	for _, family := range families {
+		if family.GetName() != apiRequestsMetric {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			var k key
+			for _, label := range metric.GetLabel() {
+				switch label.GetName() {
+				case "method":
+					k.method = label.GetValue()
+				case "code":
+					k.code = label.GetValue()
+				}
+			}
+			m[k] += uint64(metric.GetCounter().GetValue())
+		}
+	}
+	for k, count := range m {
+		l = append(l, api.DaemonStatsAPI{Method: k.method, Code: k.code, Count: count})
+	}
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].Method != l[j].Method {
+			return l[i].Method < l[j].Method
+		}
+		return l[i].Code < l[j].Code
+	})
+	return l
+}
+
+// objectStats returns the cpu and memory usage of the pg of the objects with
+// a running local instance. The objects without pg are not reported.
+func objectStats(localhost string) []api.DaemonStatsObject {
+	l := make([]api.DaemonStatsObject, 0)
+	for p, v := range instance.StatusData.GetByNode(localhost) {
+		if !v.Avail.Is(status.Up, status.Warn) {
+			continue
+		}
+		usage, err := pg.Config{ID: object.PGID(p)}.Usage()
+		if err != nil {
+			continue
+		}
+		l = append(l, api.DaemonStatsObject{
+			Path:    p.String(),
+			CpuTime: usage.CPUTime.String(),
+			Mem:     usage.Memory,
+		})
+	}
+	sort.Slice(l, func(i, j int) bool { return l[i].Path < l[j].Path })
+	return l
+}
@@ -0,0 +1,89 @@
+package daemonstats
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+)
+
+const stacks = `goroutine 1 [running]:
+main.main()
+	/src/main.go:10 +0x1
+
+goroutine 20 [select]:
+github.com/opensvc/om3/util/pubsub.(*Bus).Start.func1()
+	/src/util/pubsub/main.go:440 +0x1
+created by github.com/opensvc/om3/daemon/imon.(*Manager).startSubscriptions in goroutine 12
+	/src/daemon/imon/main.go:300 +0x1
+
+goroutine 21 [select]:
+github.com/opensvc/om3/daemon/imon.(*Manager).worker(0xc000010000)
+	/src/daemon/imon/main.go:400 +0x1
+created by github.com/opensvc/om3/daemon/imon.Start in goroutine 1
+	/src/daemon/imon/main.go:200 +0x1
+
+goroutine 30 [IO wait]:
+github.com/opensvc/om3/daemon/daemonapi.(*DaemonAPI).GetJob(0xc000010000)
+	/src/daemon/daemonapi/get_job.go:30 +0x1
+github.com/opensvc/om3/daemon/listener/routehttp.(*T).ServeHTTP(0xc000010000)
+	/src/daemon/listener/routehttp/main.go:60 +0x1
+net/http.(*conn).serve(0xc000010000)
+	/usr/lib/go/src/net/http/server.go:2000 +0x1
+created by net/http.(*Server).Serve in goroutine 40
+	/usr/lib/go/src/net/http/server.go:3000 +0x1
+
+goroutine 31 [chan receive]:
+github.com/opensvc/om3/core/omcmd.Run.func1()
+	/src/core/omcmd/run.go:10 +0x1
+created by github.com/opensvc/om3/core/omcmd.Run in goroutine 1
+	/src/core/omcmd/run.go:8 +0x1
+`
+
+func TestCountGoroutines(t *testing.T) {
+	l, total := countGoroutines(stacks)
+	require.Equal(t, 5, total)
+	require.Equal(t, []api.DaemonStatsSubsystem{
+		{Name: "core/omcmd", Goroutines: 1},
+		{Name: "daemon/imon", Goroutines: 2},
+		{Name: "daemon/listener/routehttp", Goroutines: 1},
+		{Name: otherSubsystem, Goroutines: 1},
+	}, l)
+}
+
+func TestHeartbeatStats(t *testing.T) {
+	require.Empty(t, heartbeatStats(nil))
+	l := heartbeatStats(&daemonsubsystem.Heartbeat{
+		Streams: []daemonsubsystem.HeartbeatStream{
+			{Status: daemonsubsystem.Status{ID: "hb#2.tx"}, Type: "disk", Bytes: 30},
+			{Status: daemonsubsystem.Status{ID: "hb#1.rx"}, Type: "unicast", Bytes: 10},
+			{Status: daemonsubsystem.Status{ID: "hb#1.tx"}, Type: "unicast", Bytes: 20},
+		},
+	})
+	require.Equal(t, []api.DaemonStatsHeartbeat{
+		{Name: "hb#1", Type: "unicast", RxBytes: 10, TxBytes: 20},
+		{Name: "hb#2", Type: "disk", TxBytes: 30},
+	}, l)
+}
+
+func TestAPIStats(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	requests := prometheus.NewCounterVec(
+		prometheus.CounterOpts{Name: apiRequestsMetric},
+		[]string{"code", "method", "host", "url"})
+	registry.MustRegister(requests)
+	requests.WithLabelValues("200", http.MethodGet, "n1", "/node").Add(2)
+	requests.WithLabelValues("200", http.MethodGet, "n1", "/object").Add(3)
+	requests.WithLabelValues("404", http.MethodGet, "n1", "/job/:id").Inc()
+	requests.WithLabelValues("200", http.MethodPost, "n1", "/auth/token").Inc()
+
+	require.Equal(t, []api.DaemonStatsAPI{
+		{Method: http.MethodGet, Code: "200", Count: 5},
+		{Method: http.MethodGet, Code: "404", Count: 1},
+		{Method: http.MethodPost, Code: "200", Count: 1},
+	}, apiStats(registry))
+}
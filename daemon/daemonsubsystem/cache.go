@@ -6,7 +6,7 @@ import (
 
 type (
 	Cacher interface {
-		Collector | Dns | Daemondata | Heartbeat | Listener | RunnerImon | Scheduler
+		Collector | Dns | Daemondata | Heartbeat | Listener | Notify | RunnerImon | Scheduler
 	}
 
 	CacheElement[T Cacher] struct {
@@ -37,6 +37,9 @@ var (
 	// DataListener is the package data holder for all nodes Listener
 	DataListener *CacheData[Listener]
 
+	// DataNotify is the package data holder for all nodes Notify
+	DataNotify *CacheData[Notify]
+
 	// DataListener is the package data holder for all nodes RunnerImon
 	DataRunnerImon *CacheData[RunnerImon]
 
@@ -56,6 +59,7 @@ func DropNode(nodename string) {
 	DataDaemondata.Unset(nodename)
 	DataHeartbeat.Unset(nodename)
 	DataListener.Unset(nodename)
+	DataNotify.Unset(nodename)
 	DataRunnerImon.Unset(nodename)
 	DataScheduler.Unset(nodename)
 }
@@ -103,6 +107,7 @@ func InitData() {
 	DataDaemondata = NewData[Daemondata]()
 	DataHeartbeat = NewData[Heartbeat]()
 	DataListener = NewData[Listener]()
+	DataNotify = NewData[Notify]()
 	DataRunnerImon = NewData[RunnerImon]()
 	DataScheduler = NewData[Scheduler]()
 }
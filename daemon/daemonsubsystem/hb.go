@@ -19,6 +19,10 @@ type (
 		// Streams list of daemon heartbeat streams
 		Streams []HeartbeatStream `json:"streams"`
 
+		// SecretRotation is the localhost progress of the cluster secret
+		// rotation.
+		SecretRotation HeartbeatSecretRotation `json:"secret_rotation"`
+
 		UpdatedAt time.Time `json:"updated_at"`
 	}
 
@@ -44,28 +48,116 @@ type (
 		// Type of heartbeat stream (unicast, multicast, ...)
 		Type string `json:"type"`
 
+		// Bytes is the count of message bytes sent by a sending stream, or
+		// received by a receiving stream.
+		Bytes uint64 `json:"bytes"`
+
 		// Peers map of peer names to daemon hb stream peer status
 		Peers map[string]HeartbeatStreamPeerStatus `json:"peers"`
 
+		// Faults is the list of the fault injections active on the stream.
+		Faults []HeartbeatFault `json:"faults,omitempty"`
+
 		Alerts []Alert `json:"alerts"`
 	}
 
+	// HeartbeatFault describes a fault injected in a heartbeat stream, to
+	// simulate a network failure.
+	HeartbeatFault struct {
+		// ID is the heartbeat stream id (example: hb#1.rx).
+		ID string `json:"id"`
+
+		// Peer is the peer node the fault applies to. The fault applies to
+		// all peers if empty.
+		Peer string `json:"peer"`
+
+		// Action is the fault action:
+		//   - "pause": the messages are held, and the last one is delivered
+		//     when the fault expires
+		//   - "drop": the messages are discarded
+		//   - "delay": the messages are delivered after Delay
+		Action string `json:"action"`
+
+		// Delay is the delay of the messages for the "delay" action.
+		Delay time.Duration `json:"delay,omitempty"`
+
+		CreatedAt time.Time `json:"created_at"`
+
+		// ExpireAt is the time the fault is cleared.
+		ExpireAt time.Time `json:"expire_at"`
+	}
+
+	// HeartbeatSecretRotation describes the progress of a cluster secret
+	// rotation on a node.
+	HeartbeatSecretRotation struct {
+		// State is the rotation state of the node:
+		//   - "": no rotation in progress
+		//   - "accepting": the next secret is accepted to decrypt, but not
+		//     yet used to encrypt
+		//   - "switched": the next secret is used to encrypt, the current
+		//     secret is still accepted to decrypt
+		State string `json:"state"`
+
+		// Key is the fingerprint of the next secret.
+		Key string `json:"key"`
+
+		// UpdatedAt is the time of the last State change.
+		UpdatedAt time.Time `json:"updated_at"`
+	}
+
 	// HeartbeatStreamPeerStatus status of the communication with a specific peer node.
 	HeartbeatStreamPeerStatus struct {
 		IsBeating bool      `json:"is_beating"`
 		LastAt    time.Time `json:"last_at"`
+
+		// Delay is the delay estimate of the last beat: the send duration
+		// for a sending stream, the age of the message when read for a
+		// receiving stream. It is zero when the stream can't estimate it.
+		Delay time.Duration `json:"delay"`
+
+		// AvgDelay is the moving average of the beats delay estimates.
+		AvgDelay time.Duration `json:"avg_delay"`
+
+		// MissedBeats is the count of beats expected but not sent or received.
+		MissedBeats uint64 `json:"missed_beats"`
+
+		// BeatingCount is the count of transitions from stale to beating.
+		BeatingCount uint64 `json:"beating_count"`
+
+		// StaleCount is the count of transitions from beating to stale.
+		StaleCount uint64 `json:"stale_count"`
+
+		// ErrorCount is the count of errors sending to or receiving from
+		// the peer.
+		ErrorCount uint64 `json:"error_count"`
+
+		// LastError is the last error sending to or receiving from the peer.
+		LastError string `json:"last_error,omitempty"`
+
+		// LastErrorAt is the time of the last error.
+		LastErrorAt time.Time `json:"last_error_at,omitempty"`
 	}
 )
 
+const (
+	SecretRotationAccepting = "accepting"
+	SecretRotationSwitched  = "switched"
+
+	HeartbeatFaultPause = "pause"
+	HeartbeatFaultDrop  = "drop"
+	HeartbeatFaultDelay = "delay"
+)
+
 func (c *Heartbeat) DeepCopy() *Heartbeat {
 	streams := make([]HeartbeatStream, 0, len(c.Streams))
 	for _, stream := range c.Streams {
 		streams = append(streams, *stream.DeepCopy())
 	}
 	return &Heartbeat{
-		LastMessages: append([]HeartbeatLastMessage{}, c.LastMessages...),
-		LastMessage:  c.LastMessage,
-		Streams:      append([]HeartbeatStream{}, streams...),
+		LastMessages:   append([]HeartbeatLastMessage{}, c.LastMessages...),
+		LastMessage:    c.LastMessage,
+		Streams:        append([]HeartbeatStream{}, streams...),
+		SecretRotation: c.SecretRotation,
 	}
 }
 
@@ -77,7 +169,9 @@ func (c *HeartbeatStream) DeepCopy() *HeartbeatStream {
 	return &HeartbeatStream{
 		Status: c.Status,
 		Type:   c.Type,
+		Bytes:  c.Bytes,
 		Peers:  peers,
+		Faults: append([]HeartbeatFault{}, c.Faults...),
 		Alerts: append([]Alert{}, c.Alerts...),
 	}
 }
@@ -38,6 +38,10 @@ type (
 
 		Nodename string `json:"nodename"`
 
+		// Notify describes the OpenSVC daemon notify subsystem state,
+		// which is responsible for posting the events to the webhooks.
+		Notify Notify `json:"notify"`
+
 		// Pid the main daemon process id
 		// it is sent on the full hb message, then not anymore changed
 		Pid int `json:"pid"`
@@ -81,6 +85,7 @@ func (d *Daemon) DeepCopy() *Daemon {
 		Dns:        *d.Dns.DeepCopy(),
 		Heartbeat:  *d.Heartbeat.DeepCopy(),
 		Listener:   *d.Listener.DeepCopy(),
+		Notify:     *d.Notify.DeepCopy(),
 		RunnerImon: *d.RunnerImon.DeepCopy(),
 		Scheduler:  *d.Scheduler.DeepCopy(),
 	}
@@ -0,0 +1,56 @@
+package daemonsubsystem
+
+import (
+	"time"
+)
+
+type (
+	// Notify defines model for the daemon notify subsystem.
+	Notify struct {
+		Status
+
+		// Endpoints is the delivery status of the notify#<name> sections,
+		// indexed by name.
+		Endpoints map[string]NotifyEndpoint `json:"endpoints"`
+	}
+
+	// NotifyEndpoint describes the delivery status of a webhook.
+	NotifyEndpoint struct {
+		// Host is the webhook url scheme and host. The url path and
+		// query are not exposed, as they may embed credentials.
+		Host string `json:"host"`
+
+		// State is "idle" before the first post, "ok" after a delivered
+		// post and "failing" after a post failed after all the retries.
+		State string `json:"state"`
+
+		// Queued is the number of events waiting for delivery.
+		Queued int `json:"queued"`
+
+		// Sent is the number of delivered events.
+		Sent uint64 `json:"sent"`
+
+		// Failed is the number of events not delivered after all the
+		// retries.
+		Failed uint64 `json:"failed"`
+
+		// Dropped is the number of events dropped because the delivery
+		// queue was full.
+		Dropped uint64 `json:"dropped"`
+
+		LastSentAt   time.Time `json:"last_sent_at"`
+		LastFailedAt time.Time `json:"last_failed_at"`
+
+		// LastError is the error of the last failed post.
+		LastError string `json:"last_error,omitempty"`
+	}
+)
+
+func (c *Notify) DeepCopy() *Notify {
+	n := *c
+	n.Endpoints = make(map[string]NotifyEndpoint, len(c.Endpoints))
+	for name, v := range c.Endpoints {
+		n.Endpoints[name] = v
+	}
+	return &n
+}
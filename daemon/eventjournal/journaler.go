@@ -0,0 +1,65 @@
+package eventjournal
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	// Journaler subscribes to all the daemon bus messages and appends them
+	// to the daemon journal.
+	Journaler struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		log    *plog.Logger
+		wg     sync.WaitGroup
+
+		subQS pubsub.QueueSizer
+	}
+)
+
+func NewJournaler(subQS pubsub.QueueSizer) *Journaler {
+	return &Journaler{
+		log: plog.NewDefaultLogger().
+			Attr("pkg", "daemon/eventjournal").
+			WithPrefix("daemon: eventjournal: "),
+		subQS: subQS,
+	}
+}
+
+func (t *Journaler) Start(parent context.Context) error {
+	t.ctx, t.cancel = context.WithCancel(parent)
+	sub := pubsub.BusFromContext(t.ctx).Sub("daemon.eventjournal", t.subQS)
+	sub.AddFilter(nil)
+	sub.Start()
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		defer func() {
+			if err := sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Errorf("subscription stop error %s", err)
+			}
+		}()
+		for {
+			select {
+			case <-t.ctx.Done():
+				return
+			case i := <-sub.C:
+				if msg, ok := i.(pubsub.Messager); ok {
+					Journal.Append(msg)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (t *Journaler) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
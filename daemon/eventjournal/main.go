@@ -0,0 +1,128 @@
+/*
+Package eventjournal keeps a bounded journal of the messages published on the
+daemon bus, with increasing ids.
+
+The event stream handlers read the journal instead of a live subscription,
+so a client reconnecting with the id of the last event it received gets the
+events published in between. The oldest entries are evicted when the journal
+is full.
+
+The ids of a journal start after its creation time in microseconds, so the
+ids received from a previous daemon run are seen as evicted.
+*/
+package eventjournal
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	// Entry is a journaled message, with its journal id.
+	Entry struct {
+		ID  uint64
+		Msg pubsub.Messager
+	}
+
+	// T is a ring journal of messages.
+	T struct {
+		mu sync.RWMutex
+
+		// entries is the ring buffer, the oldest entry is at index start.
+		entries []Entry
+		start   int
+		count   int
+
+		// lastID is the id of the last appended entry.
+		lastID uint64
+
+		// notifyC is closed and replaced on each append, to wake up the
+		// readers waiting for new entries.
+		notifyC chan struct{}
+	}
+)
+
+var (
+	// DefaultSize is the number of entries kept by the daemon journal.
+	DefaultSize = 10000
+
+	// Journal is the daemon event journal. It is fed by the Journaler.
+	Journal = New(DefaultSize)
+
+	// ErrEvicted is returned when the entries following the requested id
+	// are no longer in the journal.
+	ErrEvicted = errors.New("the events following this id are evicted from the journal")
+
+	// ErrUnknownID is returned when the requested id is greater than the
+	// last journaled id, like an id received from another node.
+	ErrUnknownID = errors.New("unknown event id")
+)
+
+// New returns a journal keeping the last <size> entries.
+func New(size int) *T {
+	return &T{
+		entries: make([]Entry, size),
+		lastID:  uint64(time.Now().UnixMicro()),
+		notifyC: make(chan struct{}),
+	}
+}
+
+// Append journals the message <msg> and returns its id.
+func (t *T) Append(msg pubsub.Messager) uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lastID++
+	size := len(t.entries)
+	entry := Entry{ID: t.lastID, Msg: msg}
+	if t.count < size {
+		t.entries[(t.start+t.count)%size] = entry
+		t.count++
+	} else {
+		t.entries[t.start] = entry
+		t.start = (t.start + 1) % size
+	}
+	close(t.notifyC)
+	t.notifyC = make(chan struct{})
+	return t.lastID
+}
+
+// LastID returns the id of the last journaled message, or the id preceding
+// the first id if the journal is empty.
+func (t *T) LastID() uint64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.lastID
+}
+
+// Notify returns a channel closed on the next Append. A reader gets this
+// channel before calling After, so no append is missed.
+func (t *T) Notify() <-chan struct{} {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.notifyC
+}
+
+// After returns at most <max> entries following the id <id>, the oldest
+// first. It returns ErrEvicted if some of the entries following <id> are
+// evicted, and ErrUnknownID if <id> is greater than the last id.
+func (t *T) After(id uint64, max int) ([]Entry, error) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if id > t.lastID {
+		return nil, ErrUnknownID
+	}
+	firstID := t.lastID - uint64(t.count) + 1
+	if id+1 < firstID {
+		return nil, ErrEvicted
+	}
+	n := min(int(t.lastID-id), max)
+	l := make([]Entry, n)
+	offset := int(id + 1 - firstID)
+	for i := 0; i < n; i++ {
+		l[i] = t.entries[(t.start+offset+i)%len(t.entries)]
+	}
+	return l, nil
+}
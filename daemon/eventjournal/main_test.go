@@ -0,0 +1,70 @@
+package eventjournal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+func TestJournal(t *testing.T) {
+	j := New(3)
+	start := j.LastID()
+
+	entries, err := j.After(start, 10)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	_, err = j.After(start+1, 10)
+	require.ErrorIs(t, err, ErrUnknownID)
+
+	notifyC := j.Notify()
+	for i := 1; i <= 5; i++ {
+		require.Equal(t, start+uint64(i), j.Append(&pubsub.Msg{}))
+	}
+	select {
+	case <-notifyC:
+	default:
+		t.Fatal("append is not notified")
+	}
+
+	t.Run("the evicted entries are reported", func(t *testing.T) {
+		_, err := j.After(start, 10)
+		require.ErrorIs(t, err, ErrEvicted)
+		_, err = j.After(start+1, 10)
+		require.ErrorIs(t, err, ErrEvicted)
+	})
+
+	t.Run("the entries following the oldest entry predecessor are kept", func(t *testing.T) {
+		entries, err := j.After(start+2, 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		for i, entry := range entries {
+			require.Equal(t, start+uint64(i)+3, entry.ID)
+		}
+	})
+
+	t.Run("the entries are limited to max", func(t *testing.T) {
+		entries, err := j.After(start+3, 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, start+4, entries[0].ID)
+	})
+
+	t.Run("no entry follows the last id", func(t *testing.T) {
+		entries, err := j.After(j.LastID(), 10)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+}
+
+func TestJournalIDsAreNotReusedAfterRestart(t *testing.T) {
+	previous := New(10)
+	previous.Append(&pubsub.Msg{})
+	time.Sleep(time.Millisecond)
+	j := New(10)
+	_, err := j.After(previous.LastID(), 10)
+	require.ErrorIs(t, err, ErrEvicted)
+}
@@ -0,0 +1,71 @@
+package hb
+
+import (
+	"context"
+
+	"github.com/opensvc/om3/daemon/hb/hbctrl"
+	"github.com/opensvc/om3/daemon/hb/hbfault"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+// startFaultInterposer returns the context of the fault interposer of the
+// hb driver <id>. A previous interposer of <id> is stopped.
+func (t *T) startFaultInterposer(id string) context.Context {
+	t.stopFaultInterposer(id)
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.faultCancel[id] = cancel
+	return ctx
+}
+
+func (t *T) stopFaultInterposer(id string) {
+	if cancel, ok := t.faultCancel[id]; ok {
+		cancel()
+		delete(t.faultCancel, id)
+	}
+}
+
+// onHbFaultCtl injects or clears the heartbeat fault requested by <c>.
+func (t *T) onHbFaultCtl(c *msgbus.HbFaultCtl) {
+	f := c.Value
+	switch c.Action {
+	case "inject":
+		if err := t.faults.Inject(f); err != nil {
+			t.log.Warnf("fault injection on %s: %s", f.ID, err)
+			return
+		}
+		if _, ok := t.faultCancel[f.ID]; !ok {
+			t.log.Warnf("fault injection on %s: not running, the fault will apply if started before %s", f.ID, f.ExpireAt)
+		}
+		t.log.Infof("fault injected on %s peer '%s': %s %s until %s", f.ID, f.Peer, f.Action, f.Delay, f.ExpireAt)
+		t.bus.Pub(&msgbus.HbFaultInjected{Node: hostname.Hostname(), Value: f},
+			pubsub.Label{"node", hostname.Hostname()}, pubsub.Label{"id", f.ID})
+		t.setFaults(f.ID)
+	case "clear":
+		if l := t.faults.Clear(f.ID, f.Peer); len(l) == 0 {
+			t.log.Infof("fault clear on %s peer '%s': no fault", f.ID, f.Peer)
+		}
+	default:
+		t.log.Warnf("fault ctl on %s: unexpected action '%s'", f.ID, c.Action)
+	}
+}
+
+// onFaultCleared is called by the fault registry when a fault expires or is
+// cleared.
+func (t *T) onFaultCleared(f hbfault.Fault, reason string) {
+	t.log.Infof("fault %s on %s peer '%s': %s", reason, f.ID, f.Peer, f.Action)
+	t.bus.Pub(&msgbus.HbFaultCleared{Node: hostname.Hostname(), Reason: reason, Value: f},
+		pubsub.Label{"node", hostname.Hostname()}, pubsub.Label{"id", f.ID})
+	t.setFaults(f.ID)
+}
+
+// setFaults updates the hb controller list of the faults active on the hb
+// driver <id>.
+func (t *T) setFaults(id string) {
+	select {
+	case <-t.ctx.Done():
+		// don't hang up when context is done
+	case t.ctrlC <- hbctrl.CmdSetFaults{HbID: id, Faults: t.faults.List(id)}:
+	}
+}
@@ -56,8 +56,8 @@ type (
 		rxCount     int // rx peer watcher count for a remote
 		txBeating   int
 		rxBeating   int
-		cancel      map[string]func()      // cancel function of hbID peer watcher for the remote
-		beatingChan map[string]chan<- bool // beating bool chan of hbID for the remote
+		cancel      map[string]func()          // cancel function of hbID peer watcher for the remote
+		beatingChan map[string]chan<- peerBeat // beating chan of hbID for the remote
 	}
 
 	// CmdRegister is the command to register a new heartbeat status
@@ -98,6 +98,24 @@ type (
 		Nodename string
 		HbID     string
 		Success  bool
+
+		// Delay is the delay estimate of the beat, zero if unknown.
+		Delay time.Duration
+	}
+
+	// CmdAddBytes is a command to account the bytes of a message sent by a
+	// hb tx or received by a hb rx.
+	CmdAddBytes struct {
+		HbID  string
+		Count int
+	}
+
+	// CmdSetPeerError is a command to account a hb error sending to or
+	// receiving from a node. It does not change the peer beating state.
+	CmdSetPeerError struct {
+		Nodename string
+		HbID     string
+		Err      error
 	}
 
 	// CmdSetPeerStatus is a command to set a hb peer HeartbeatPeerStatus for a node
@@ -107,12 +125,22 @@ type (
 		PeerStatus daemonsubsystem.HeartbeatStreamPeerStatus
 	}
 
+	// CmdSetFaults is a command to set the fault injections active on a hb
+	CmdSetFaults struct {
+		HbID   string
+		Faults []daemonsubsystem.HeartbeatFault
+	}
+
 	// CmdAddWatcher is a command to run new instance of a hb watcher for a remote
 	CmdAddWatcher struct {
 		HbID     string
 		Nodename string
 		Ctx      context.Context
 		Timeout  time.Duration
+
+		// Interval is the expected interval between beats, used to count
+		// the missed beats. Zero disables the missed beats accounting.
+		Interval time.Duration
 	}
 
 	// CmdDelWatcher is a command to stop one instance of a hb watcher for a remote
@@ -127,6 +155,13 @@ type (
 		result chan<- map[string]daemonsubsystem.HeartbeatStreamPeerStatus
 	}
 
+	// peerBeat is a beat or an error sent to a peer watcher
+	peerBeat struct {
+		success bool
+		delay   time.Duration
+		err     error
+	}
+
 	// C struct holds the hb controller data
 	C struct {
 		cmd    chan any
@@ -212,7 +247,9 @@ func (c *C) run() {
 				heartbeats = append(heartbeats, daemonsubsystem.HeartbeatStream{
 					Status: heartbeat[key].Status,
 					Type:   heartbeat[key].Type,
+					Bytes:  heartbeat[key].Bytes,
 					Peers:  peers,
+					Faults: append([]daemonsubsystem.HeartbeatFault{}, heartbeat[key].Faults...),
 				})
 			}
 			hbcache.SetHeartbeats(heartbeats)
@@ -257,7 +294,20 @@ func (c *C) run() {
 					k := o.HbID
 					if beatC, found := remote.beatingChan[k]; found {
 						go func() {
-							beatC <- o.Success
+							beatC <- peerBeat{success: o.Success, delay: o.Delay}
+						}()
+					}
+				}
+			case CmdAddBytes:
+				if foundHeartbeat, ok := heartbeat[o.HbID]; ok {
+					foundHeartbeat.Bytes += uint64(o.Count)
+					heartbeat[o.HbID] = foundHeartbeat
+				}
+			case CmdSetPeerError:
+				if remote, ok := remotes[o.Nodename]; ok {
+					if beatC, found := remote.beatingChan[o.HbID]; found {
+						go func() {
+							beatC <- peerBeat{err: o.Err}
 						}()
 					}
 				}
@@ -312,12 +362,17 @@ func (c *C) run() {
 					foundHeartbeat.Peers[peerNode] = o.PeerStatus
 					heartbeat[hbID] = foundHeartbeat
 				}
+			case CmdSetFaults:
+				if foundHeartbeat, ok := heartbeat[o.HbID]; ok {
+					foundHeartbeat.Faults = o.Faults
+					heartbeat[o.HbID] = foundHeartbeat
+				}
 			case CmdAddWatcher:
 				hbID := o.HbID
 				peerNode := o.Nodename
 				remote, ok := remotes[peerNode]
 				if !ok {
-					remote.beatingChan = make(map[string]chan<- bool)
+					remote.beatingChan = make(map[string]chan<- peerBeat)
 					remote.cancel = make(map[string]func())
 				}
 				if _, registered := remote.cancel[hbID]; registered {
@@ -331,7 +386,7 @@ func (c *C) run() {
 					continue
 				}
 				c.log.Infof("watcher starting %s -> %s", hbID, peerNode)
-				beatingC := make(chan bool)
+				beatingC := make(chan peerBeat)
 				beatingCtx, cancel := context.WithCancel(o.Ctx)
 				remote.cancel[hbID] = cancel
 				remote.beatingChan[hbID] = beatingC
@@ -341,7 +396,7 @@ func (c *C) run() {
 					remote.txCount++
 				}
 				remotes[peerNode] = remote
-				c.peerWatch(beatingCtx, beatingC, o.HbID, peerNode, o.Timeout)
+				c.peerWatch(beatingCtx, beatingC, o.HbID, peerNode, o.Timeout, o.Interval)
 			case CmdDelWatcher:
 				hbID := o.HbID
 				peerNode := o.Nodename
@@ -0,0 +1,55 @@
+package hbctrl
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	peerLabelNames = []string{"hb_id", "peer"}
+
+	peerBeatingGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "opensvc_hb_peer_beating",
+			Help: "1 if the hb peer is beating, else 0",
+		},
+		peerLabelNames)
+
+	peerDelayGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "opensvc_hb_peer_delay_seconds",
+			Help: "The moving average of the hb peer beats delay estimates",
+		},
+		peerLabelNames)
+
+	peerMissedBeatsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "opensvc_hb_peer_missed_beats_total",
+			Help: "The total number of hb peer beats expected but not sent or received",
+		},
+		peerLabelNames)
+
+	peerTransitionsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "opensvc_hb_peer_transitions_total",
+			Help: "The total number of hb peer transitions to the beating or stale state",
+		},
+		append([]string{"state"}, peerLabelNames...))
+
+	peerErrorsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "opensvc_hb_peer_errors_total",
+			Help: "The total number of errors sending to or receiving from the hb peer",
+		},
+		peerLabelNames)
+)
+
+// deletePeerMetrics removes the metrics of a stopped hb peer watcher.
+func deletePeerMetrics(hbID, nodename string) {
+	peerBeatingGauge.DeleteLabelValues(hbID, nodename)
+	peerDelayGauge.DeleteLabelValues(hbID, nodename)
+	peerMissedBeatsTotal.DeleteLabelValues(hbID, nodename)
+	peerTransitionsTotal.DeleteLabelValues("beating", hbID, nodename)
+	peerTransitionsTotal.DeleteLabelValues("stale", hbID, nodename)
+	peerErrorsTotal.DeleteLabelValues(hbID, nodename)
+}
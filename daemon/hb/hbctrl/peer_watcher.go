@@ -19,18 +19,39 @@ var (
 	// t2: evBeating => beating true
 	// if t1 + pubDelay > t2: read current beating (true) => no event
 	pubDelay = 200 * time.Millisecond
+
+	// statsDelay is the minimum interval between two publications of the
+	// peer statistics changes.
+	statsDelay = time.Second
+
+	// avgDelayWeight is the weight of the moving average of the delays:
+	// a new delay estimate accounts for 1/avgDelayWeight of the average.
+	avgDelayWeight time.Duration = 5
 )
 
 // peerWatch starts a new peer watcher of nodename for hbID
 // when beating state change a hb_beating or hb_stale event is fired
 // Once beating, a hb_stale event is fired if no beating are received after timeout
-func (c *C) peerWatch(ctx context.Context, beatingC chan bool, HbID, nodename string, timeout time.Duration) {
+//
+// The watcher also maintains the peer statistics: delays, missed beats,
+// transitions and errors. The missed beats are counted from the gaps between
+// beats longer than interval, if interval is not zero.
+func (c *C) peerWatch(ctx context.Context, beatingC chan peerBeat, HbID, nodename string, timeout, interval time.Duration) {
 	peer := daemonsubsystem.HeartbeatStreamPeerStatus{}
 	started := make(chan bool)
 	go func() {
+		defer deletePeerMetrics(HbID, nodename)
+
 		// changes tracks beating value changes
 		var changes bool
 
+		// statsChanges tracks the statistics changes not yet published
+		var statsChanges bool
+
+		// lastBeatAt is the time of the last successful beat, used to
+		// count the missed beats
+		var lastBeatAt time.Time
+
 		// beatingOnLastPub is the latest peer.Beating value changed
 		var beatingOnLastPub bool
 
@@ -46,13 +67,54 @@ func (c *C) peerWatch(ctx context.Context, beatingC chan bool, HbID, nodename st
 		staleTicker.Stop()
 		defer staleTicker.Stop()
 		log := plog.NewDefaultLogger().Attr("pkg", "daemon/hbctrl:peerWatch").Attr("hb_peer_watch", HbID+"-"+nodename).WithPrefix("daemon: hbctrl: peer watcher: " + HbID + "-" + nodename + ": ")
+		// statsTicker is the interval ticker to publish the statistics
+		// changes
+		statsTicker := time.NewTicker(statsDelay)
+		defer statsTicker.Stop()
+
 		log.Infof("started")
 		started <- true
+		peerBeatingGauge.WithLabelValues(HbID, nodename).Set(0)
 		setBeating := func(v bool) {
 			peer.IsBeating = v
 			changes = true
 			pubTicker.Reset(pubDelay)
 		}
+		setPeerStatus := func() {
+			statsChanges = false
+			select {
+			case c.cmd <- CmdSetPeerStatus{Nodename: nodename, HbID: HbID, PeerStatus: peer}:
+			case <-c.ctx.Done():
+			}
+		}
+		onError := func(err error) {
+			peer.ErrorCount++
+			peer.LastError = err.Error()
+			peer.LastErrorAt = time.Now()
+			peerErrorsTotal.WithLabelValues(HbID, nodename).Inc()
+			statsChanges = true
+		}
+		onBeat := func(delay time.Duration) {
+			now := time.Now()
+			if interval > 0 && !lastBeatAt.IsZero() {
+				if missed := int64(now.Sub(lastBeatAt)/interval) - 1; missed > 0 {
+					peer.MissedBeats += uint64(missed)
+					peerMissedBeatsTotal.WithLabelValues(HbID, nodename).Add(float64(missed))
+				}
+			}
+			lastBeatAt = now
+			peer.LastAt = now
+			if delay > 0 {
+				peer.Delay = delay
+				if peer.AvgDelay == 0 {
+					peer.AvgDelay = delay
+				} else {
+					peer.AvgDelay += (delay - peer.AvgDelay) / avgDelayWeight
+				}
+				peerDelayGauge.WithLabelValues(HbID, nodename).Set(peer.AvgDelay.Seconds())
+			}
+			statsChanges = true
+		}
 		for {
 			select {
 			case <-ctx.Done():
@@ -61,17 +123,22 @@ func (c *C) peerWatch(ctx context.Context, beatingC chan bool, HbID, nodename st
 			case <-c.ctx.Done():
 				log.Infof("done (from ctrl done)")
 				return
-			case beating := <-beatingC:
+			case beat := <-beatingC:
+				if beat.err != nil {
+					onError(beat.err)
+					continue
+				}
+				beating := beat.success
 				switch {
 				case beating && peer.IsBeating:
 					// continue beating (normal situation)
 					staleTicker.Reset(timeout)
-					peer.LastAt = time.Now()
+					onBeat(beat.delay)
 				case beating && !peer.IsBeating:
 					// resume beating
 					setBeating(true)
 					staleTicker.Reset(timeout)
-					peer.LastAt = time.Now()
+					onBeat(beat.delay)
 				case !beating && peer.IsBeating:
 					// stop beating
 					setBeating(false)
@@ -83,22 +150,29 @@ func (c *C) peerWatch(ctx context.Context, beatingC chan bool, HbID, nodename st
 					changes = false
 					if beatingOnLastPub != peer.IsBeating {
 						evName := evBeating
-						if !peer.IsBeating {
+						if peer.IsBeating {
+							peer.BeatingCount++
+							peerTransitionsTotal.WithLabelValues("beating", HbID, nodename).Inc()
+							peerBeatingGauge.WithLabelValues(HbID, nodename).Set(1)
+						} else {
 							evName = evStale
+							peer.StaleCount++
+							peerTransitionsTotal.WithLabelValues("stale", HbID, nodename).Inc()
+							peerBeatingGauge.WithLabelValues(HbID, nodename).Set(0)
 						}
 						c.cmd <- CmdEvent{
 							Name:     evName,
 							Nodename: nodename,
 							HbID:     HbID,
 						}
-						c.cmd <- CmdSetPeerStatus{
-							Nodename:   nodename,
-							HbID:       HbID,
-							PeerStatus: peer,
-						}
+						setPeerStatus()
 						beatingOnLastPub = peer.IsBeating
 					}
 				}
+			case <-statsTicker.C:
+				if statsChanges {
+					setPeerStatus()
+				}
 			case <-staleTicker.C:
 				if peer.IsBeating {
 					setBeating(false)
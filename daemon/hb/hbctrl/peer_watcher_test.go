@@ -0,0 +1,74 @@
+package hbctrl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+)
+
+func TestPeerWatchStats(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	savedPubDelay, savedStatsDelay := pubDelay, statsDelay
+	t.Cleanup(func() {
+		pubDelay, statsDelay = savedPubDelay, savedStatsDelay
+	})
+	pubDelay = 10 * time.Millisecond
+	statsDelay = 10 * time.Millisecond
+	interval := 20 * time.Millisecond
+
+	c := &C{cmd: make(chan any), ctx: ctx}
+	beatingC := make(chan peerBeat)
+	c.peerWatch(ctx, beatingC, "hb#1.tx", "node2", time.Second, interval)
+
+	// lastPeerStatus returns the last peer status sent by the watcher
+	// before the <d> duration elapsed.
+	lastPeerStatus := func(d time.Duration) (status daemonsubsystem.HeartbeatStreamPeerStatus) {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		for {
+			select {
+			case i := <-c.cmd:
+				if o, ok := i.(CmdSetPeerStatus); ok {
+					status = o.PeerStatus
+				}
+			case <-timer.C:
+				return
+			}
+		}
+	}
+
+	beatingC <- peerBeat{success: true, delay: 10 * time.Millisecond}
+	status := lastPeerStatus(50 * time.Millisecond)
+	require.True(t, status.IsBeating)
+	require.Equal(t, uint64(1), status.BeatingCount)
+	require.Equal(t, 10*time.Millisecond, status.Delay)
+	require.Equal(t, 10*time.Millisecond, status.AvgDelay)
+
+	t.Logf("skip 3 beats")
+	time.Sleep(3 * interval)
+	beatingC <- peerBeat{success: true, delay: 20 * time.Millisecond}
+	status = lastPeerStatus(50 * time.Millisecond)
+	require.GreaterOrEqual(t, status.MissedBeats, uint64(2))
+	require.Equal(t, 20*time.Millisecond, status.Delay)
+	require.Equal(t, 12*time.Millisecond, status.AvgDelay)
+
+	t.Logf("errors don't change the beating state")
+	beatingC <- peerBeat{err: errors.New("write failed")}
+	status = lastPeerStatus(50 * time.Millisecond)
+	require.True(t, status.IsBeating)
+	require.Equal(t, uint64(1), status.ErrorCount)
+	require.Equal(t, "write failed", status.LastError)
+	require.False(t, status.LastErrorAt.IsZero())
+
+	beatingC <- peerBeat{success: false}
+	status = lastPeerStatus(50 * time.Millisecond)
+	require.False(t, status.IsBeating)
+	require.Equal(t, uint64(1), status.StaleCount)
+}
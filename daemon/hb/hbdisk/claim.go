@@ -0,0 +1,116 @@
+package hbdisk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	blockdevice "github.com/opensvc/om3/util/device"
+	"github.com/opensvc/om3/util/scsi"
+)
+
+type (
+	// Claim is the disk arbitrator claim stored in the first logical block
+	// of the page at ClaimOffset.
+	Claim struct {
+		// Node is the name of the node holding the claim.
+		Node string `json:"node"`
+
+		// At is the claim write time.
+		At time.Time `json:"at"`
+
+		// block is the logical block the claim was read from, used as the
+		// compare data of the next claim write.
+		block []byte
+	}
+
+	// ClaimDevice gives access to the disk arbitrator claim of a device,
+	// possibly also used by hb disk drivers.
+	ClaimDevice struct {
+		device
+	}
+)
+
+var (
+	// ErrClaimChanged is returned by CompareAndWriteClaim when the claim
+	// stored on the device is no longer the claim read before.
+	ErrClaimChanged = errors.New("the claim changed since it was read")
+)
+
+// NewClaimDevice returns a ClaimDevice for the device path.
+func NewClaimDevice(path string) *ClaimDevice {
+	return &ClaimDevice{
+		device: device{path: path},
+	}
+}
+
+// Path returns the device path.
+func (t *ClaimDevice) Path() string {
+	return t.path
+}
+
+// ReadClaim returns the claim stored on the device. The returned claim is
+// zero if the claim block has never been written or is not decodable.
+func (t *ClaimDevice) ReadClaim() (Claim, error) {
+	var c Claim
+	blockSize, err := blockdevice.New(t.path).LogicalBlockSize()
+	if err != nil {
+		return c, fmt.Errorf("%s logical block size: %w", t.path, err)
+	} else if blockSize > PageSize {
+		return c, fmt.Errorf("%s logical block size %d is greater than the page size %d", t.path, blockSize, PageSize)
+	}
+	if err := t.open(); err != nil {
+		return c, err
+	}
+	defer func() { _ = t.close() }()
+	b, err := t.readPage(ClaimOffset)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return c, fmt.Errorf("%s read claim: the device is smaller than %d bytes", t.path, ClaimOffset+int64(PageSize))
+	} else if err != nil {
+		return c, fmt.Errorf("%s read claim: %w", t.path, err)
+	}
+	block := b[:blockSize]
+	b = block
+	if i := bytes.IndexRune(b, '\x00'); i >= 0 {
+		b = b[:i]
+	}
+	if len(b) > 0 {
+		_ = json.Unmarshal(b, &c)
+	}
+	c.block = block
+	return c, nil
+}
+
+// CompareAndWriteClaim stores the claim <c> on the device if the stored
+// claim still is the claim <old> returned by ReadClaim, and returns
+// ErrClaimChanged otherwise.
+//
+// The compare and write is atomic, using the SCSI COMPARE AND WRITE command
+// on the claim logical block: when nodes concurrently replace the same
+// claim, a single node succeeds, whatever the device latency.
+func (t *ClaimDevice) CompareAndWriteClaim(ctx context.Context, old, c Claim) error {
+	if len(old.block) == 0 {
+		return fmt.Errorf("%s write claim: the old claim is not read from the device", t.path)
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if len(b) >= len(old.block) {
+		return fmt.Errorf("attempt to write too long claim")
+	}
+	block := make([]byte, len(old.block))
+	copy(block, b)
+	dev := blockdevice.New(t.path)
+	err = scsi.CompareAndWrite(ctx, dev, ClaimOffset/int64(len(block)), old.block, block)
+	if errors.Is(err, scsi.ErrMiscompare) {
+		return ErrClaimChanged
+	} else if err != nil {
+		return fmt.Errorf("%s write claim: %w", t.path, err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package hbdisk
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"sync"
 	"time"
 
@@ -31,8 +32,6 @@ type (
 		cmdC   chan<- any
 		msgC   chan<- *hbtype.Msg
 		cancel func()
-
-		encryptDecrypter *omcrypto.Factory
 	}
 )
 
@@ -69,19 +68,13 @@ func (t *rx) Start(cmdC chan<- any, msgC chan<- *hbtype.Msg) error {
 	t.msgC = msgC
 	t.cancel = cancel
 
-	clusterConfig := cluster.ConfigData.Get()
-	t.encryptDecrypter = &omcrypto.Factory{
-		NodeName:    hostname.Hostname(),
-		ClusterName: clusterConfig.Name,
-		Key:         clusterConfig.Secret(),
-	}
-
 	for _, node := range t.nodes {
 		cmdC <- hbctrl.CmdAddWatcher{
 			HbID:     t.id,
 			Nodename: node,
 			Ctx:      ctx,
 			Timeout:  t.timeout,
+			Interval: t.interval,
 		}
 	}
 
@@ -120,6 +113,7 @@ func (t *rx) recv(nodename string) {
 	c, err := t.base.ReadDataSlot(meta.Slot) // TODO read timeout?
 	if err != nil {
 		t.log.Debugf("recv: reading node %s data slot %d: %s", nodename, meta.Slot, err)
+		t.setPeerError(nodename, fmt.Errorf("read data slot %d: %w", meta.Slot, err))
 		return
 	}
 	if c.Updated.IsZero() {
@@ -135,9 +129,10 @@ func (t *rx) recv(nodename string) {
 		t.log.Debugf("recv: node %s data slot %d has not been updated for %s", nodename, meta.Slot, elapsed)
 		return
 	}
-	b, msgNodename, err := t.encryptDecrypter.DecryptWithNode(c.Msg)
+	b, msgNodename, err := t.newEncryptDecrypter().DecryptWithNode(c.Msg)
 	if err != nil {
 		t.log.Debugf("recv: decrypting node %s data slot %d: %s", nodename, meta.Slot, err)
+		t.setPeerError(nodename, fmt.Errorf("decrypt data slot %d: %w", meta.Slot, err))
 		return
 	}
 
@@ -152,15 +147,27 @@ func (t *rx) recv(nodename string) {
 		return
 	}
 	t.log.Debugf("recv: node %s", nodename)
+	t.cmdC <- hbctrl.CmdAddBytes{HbID: t.id, Count: len(c.Msg)}
 	t.cmdC <- hbctrl.CmdSetPeerSuccess{
 		Nodename: msg.Nodename,
 		HbID:     t.id,
 		Success:  true,
+		Delay:    max(elapsed, 0),
 	}
 	t.msgC <- &msg
 	t.last = c.Updated
 }
 
+// setPeerError reports the <err> receive failure to the <nodename> peer
+// watcher.
+func (t *rx) setPeerError(nodename string, err error) {
+	t.cmdC <- hbctrl.CmdSetPeerError{
+		Nodename: nodename,
+		HbID:     t.id,
+		Err:      err,
+	}
+}
+
 func newRx(ctx context.Context, name string, nodes []string, dev string, timeout, interval time.Duration) *rx {
 	id := name + ".rx"
 	log := plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbdisk").
@@ -184,3 +191,15 @@ func newRx(ctx context.Context, name string, nodes []string, dev string, timeout
 		},
 	}
 }
+
+// newEncryptDecrypter returns a message decrypter using the cluster secrets
+// currently loaded, so a secret rotation applies to a running receiver.
+func (t *rx) newEncryptDecrypter() *omcrypto.Factory {
+	clusterConfig := cluster.ConfigData.Get()
+	return &omcrypto.Factory{
+		NodeName:    hostname.Hostname(),
+		ClusterName: clusterConfig.Name,
+		Key:         clusterConfig.Secret(),
+		AltKeys:     clusterConfig.AltSecrets(),
+	}
+}
@@ -73,6 +73,7 @@ func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
 				Nodename: node,
 				Ctx:      ctx,
 				Timeout:  t.timeout,
+				Interval: t.interval,
 			}
 		}
 		var b []byte
@@ -102,22 +103,38 @@ func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
 }
 
 func (t *tx) send(b []byte) {
+	begin := time.Now()
 	meta, err := t.base.GetPeer(hostname.Hostname())
 	if err != nil {
 		t.log.Debugf("send can't get peer for localhost: %s", err)
 		return
 	}
-	if t.base.WriteDataSlot(meta.Slot, b); err != nil { // TODO write timeout?
+	if err := t.base.WriteDataSlot(meta.Slot, b); err != nil { // TODO write timeout?
 		t.log.Debugf("send can't write data slot: %s", err)
+		t.setPeersError(fmt.Errorf("write data slot %d: %w", meta.Slot, err))
 		return
 	} else {
 		t.log.Debugf("send wrote to slot %d %s", meta.Slot, string(b))
 	}
+	t.cmdC <- hbctrl.CmdAddBytes{HbID: t.id, Count: len(b)}
+	delay := time.Since(begin)
 	for _, node := range t.nodes {
 		t.cmdC <- hbctrl.CmdSetPeerSuccess{
 			Nodename: node,
 			HbID:     t.id,
 			Success:  true,
+			Delay:    delay,
+		}
+	}
+}
+
+// setPeersError reports the <err> send failure to the peer watchers.
+func (t *tx) setPeersError(err error) {
+	for _, node := range t.nodes {
+		t.cmdC <- hbctrl.CmdSetPeerError{
+			Nodename: node,
+			HbID:     t.id,
+			Err:      err,
 		}
 	}
 }
@@ -64,8 +64,14 @@ var (
 	// SlotSize is the data size reserved for a single node
 	SlotSize = 1024 * 1024
 
-	// MaxSlots is maximum number of slots that can fit in MetaSize
-	MaxSlots = MetaSize / PageSize
+	// MaxSlots is maximum number of slots that can fit in MetaSize, minus
+	// the last meta page reserved for the disk arbitrator claims.
+	MaxSlots = MetaSize/PageSize - 1
+
+	// ClaimOffset is the offset of the meta page reserved for the disk
+	// arbitrator claims. No node is allocated this slot, so the claims don't
+	// change the slots layout of the hb disk drivers sharing the device.
+	ClaimOffset = int64(MaxSlots) * int64(PageSize)
 )
 
 func New() hbcfg.Confer {
@@ -157,13 +163,33 @@ func (t *device) open() error {
 	return nil
 }
 
+func (t *device) close() error {
+	if t.file == nil {
+		return nil
+	}
+	err := t.file.Close()
+	t.file = nil
+	return err
+}
+
 // SlotOffset returns the offset of the meta page of the slot.
 func (t *device) MetaSlotOffset(slot int) int64 {
 	return int64(slot) * int64(PageSize)
 }
 
 func (t *device) ReadMetaSlot(slot int) ([]byte, error) {
-	offset := t.MetaSlotOffset(slot)
+	return t.readPage(t.MetaSlotOffset(slot))
+}
+
+func (t *device) WriteMetaSlot(slot int, b []byte) error {
+	if len(b) > PageSize {
+		return fmt.Errorf("attempt to write too long data in meta slot %d", slot)
+	}
+	return t.writePage(t.MetaSlotOffset(slot), b)
+}
+
+// readPage returns the page at <offset>.
+func (t *device) readPage(offset int64) ([]byte, error) {
 	if _, err := t.file.Seek(offset, os.SEEK_SET); err != nil {
 		return nil, err
 	}
@@ -174,11 +200,8 @@ func (t *device) ReadMetaSlot(slot int) ([]byte, error) {
 	return block, nil
 }
 
-func (t *device) WriteMetaSlot(slot int, b []byte) error {
-	if len(b) > PageSize {
-		return fmt.Errorf("attempt to write too long data in meta slot %d", slot)
-	}
-	offset := t.MetaSlotOffset(slot)
+// writePage writes <b>, padded to a page, at <offset>.
+func (t *device) writePage(offset int64, b []byte) error {
 	if _, err := t.file.Seek(offset, os.SEEK_SET); err != nil {
 		return err
 	}
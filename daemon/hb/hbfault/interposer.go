@@ -0,0 +1,234 @@
+package hbfault
+
+import (
+	"context"
+	"time"
+
+	"github.com/opensvc/om3/core/hbtype"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/daemon/hb/hbctrl"
+)
+
+type (
+	// delayLine is a FIFO of values to deliver at a given time. The faults
+	// delay is the same for all the values of a stream, so the values are
+	// delivered in order.
+	delayLine[T any] struct {
+		items []delayed[T]
+		timer *time.Timer
+	}
+
+	delayed[T any] struct {
+		value T
+		at    time.Time
+	}
+)
+
+func newDelayLine[T any]() *delayLine[T] {
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+	return &delayLine[T]{timer: timer}
+}
+
+func (d *delayLine[T]) push(v T, at time.Time) {
+	d.items = append(d.items, delayed[T]{value: v, at: at})
+	if len(d.items) == 1 {
+		d.timer.Reset(time.Until(at))
+	}
+}
+
+// C returns the channel receiving a time when the first value is due, or nil
+// if the line is empty.
+func (d *delayLine[T]) C() <-chan time.Time {
+	if len(d.items) == 0 {
+		return nil
+	}
+	return d.timer.C
+}
+
+// pop returns the due values, and rearms the timer for the next value.
+func (d *delayLine[T]) pop() []T {
+	now := time.Now()
+	l := make([]T, 0)
+	for len(d.items) > 0 && !d.items[0].at.After(now) {
+		l = append(l, d.items[0].value)
+		d.items = d.items[1:]
+	}
+	if len(d.items) > 0 {
+		d.timer.Reset(time.Until(d.items[0].at))
+	}
+	return l
+}
+
+func (d *delayLine[T]) stop() {
+	d.timer.Stop()
+}
+
+// Tx relays the data messages from <in> to the hb tx driver <id> data
+// channel <out>, applying the faults injected on <id>.
+//
+// It ends when ctx is done.
+func (r *Registry) Tx(ctx context.Context, id string, in <-chan []byte, out chan<- []byte) {
+	var (
+		held  []byte
+		muted bool
+	)
+	line := newDelayLine[[]byte]()
+	defer line.stop()
+
+	send := func(b []byte) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case out <- b:
+			return true
+		}
+	}
+
+	handle := func(b []byte) bool {
+		f, ok := r.Lookup(id, "")
+		if !ok {
+			muted = false
+			return send(b)
+		}
+		switch f.Action {
+		case daemonsubsystem.HeartbeatFaultPause:
+			held = b
+		case daemonsubsystem.HeartbeatFaultDelay:
+			muted = false
+			line.push(b, time.Now().Add(f.Delay))
+			return true
+		}
+		if !muted {
+			// the drivers don't send empty data, so they stop sending
+			// their last message.
+			muted = true
+			return send([]byte{})
+		}
+		return true
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case b := <-in:
+			if !handle(b) {
+				return
+			}
+		case <-line.C():
+			for _, b := range line.pop() {
+				if !send(b) {
+					return
+				}
+			}
+		case <-r.Changed():
+			if held != nil {
+				if f, ok := r.Lookup(id, ""); ok && f.Action == daemonsubsystem.HeartbeatFaultPause {
+					continue
+				}
+				b := held
+				held = nil
+				if !handle(b) {
+					return
+				}
+			} else if muted {
+				if _, ok := r.Lookup(id, ""); !ok {
+					// resume with the next data message
+					muted = false
+				}
+			}
+		}
+	}
+}
+
+// Rx relays the messages and the commands from the hb rx driver <id> to
+// <msgOut> and <cmdOut>, applying the faults injected on <id>. The peer
+// success commands are filtered like the messages of the peer, so a paused
+// or dropped peer becomes stale on this hb rx.
+//
+// It ends when ctx is done.
+func (r *Registry) Rx(ctx context.Context, id string, cmdIn <-chan any, cmdOut chan<- any, msgIn <-chan *hbtype.Msg, msgOut chan<- *hbtype.Msg) {
+	held := make(map[string]*hbtype.Msg)
+	line := newDelayLine[any]()
+	defer line.stop()
+
+	send := func(i any) bool {
+		switch o := i.(type) {
+		case *hbtype.Msg:
+			select {
+			case <-ctx.Done():
+				return false
+			case msgOut <- o:
+			}
+		default:
+			select {
+			case <-ctx.Done():
+				return false
+			case cmdOut <- o:
+			}
+		}
+		return true
+	}
+
+	handleMsg := func(msg *hbtype.Msg) bool {
+		f, ok := r.Lookup(id, msg.Nodename)
+		if !ok {
+			return send(msg)
+		}
+		switch f.Action {
+		case daemonsubsystem.HeartbeatFaultPause:
+			held[msg.Nodename] = msg
+		case daemonsubsystem.HeartbeatFaultDelay:
+			line.push(msg, time.Now().Add(f.Delay))
+		}
+		return true
+	}
+
+	handleCmd := func(i any) bool {
+		o, ok := i.(hbctrl.CmdSetPeerSuccess)
+		if !ok || !o.Success {
+			return send(i)
+		}
+		f, ok := r.Lookup(id, o.Nodename)
+		if !ok {
+			return send(o)
+		}
+		if f.Action == daemonsubsystem.HeartbeatFaultDelay {
+			o.Delay += f.Delay
+			line.push(o, time.Now().Add(f.Delay))
+		}
+		return true
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case i := <-cmdIn:
+			if !handleCmd(i) {
+				return
+			}
+		case msg := <-msgIn:
+			if !handleMsg(msg) {
+				return
+			}
+		case <-line.C():
+			for _, i := range line.pop() {
+				if !send(i) {
+					return
+				}
+			}
+		case <-r.Changed():
+			for peer, msg := range held {
+				if f, ok := r.Lookup(id, peer); ok && f.Action == daemonsubsystem.HeartbeatFaultPause {
+					continue
+				}
+				delete(held, peer)
+				if !handleMsg(msg) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Package hbfault implements the heartbeat fault injections.
+
+A fault injection pauses, drops or delays the messages of a hb tx or rx
+stream, for all peers or for a single peer, until it expires. It is used to
+simulate network failures on a test cluster, to validate the split-brain and
+failover settings.
+
+The faults are applied by interposers placed between the hb component and
+the hb drivers channels, so they work with all hb drivers:
+
+  - a tx interposer filters the data messages sent to the driver. When a tx
+    is paused or dropped, an empty data message is sent to the driver, so it
+    stops sending its last message to its peers.
+  - a rx interposer filters the messages and the peer success commands
+    sent by the driver.
+
+The tx drivers send the same data to all peers, so the tx faults apply to
+all peers. A single peer fault is injected on a rx stream.
+*/
+package hbfault
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+)
+
+type (
+	Fault = daemonsubsystem.HeartbeatFault
+
+	// Registry holds the active fault injections, indexed by hb id and peer.
+	Registry struct {
+		mu     sync.RWMutex
+		faults map[key]entry
+
+		// changed is closed and replaced on each fault change, to wake up
+		// the interposers holding paused messages.
+		changed chan struct{}
+
+		// OnClear is called when a fault is cleared, with the reason:
+		// "expired", "cleared" or "replaced".
+		OnClear func(f Fault, reason string)
+	}
+
+	key struct {
+		id   string
+		peer string
+	}
+
+	entry struct {
+		fault Fault
+		timer *time.Timer
+	}
+)
+
+const (
+	ReasonExpired  = "expired"
+	ReasonCleared  = "cleared"
+	ReasonReplaced = "replaced"
+)
+
+var (
+	// MaxDuration is the maximum duration of a fault injection.
+	MaxDuration = time.Hour
+)
+
+func NewRegistry() *Registry {
+	return &Registry{
+		faults:  make(map[key]entry),
+		changed: make(chan struct{}),
+	}
+}
+
+// Validate returns an error if the fault can't be injected.
+func Validate(f Fault) error {
+	switch {
+	case !strings.HasPrefix(f.ID, "hb#"):
+		return fmt.Errorf("invalid hb id '%s': expected hb#<name>.rx or hb#<name>.tx", f.ID)
+	case strings.HasSuffix(f.ID, ".rx"):
+	case strings.HasSuffix(f.ID, ".tx"):
+		if f.Peer != "" {
+			return fmt.Errorf("invalid peer '%s' for %s: the tx faults apply to all peers, inject a rx fault on the peer node instead", f.Peer, f.ID)
+		}
+	default:
+		return fmt.Errorf("invalid hb id '%s': expected hb#<name>.rx or hb#<name>.tx", f.ID)
+	}
+	switch f.Action {
+	case daemonsubsystem.HeartbeatFaultPause, daemonsubsystem.HeartbeatFaultDrop:
+	case daemonsubsystem.HeartbeatFaultDelay:
+		if f.Delay <= 0 {
+			return fmt.Errorf("invalid delay %s: the delay action needs a positive delay", f.Delay)
+		}
+	default:
+		return fmt.Errorf("invalid action '%s': expected pause, drop or delay", f.Action)
+	}
+	if d := f.ExpireAt.Sub(f.CreatedAt); d <= 0 || d > MaxDuration {
+		return fmt.Errorf("invalid duration %s: expected a positive duration up to %s", d, MaxDuration)
+	}
+	return nil
+}
+
+// Inject adds the fault <f>, replacing the fault with the same hb id and
+// peer. The fault is cleared when its ExpireAt is reached.
+func (r *Registry) Inject(f Fault) error {
+	if err := Validate(f); err != nil {
+		return err
+	}
+	k := key{id: f.ID, peer: f.Peer}
+	r.mu.Lock()
+	replaced, hasReplaced := r.faults[k]
+	if hasReplaced {
+		replaced.timer.Stop()
+	}
+	var e entry
+	e.fault = f
+	e.timer = time.AfterFunc(time.Until(f.ExpireAt), func() {
+		r.expire(k, f)
+	})
+	r.faults[k] = e
+	r.notifyLocked()
+	r.mu.Unlock()
+	if hasReplaced {
+		r.onClear(replaced.fault, ReasonReplaced)
+	}
+	return nil
+}
+
+// Clear clears the faults of the hb <id>. If <peer> is empty, all the
+// faults of the hb <id> are cleared. It returns the cleared faults.
+func (r *Registry) Clear(id, peer string) []Fault {
+	r.mu.Lock()
+	cleared := make([]Fault, 0)
+	for k, e := range r.faults {
+		if k.id != id || (peer != "" && k.peer != peer) {
+			continue
+		}
+		e.timer.Stop()
+		delete(r.faults, k)
+		cleared = append(cleared, e.fault)
+	}
+	if len(cleared) > 0 {
+		r.notifyLocked()
+	}
+	r.mu.Unlock()
+	sortFaults(cleared)
+	for _, f := range cleared {
+		r.onClear(f, ReasonCleared)
+	}
+	return cleared
+}
+
+// Stop clears all the faults, without calling OnClear.
+func (r *Registry) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for k, e := range r.faults {
+		e.timer.Stop()
+		delete(r.faults, k)
+	}
+	r.notifyLocked()
+}
+
+// Lookup returns the fault applying to the messages of the hb <id> from or
+// to <peer>: the <peer> fault if any, else the all peers fault.
+func (r *Registry) Lookup(id, peer string) (Fault, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if peer != "" {
+		if e, ok := r.faults[key{id: id, peer: peer}]; ok {
+			return e.fault, true
+		}
+	}
+	e, ok := r.faults[key{id: id}]
+	return e.fault, ok
+}
+
+// List returns the faults of the hb <id>, sorted by peer.
+func (r *Registry) List(id string) []Fault {
+	r.mu.RLock()
+	l := make([]Fault, 0)
+	for k, e := range r.faults {
+		if k.id == id {
+			l = append(l, e.fault)
+		}
+	}
+	r.mu.RUnlock()
+	sortFaults(l)
+	return l
+}
+
+// Changed returns a channel closed on the next fault change.
+func (r *Registry) Changed() <-chan struct{} {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.changed
+}
+
+func (r *Registry) expire(k key, f Fault) {
+	r.mu.Lock()
+	e, ok := r.faults[k]
+	if !ok || !e.fault.CreatedAt.Equal(f.CreatedAt) {
+		// cleared or replaced while this timer was firing
+		r.mu.Unlock()
+		return
+	}
+	delete(r.faults, k)
+	r.notifyLocked()
+	r.mu.Unlock()
+	r.onClear(f, ReasonExpired)
+}
+
+func (r *Registry) notifyLocked() {
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+func (r *Registry) onClear(f Fault, reason string) {
+	if r.OnClear != nil {
+		r.OnClear(f, reason)
+	}
+}
+
+func sortFaults(l []Fault) {
+	sort.Slice(l, func(i, j int) bool {
+		if l[i].ID != l[j].ID {
+			return l[i].ID < l[j].ID
+		}
+		return l[i].Peer < l[j].Peer
+	})
+}
@@ -0,0 +1,205 @@
+package hbfault
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/hbtype"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/daemon/hb/hbctrl"
+)
+
+func newFault(id, peer, action string, d time.Duration) Fault {
+	now := time.Now()
+	return Fault{ID: id, Peer: peer, Action: action, CreatedAt: now, ExpireAt: now.Add(d)}
+}
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		fault Fault
+		err   string
+	}{
+		"rx peer drop": {
+			fault: newFault("hb#1.rx", "n2", daemonsubsystem.HeartbeatFaultDrop, time.Minute),
+		},
+		"tx pause": {
+			fault: newFault("hb#1.tx", "", daemonsubsystem.HeartbeatFaultPause, time.Minute),
+		},
+		"tx peer": {
+			fault: newFault("hb#1.tx", "n2", daemonsubsystem.HeartbeatFaultDrop, time.Minute),
+			err:   "the tx faults apply to all peers",
+		},
+		"invalid id": {
+			fault: newFault("hb#1", "", daemonsubsystem.HeartbeatFaultDrop, time.Minute),
+			err:   "invalid hb id",
+		},
+		"invalid action": {
+			fault: newFault("hb#1.rx", "", "foo", time.Minute),
+			err:   "invalid action",
+		},
+		"delay without delay": {
+			fault: newFault("hb#1.rx", "", daemonsubsystem.HeartbeatFaultDelay, time.Minute),
+			err:   "positive delay",
+		},
+		"unbounded duration": {
+			fault: newFault("hb#1.rx", "", daemonsubsystem.HeartbeatFaultDrop, 2*MaxDuration),
+			err:   "invalid duration",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Validate(tc.fault)
+			if tc.err == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		reasons []string
+	)
+	r := NewRegistry()
+	r.OnClear = func(f Fault, reason string) {
+		mu.Lock()
+		defer mu.Unlock()
+		reasons = append(reasons, f.Peer+" "+reason)
+	}
+	defer r.Stop()
+
+	require.NoError(t, r.Inject(newFault("hb#1.rx", "", daemonsubsystem.HeartbeatFaultDrop, time.Minute)))
+	require.NoError(t, r.Inject(newFault("hb#1.rx", "n2", daemonsubsystem.HeartbeatFaultPause, time.Minute)))
+
+	f, ok := r.Lookup("hb#1.rx", "n2")
+	require.True(t, ok)
+	require.Equal(t, daemonsubsystem.HeartbeatFaultPause, f.Action, "the peer fault must apply first")
+
+	f, ok = r.Lookup("hb#1.rx", "n3")
+	require.True(t, ok)
+	require.Equal(t, daemonsubsystem.HeartbeatFaultDrop, f.Action, "the all peers fault must apply to other peers")
+
+	_, ok = r.Lookup("hb#1.tx", "n2")
+	require.False(t, ok)
+
+	require.NoError(t, r.Inject(newFault("hb#1.rx", "n2", daemonsubsystem.HeartbeatFaultDrop, 50*time.Millisecond)))
+	require.Len(t, r.List("hb#1.rx"), 2)
+
+	require.Eventually(t, func() bool {
+		return len(r.List("hb#1.rx")) == 1
+	}, time.Second, 10*time.Millisecond, "the n2 fault must expire")
+
+	require.Len(t, r.Clear("hb#1.rx", ""), 1)
+	require.Empty(t, r.List("hb#1.rx"))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, []string{"n2 replaced", "n2 expired", " cleared"}, reasons)
+}
+
+func TestTx(t *testing.T) {
+	r := NewRegistry()
+	defer r.Stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	in := make(chan []byte)
+	out := make(chan []byte, 10)
+	go r.Tx(ctx, "hb#1.tx", in, out)
+
+	in <- []byte("1")
+	require.Equal(t, []byte("1"), <-out)
+
+	t.Logf("pause: the driver is muted, the last message is delivered on expire")
+	require.NoError(t, r.Inject(newFault("hb#1.tx", "", daemonsubsystem.HeartbeatFaultPause, 100*time.Millisecond)))
+	in <- []byte("2")
+	require.Equal(t, []byte{}, <-out)
+	in <- []byte("3")
+	select {
+	case b := <-out:
+		t.Fatalf("unexpected message %s during pause", b)
+	case <-time.After(50 * time.Millisecond):
+	}
+	require.Equal(t, []byte("3"), <-out)
+
+	t.Logf("drop: the driver is muted, the messages are discarded")
+	require.NoError(t, r.Inject(newFault("hb#1.tx", "", daemonsubsystem.HeartbeatFaultDrop, time.Minute)))
+	in <- []byte("4")
+	require.Equal(t, []byte{}, <-out)
+	in <- []byte("5")
+	select {
+	case b := <-out:
+		t.Fatalf("unexpected message %s during drop", b)
+	case <-time.After(50 * time.Millisecond):
+	}
+	r.Clear("hb#1.tx", "")
+	in <- []byte("6")
+	require.Equal(t, []byte("6"), <-out)
+
+	t.Logf("delay: the messages are delivered after the delay, in order")
+	f := newFault("hb#1.tx", "", daemonsubsystem.HeartbeatFaultDelay, time.Minute)
+	f.Delay = 50 * time.Millisecond
+	require.NoError(t, r.Inject(f))
+	begin := time.Now()
+	in <- []byte("7")
+	in <- []byte("8")
+	require.Equal(t, []byte("7"), <-out)
+	require.Equal(t, []byte("8"), <-out)
+	require.GreaterOrEqual(t, time.Since(begin), f.Delay)
+}
+
+func TestRx(t *testing.T) {
+	r := NewRegistry()
+	defer r.Stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cmdIn := make(chan any)
+	cmdOut := make(chan any, 10)
+	msgIn := make(chan *hbtype.Msg)
+	msgOut := make(chan *hbtype.Msg, 10)
+	go r.Rx(ctx, "hb#1.rx", cmdIn, cmdOut, msgIn, msgOut)
+
+	beat := func(peer string) {
+		msgIn <- &hbtype.Msg{Nodename: peer}
+		cmdIn <- hbctrl.CmdSetPeerSuccess{Nodename: peer, HbID: "hb#1.rx", Success: true}
+	}
+
+	t.Logf("drop n2: the n2 messages and successes are discarded")
+	require.NoError(t, r.Inject(newFault("hb#1.rx", "n2", daemonsubsystem.HeartbeatFaultDrop, time.Minute)))
+	beat("n2")
+	beat("n3")
+	require.Equal(t, "n3", (<-msgOut).Nodename)
+	require.Equal(t, "n3", (<-cmdOut).(hbctrl.CmdSetPeerSuccess).Nodename)
+	require.Len(t, msgOut, 0)
+	require.Len(t, cmdOut, 0)
+
+	t.Logf("the other commands are not filtered")
+	cmdIn <- hbctrl.CmdSetPeerSuccess{Nodename: "n2", HbID: "hb#1.rx", Success: false}
+	require.False(t, (<-cmdOut).(hbctrl.CmdSetPeerSuccess).Success)
+
+	t.Logf("delay n2: the n2 messages and successes are delivered after the delay")
+	f := newFault("hb#1.rx", "n2", daemonsubsystem.HeartbeatFaultDelay, time.Minute)
+	f.Delay = 50 * time.Millisecond
+	require.NoError(t, r.Inject(f))
+	begin := time.Now()
+	beat("n2")
+	require.Equal(t, "n2", (<-msgOut).Nodename)
+	cmd := (<-cmdOut).(hbctrl.CmdSetPeerSuccess)
+	require.Equal(t, "n2", cmd.Nodename)
+	require.Equal(t, f.Delay, cmd.Delay)
+	require.GreaterOrEqual(t, time.Since(begin), f.Delay)
+
+	t.Logf("pause n2: the last n2 message is delivered on clear")
+	require.NoError(t, r.Inject(newFault("hb#1.rx", "n2", daemonsubsystem.HeartbeatFaultPause, time.Minute)))
+	msgIn <- &hbtype.Msg{Nodename: "n2", Kind: "1"}
+	msgIn <- &hbtype.Msg{Nodename: "n2", Kind: "2"}
+	require.Len(t, msgOut, 0)
+	r.Clear("hb#1.rx", "n2")
+	require.Equal(t, "2", (<-msgOut).Kind)
+}
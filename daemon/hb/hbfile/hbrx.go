@@ -0,0 +1,203 @@
+package hbfile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/hbtype"
+	"github.com/opensvc/om3/core/omcrypto"
+	"github.com/opensvc/om3/daemon/hb/hbctrl"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	// rx holds an hb file receiver
+	rx struct {
+		sync.WaitGroup
+		dir      directory
+		ctx      context.Context
+		id       string
+		nodes    []string
+		timeout  time.Duration
+		interval time.Duration
+
+		// last is the capsule update time of the last message received,
+		// indexed by nodename.
+		last map[string]time.Time
+
+		name   string
+		log    *plog.Logger
+		cmdC   chan<- any
+		msgC   chan<- *hbtype.Msg
+		cancel func()
+	}
+)
+
+// ID implements the ID function of the Receiver interface for rx
+func (t *rx) ID() string {
+	return t.id
+}
+
+// Stop implements the Stop function of the Receiver interface for rx
+func (t *rx) Stop() error {
+	t.log.Debugf("cancelling")
+	t.cancel()
+	for _, node := range t.nodes {
+		t.cmdC <- hbctrl.CmdDelWatcher{
+			HbID:     t.id,
+			Nodename: node,
+		}
+	}
+	t.Wait()
+	t.log.Debugf("wait done")
+	return nil
+}
+
+// Start implements the Start function of the Receiver interface for rx
+func (t *rx) Start(cmdC chan<- any, msgC chan<- *hbtype.Msg) error {
+	if err := t.dir.open(); err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(t.ctx)
+	t.cmdC = cmdC
+	t.msgC = msgC
+	t.cancel = cancel
+
+	for _, node := range t.nodes {
+		cmdC <- hbctrl.CmdAddWatcher{
+			HbID:     t.id,
+			Nodename: node,
+			Ctx:      ctx,
+			Timeout:  t.timeout,
+			Interval: t.interval,
+		}
+	}
+
+	t.Add(1)
+	go func() {
+		defer t.Done()
+		t.log.Infof("started")
+		defer t.log.Infof("stopped")
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.onTick()
+			case <-ctx.Done():
+				t.cancel()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (t *rx) onTick() {
+	for _, node := range t.nodes {
+		t.recv(node)
+	}
+}
+
+func (t *rx) recv(nodename string) {
+	c, err := t.dir.ReadSlot(nodename) // TODO read timeout?
+	if errors.Is(err, os.ErrNotExist) {
+		t.log.Debugf("recv: node %s slot file has never been written", nodename)
+		return
+	} else if err != nil {
+		t.log.Debugf("recv: reading node %s slot file: %s", nodename, err)
+		t.setPeerError(nodename, fmt.Errorf("read slot file: %w", err))
+		return
+	}
+	if c.Updated.IsZero() {
+		t.log.Debugf("recv: node %s slot file has never been updated", nodename)
+		return
+	}
+	if last, ok := t.last[nodename]; ok && c.Updated.Equal(last) {
+		t.log.Debugf("recv: node %s slot file has not change since last read", nodename)
+		return
+	}
+	elapsed := time.Now().Sub(c.Updated)
+	if elapsed > t.timeout {
+		t.log.Debugf("recv: node %s slot file has not been updated for %s", nodename, elapsed)
+		return
+	}
+	b, msgNodename, err := t.newEncryptDecrypter().DecryptWithNode(c.Msg)
+	if err != nil {
+		t.log.Debugf("recv: decrypting node %s slot file: %s", nodename, err)
+		t.setPeerError(nodename, fmt.Errorf("decrypt slot file: %w", err))
+		return
+	}
+
+	if nodename != msgNodename {
+		t.log.Debugf("recv: node %s slot file was written by unexpected node %s", nodename, msgNodename)
+		return
+	}
+
+	msg := hbtype.Msg{}
+	if err := json.Unmarshal(b, &msg); err != nil {
+		t.log.Warnf("can't unmarshal msg from %s: %s", nodename, err)
+		return
+	}
+	t.log.Debugf("recv: node %s", nodename)
+	t.cmdC <- hbctrl.CmdAddBytes{HbID: t.id, Count: len(c.Msg)}
+	t.cmdC <- hbctrl.CmdSetPeerSuccess{
+		Nodename: msg.Nodename,
+		HbID:     t.id,
+		Success:  true,
+		Delay:    max(elapsed, 0),
+	}
+	t.msgC <- &msg
+	t.last[nodename] = c.Updated
+}
+
+// setPeerError reports the <err> receive failure to the <nodename> peer
+// watcher.
+func (t *rx) setPeerError(nodename string, err error) {
+	t.cmdC <- hbctrl.CmdSetPeerError{
+		Nodename: nodename,
+		HbID:     t.id,
+		Err:      err,
+	}
+}
+
+func newRx(ctx context.Context, name string, nodes []string, dir string, timeout, interval time.Duration) *rx {
+	id := name + ".rx"
+	log := plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbfile").
+		Attr("hb_func", "rx").
+		Attr("hb_name", name).
+		Attr("hb_id", id).
+		WithPrefix("daemon: hb: file: rx: " + name + ": ")
+
+	return &rx{
+		ctx:      ctx,
+		id:       id,
+		nodes:    nodes,
+		timeout:  timeout,
+		interval: interval,
+		last:     make(map[string]time.Time),
+		log:      log,
+		dir: directory{
+			path: dir,
+		},
+	}
+}
+
+// newEncryptDecrypter returns a message decrypter using the cluster secrets
+// currently loaded.
+func (t *rx) newEncryptDecrypter() *omcrypto.Factory {
+	clusterConfig := cluster.ConfigData.Get()
+	return &omcrypto.Factory{
+		NodeName:    hostname.Hostname(),
+		ClusterName: clusterConfig.Name,
+		Key:         clusterConfig.Secret(),
+		AltKeys:     clusterConfig.AltSecrets(),
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Package hbfile implement a hb file driver.
+A designated directory on a shared filesystem hosts per node slot files
+receiving the exchanged dataset.
+*/
+package hbfile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/opensvc/om3/core/hbcfg"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/plog"
+)
+
+type (
+	T struct {
+		hbcfg.T
+	}
+
+	capsule struct {
+		Updated time.Time `json:"updated"`
+		Msg     []byte    `json:"msg"`
+	}
+
+	// directory is the shared directory hosting the node slot files.
+	directory struct {
+		path string
+	}
+)
+
+var (
+	// SlotSize is the maximum data size of a single node slot file
+	SlotSize = 1024 * 1024
+)
+
+func New() hbcfg.Confer {
+	t := &T{}
+	var i interface{} = t
+	return i.(hbcfg.Confer)
+}
+
+func init() {
+	hbcfg.Register("file", New)
+}
+
+// Configure implements the Configure function of Confer interface for T
+func (t *T) Configure(ctx context.Context) {
+	log := plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbfile").Attr("hb_name", t.Name()).WithPrefix("daemon: hb: file: " + t.Name() + ": configure:")
+	timeout := t.GetDuration("timeout", 9*time.Second)
+	interval := t.GetDuration("interval", 4*time.Second)
+	if timeout < 2*interval+1*time.Second {
+		oldTimeout := timeout
+		timeout = interval*2 + 1*time.Second
+		log.Warnf("reajust timeout: %s => %s (<interval>*2+1s)", oldTimeout, timeout)
+	}
+
+	nodes := t.GetStrings("nodes")
+	if len(nodes) == 0 {
+		k := key.T{Section: "cluster", Option: "nodes"}
+		nodes = t.Config().GetStrings(k)
+	}
+	dir := t.GetString("dir")
+	oNodes := hostname.OtherNodes(nodes)
+	log.Debugf("timeout=%s interval=%s dir=%s nodes=%s onodes=%s", timeout, interval, dir, nodes, oNodes)
+	t.SetNodes(oNodes)
+	t.SetTimeout(timeout)
+	signature := fmt.Sprintf("type: hb.file, dir: %s nodes: %s timeout: %s interval: %s", dir, nodes, timeout, interval)
+	t.SetSignature(signature)
+	name := t.Name()
+	tx := newTx(ctx, name, oNodes, dir, timeout, interval)
+	t.SetTx(tx)
+	rx := newRx(ctx, name, oNodes, dir, timeout, interval)
+	t.SetRx(rx)
+}
+
+func (t *directory) open() error {
+	if t.path == "" {
+		return fmt.Errorf("the 'dir' keyword is not set")
+	}
+	if !filepath.IsAbs(t.path) {
+		return fmt.Errorf("%s must be an absolute path", t.path)
+	}
+	info, err := os.Stat(t.path)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("%s does not exist: %w", t.path, err)
+	} else if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s must be a directory", t.path)
+	}
+	return nil
+}
+
+// SlotFile returns the path of the node slot file.
+func (t *directory) SlotFile(nodename string) string {
+	return filepath.Join(t.path, nodename)
+}
+
+// ReadSlot returns the capsule stored in the node slot file.
+func (t *directory) ReadSlot(nodename string) (capsule, error) {
+	c := capsule{}
+	f, err := os.Open(t.SlotFile(nodename))
+	if err != nil {
+		return c, err
+	}
+	defer func() { _ = f.Close() }()
+	if err := json.NewDecoder(io.LimitReader(f, int64(SlotSize))).Decode(&c); err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// WriteSlot stores the capsule of <b> in the node slot file.
+//
+// The capsule is written to a temporary file in the same directory, then
+// renamed to the slot file, so readers never see a partially written
+// capsule.
+func (t *directory) WriteSlot(nodename string, b []byte) error {
+	c := capsule{
+		Msg:     b,
+		Updated: time.Now(),
+	}
+	b, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("msg encapsulation: %w", err)
+	}
+	if len(b) > SlotSize {
+		return fmt.Errorf("attempt to write too long data in slot file of node %s", nodename)
+	}
+	slotFile := t.SlotFile(nodename)
+	f, err := os.CreateTemp(t.path, "."+nodename+".*")
+	if err != nil {
+		return err
+	}
+	tmpFile := f.Name()
+	defer func() { _ = os.Remove(tmpFile) }()
+	if _, err := f.Write(b); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmpFile, slotFile)
+}
@@ -0,0 +1,44 @@
+package hbfile
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDirectorySlot(t *testing.T) {
+	dir := directory{path: t.TempDir()}
+	require.NoError(t, dir.open())
+
+	_, err := dir.ReadSlot("node1")
+	assert.True(t, errors.Is(err, os.ErrNotExist), "read a never written slot file: %s", err)
+
+	before := time.Now()
+	require.NoError(t, dir.WriteSlot("node1", []byte("msg1")))
+	c, err := dir.ReadSlot("node1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("msg1"), c.Msg)
+	assert.False(t, c.Updated.Before(before))
+
+	require.NoError(t, dir.WriteSlot("node1", []byte("msg2")))
+	c, err = dir.ReadSlot("node1")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("msg2"), c.Msg)
+
+	entries, err := os.ReadDir(dir.path)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "expected no temporary file left")
+
+	err = dir.WriteSlot("node1", make([]byte, SlotSize))
+	assert.Error(t, err, "write too long data")
+}
+
+func TestDirectoryOpen(t *testing.T) {
+	assert.Error(t, (&directory{}).open(), "empty dir")
+	assert.Error(t, (&directory{path: "relative"}).open(), "relative dir")
+	assert.Error(t, (&directory{path: "/nonexistent/hb"}).open(), "missing dir")
+}
@@ -28,15 +28,18 @@ type (
 		udpAddr  *net.UDPAddr
 		intf     *net.Interface
 		timeout  time.Duration
+		interval time.Duration
 		assembly map[string]msgMap
 
+		// nodeByIP is the nodename of the peer nodes addresses, used to
+		// report the receive failures to the peer watchers.
+		nodeByIP map[string]string
+
 		name   string
 		log    *plog.Logger
 		cmdC   chan<- interface{}
 		msgC   chan<- *hbtype.Msg
 		cancel func()
-
-		encryptDecrypter *omcrypto.Factory
 	}
 	assembly map[string]msgMap
 	msgMap   map[string]dataMap
@@ -72,22 +75,26 @@ func (t *rx) Start(cmdC chan<- interface{}, msgC chan<- *hbtype.Msg) error {
 	t.cancel = cancel
 	t.log.Infof("starting")
 	t.assembly = make(assembly)
-	clusterConfig := cluster.ConfigData.Get()
-	t.encryptDecrypter = &omcrypto.Factory{
-		NodeName:    hostname.Hostname(),
-		ClusterName: clusterConfig.Name,
-		Key:         clusterConfig.Secret(),
-	}
+	t.nodeByIP = make(map[string]string)
 	started := make(chan bool)
 	t.Add(1)
 	go func() {
 		defer t.Done()
+		resolver := net.Resolver{}
 		for _, node := range t.nodes {
 			cmdC <- hbctrl.CmdAddWatcher{
 				HbID:     t.id,
 				Nodename: node,
 				Ctx:      ctx,
 				Timeout:  t.timeout,
+				Interval: t.interval,
+			}
+			addrs, err := resolver.LookupHost(ctx, node)
+			if err != nil {
+				continue
+			}
+			for _, addr := range addrs {
+				t.nodeByIP[addr] = node
 			}
 		}
 		listener, err := net.ListenMulticastUDP("udp", t.intf, t.udpAddr)
@@ -121,8 +128,10 @@ func (t *rx) Start(cmdC chan<- interface{}, msgC chan<- *hbtype.Msg) error {
 					break
 				}
 				t.log.Infof("read: %s", err)
+				t.setPeersError(fmt.Errorf("read: %w", err))
 				// avoid fast loop
 				time.Sleep(200 * time.Millisecond)
+				continue
 			}
 			t.recv(src, n, b)
 		}
@@ -203,20 +212,23 @@ func (t *rx) recv(src *net.UDPAddr, n int, b []byte) {
 	} else {
 		encMsg = chunks[1]
 	}
-	b, err := t.encryptDecrypter.Decrypt(encMsg)
+	b, err := t.newEncryptDecrypter().Decrypt(encMsg)
 	if err != nil {
 		t.log.Debugf("recv: decrypting msg from %s: %s: %s", s, hex.Dump(encMsg), err)
+		t.setPeerErrorFrom(src, fmt.Errorf("decrypt: %w", err))
 		return
 	}
 	data := hbtype.Msg{}
 	if err := json.Unmarshal(b, &data); err != nil {
 		t.log.Warnf("can't unmarshal msg from %s: %s", s, err)
+		t.setPeerErrorFrom(src, fmt.Errorf("unmarshal: %w", err))
 		return
 	}
 	if data.Nodename == hostname.Hostname() {
 		t.log.Debugf("recv: drop msg from self")
 		return
 	}
+	t.cmdC <- hbctrl.CmdAddBytes{HbID: t.id, Count: len(encMsg)}
 	t.cmdC <- hbctrl.CmdSetPeerSuccess{
 		Nodename: data.Nodename,
 		HbID:     t.id,
@@ -225,15 +237,46 @@ func (t *rx) recv(src *net.UDPAddr, n int, b []byte) {
 	t.msgC <- &data
 }
 
-func newRx(ctx context.Context, name string, nodes []string, udpAddr *net.UDPAddr, intf *net.Interface, timeout time.Duration) *rx {
+// setPeerError reports the <err> receive failure to the <nodename> peer
+// watcher.
+func (t *rx) setPeerError(nodename string, err error) {
+	t.cmdC <- hbctrl.CmdSetPeerError{
+		Nodename: nodename,
+		HbID:     t.id,
+		Err:      err,
+	}
+}
+
+// setPeerErrorFrom reports the <err> receive failure to the watcher of the
+// peer owning the <src> address. The failures from unknown addresses are
+// not reported.
+func (t *rx) setPeerErrorFrom(src *net.UDPAddr, err error) {
+	if src == nil {
+		return
+	}
+	if nodename, ok := t.nodeByIP[src.IP.String()]; ok {
+		t.setPeerError(nodename, err)
+	}
+}
+
+// setPeersError reports the <err> receive failure to all the peer watchers,
+// for the failures not related to a single peer.
+func (t *rx) setPeersError(err error) {
+	for _, nodename := range t.nodes {
+		t.setPeerError(nodename, err)
+	}
+}
+
+func newRx(ctx context.Context, name string, nodes []string, udpAddr *net.UDPAddr, intf *net.Interface, timeout, interval time.Duration) *rx {
 	id := name + ".rx"
 	return &rx{
-		ctx:     ctx,
-		id:      id,
-		nodes:   nodes,
-		udpAddr: udpAddr,
-		intf:    intf,
-		timeout: timeout,
+		ctx:      ctx,
+		id:       id,
+		nodes:    nodes,
+		udpAddr:  udpAddr,
+		intf:     intf,
+		timeout:  timeout,
+		interval: interval,
 		log: plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbmcast").
 			Attr("hb_func", "rx").
 			Attr("hb_name", name).
@@ -241,3 +284,15 @@ func newRx(ctx context.Context, name string, nodes []string, udpAddr *net.UDPAdd
 			WithPrefix("daemon: hb: mcast: rx: " + name + ": "),
 	}
 }
+
+// newEncryptDecrypter returns a message decrypter using the cluster secrets
+// currently loaded.
+func (t *rx) newEncryptDecrypter() *omcrypto.Factory {
+	clusterConfig := cluster.ConfigData.Get()
+	return &omcrypto.Factory{
+		NodeName:    hostname.Hostname(),
+		ClusterName: clusterConfig.Name,
+		Key:         clusterConfig.Secret(),
+		AltKeys:     clusterConfig.AltSecrets(),
+	}
+}
@@ -3,6 +3,7 @@ package hbmcast
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net"
 	"sync"
 	"time"
@@ -33,8 +34,6 @@ type (
 		cmdC   chan<- interface{}
 		msgC   chan<- *hbtype.Msg
 		cancel func()
-
-		encryptDecrypter *omcrypto.Factory
 	}
 )
 
@@ -65,13 +64,6 @@ func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
 	t.cancel = cancel
 	t.cmdC = cmdC
 
-	clusterConfig := cluster.ConfigData.Get()
-	t.encryptDecrypter = &omcrypto.Factory{
-		NodeName:    hostname.Hostname(),
-		ClusterName: clusterConfig.Name,
-		Key:         clusterConfig.Secret(),
-	}
-
 	t.Add(1)
 	go func() {
 		defer t.Done()
@@ -83,6 +75,7 @@ func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
 				Nodename: node,
 				Ctx:      ctx,
 				Timeout:  t.timeout,
+				Interval: t.interval,
 			}
 		}
 		started <- true
@@ -114,16 +107,18 @@ func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
 }
 
 func (t *tx) encryptMessage(b []byte) ([]byte, error) {
-	return t.encryptDecrypter.Encrypt(b)
+	return t.newEncryptDecrypter().Encrypt(b)
 }
 
 func (t *tx) send(b []byte) {
 	//fmt.Println("xx >>>\n", hex.Dump(b))
 	t.log.Debugf("send to udp %s", t.udpAddr)
+	begin := time.Now()
 
 	c, err := net.DialUDP("udp", t.laddr, t.udpAddr)
 	if err != nil {
 		t.log.Debugf("dial udp %s: %s", t.udpAddr, err)
+		t.setPeersError(fmt.Errorf("dial udp %s: %w", t.udpAddr, err))
 		return
 	}
 	defer c.Close()
@@ -156,16 +151,32 @@ func (t *tx) send(b []byte) {
 			t.log.Debugf("marshal frame: %s", err)
 			return
 		}
-		if _, err := c.Write(dgram); err != nil {
+		n, err := c.Write(dgram)
+		if err != nil {
 			t.log.Debugf("write in udp conn to %s: %s", t.udpAddr, err)
+			t.setPeersError(fmt.Errorf("write in udp conn to %s: %w", t.udpAddr, err))
 			return
 		}
+		t.cmdC <- hbctrl.CmdAddBytes{HbID: t.id, Count: n}
 	}
+	delay := time.Since(begin)
 	for _, node := range t.nodes {
 		t.cmdC <- hbctrl.CmdSetPeerSuccess{
 			Nodename: node,
 			HbID:     t.id,
 			Success:  true,
+			Delay:    delay,
+		}
+	}
+}
+
+// setPeersError reports the <err> send failure to the peer watchers.
+func (t *tx) setPeersError(err error) {
+	for _, node := range t.nodes {
+		t.cmdC <- hbctrl.CmdSetPeerError{
+			Nodename: node,
+			HbID:     t.id,
+			Err:      err,
 		}
 	}
 }
@@ -187,3 +198,15 @@ func newTx(ctx context.Context, name string, nodes []string, laddr, udpAddr *net
 			WithPrefix("daemon: hb: mcast: tx: " + name + ": "),
 	}
 }
+
+// newEncryptDecrypter returns a message encrypter using the cluster secret
+// currently loaded.
+func (t *tx) newEncryptDecrypter() *omcrypto.Factory {
+	clusterConfig := cluster.ConfigData.Get()
+	return &omcrypto.Factory{
+		NodeName:    hostname.Hostname(),
+		ClusterName: clusterConfig.Name,
+		Key:         clusterConfig.Secret(),
+		AltKeys:     clusterConfig.AltSecrets(),
+	}
+}
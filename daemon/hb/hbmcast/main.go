@@ -125,6 +125,6 @@ func (t *T) Configure(ctx context.Context) {
 
 	tx := newTx(ctx, name, oNodes, laddr, udpAddr, timeout, interval)
 	t.SetTx(tx)
-	rx := newRx(ctx, name, oNodes, udpAddr, ifi, timeout)
+	rx := newRx(ctx, name, oNodes, udpAddr, ifi, timeout, interval)
 	t.SetRx(rx)
 }
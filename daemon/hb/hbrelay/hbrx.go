@@ -3,6 +3,8 @@ package hbrelay
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
@@ -24,21 +26,26 @@ type (
 		ctx      context.Context
 		id       string
 		nodes    []string
-		relay    string
 		username string
 		password string
 		insecure bool
 		timeout  time.Duration
 		interval time.Duration
-		lastAt   time.Time
+
+		// relays is the list of relays to receive from, and current is the
+		// index of the relay of the last fresh message received.
+		relays  []string
+		current int
+
+		// last is the update time of the last message received, indexed by
+		// nodename.
+		last map[string]time.Time
 
 		name   string
 		log    *plog.Logger
 		cmdC   chan<- any
 		msgC   chan<- *hbtype.Msg
 		cancel func()
-
-		encryptDecrypter *omcrypto.Factory
 	}
 )
 
@@ -70,19 +77,13 @@ func (t *rx) Start(cmdC chan<- any, msgC chan<- *hbtype.Msg) error {
 	t.cancel = cancel
 	ticker := time.NewTicker(t.interval)
 
-	clusterConfig := cluster.ConfigData.Get()
-	t.encryptDecrypter = &omcrypto.Factory{
-		NodeName:    hostname.Hostname(),
-		ClusterName: clusterConfig.Name,
-		Key:         clusterConfig.Secret(),
-	}
-
 	for _, node := range t.nodes {
 		cmdC <- hbctrl.CmdAddWatcher{
 			HbID:     t.id,
 			Nodename: node,
 			Ctx:      ctx,
 			Timeout:  t.timeout,
+			Interval: t.interval,
 		}
 	}
 
@@ -111,52 +112,55 @@ func (t *rx) onTick() {
 	}
 }
 
+// recv reads the <nodename> message from the current relay, failing over to
+// the next relays of the list when the current relay fails or has no fresh
+// message.
 func (t *rx) recv(nodename string) {
-	clusterID := cluster.ConfigData.Get().ID
-	cli, err := client.New(
-		client.WithURL(t.relay),
-		client.WithUsername(t.username),
-		client.WithPassword(t.password),
-		client.WithInsecureSkipVerify(t.insecure),
+	var (
+		c     api.RelayMessage
+		errs  error
+		found bool
 	)
-	if err != nil {
-		t.log.Errorf("recv: node %s new client: %s", nodename, err)
-		return
-	}
-
-	params := api.GetRelayMessageParams{
-		Nodename:  nodename,
-		ClusterID: clusterID,
-	}
-	resp, err := cli.GetRelayMessageWithResponse(context.Background(), &params)
-	if err != nil {
-		t.log.Debugf("recv: node %s do request: %s", nodename, err)
-		return
-	} else if resp.StatusCode() != http.StatusOK {
-		t.log.Debugf("unexpected get relay message %s status %s", nodename, resp.Status())
-		return
-	}
-	if resp.JSON200 == nil {
-		t.log.Debugf("recv: node %s data has no stored data", nodename)
-		return
+	for i := range t.relays {
+		index := (t.current + i) % len(t.relays)
+		relay := t.relays[index]
+		var err error
+		c, err = t.recvFrom(relay, nodename)
+		if err != nil {
+			t.log.Debugf("recv: node %s: %s: %s", nodename, relay, err)
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", relay, err))
+			continue
+		}
+		if c.UpdatedAt.IsZero() {
+			t.log.Debugf("recv: node %s data has never been updated on %s", nodename, relay)
+			continue
+		}
+		if elapsed := time.Now().Sub(c.UpdatedAt); elapsed > t.timeout {
+			t.log.Debugf("recv: node %s data has not been updated for %s on %s", nodename, elapsed, relay)
+			continue
+		}
+		if index != t.current {
+			t.log.Infof("recv: node %s: fail over from relay %s to %s", nodename, t.relays[t.current], relay)
+			t.current = index
+		}
+		found = true
+		break
 	}
-	c := *resp.JSON200
-	if c.UpdatedAt.IsZero() {
-		t.log.Debugf("recv: node %s data has never been updated", nodename)
+	if !found {
+		if errs != nil {
+			t.setPeerError(nodename, errs)
+		}
 		return
 	}
-	if !t.lastAt.IsZero() && c.UpdatedAt == t.lastAt {
+	if last, ok := t.last[nodename]; ok && c.UpdatedAt.Equal(last) {
 		t.log.Debugf("recv: node %s data has not change since last read", nodename)
 		return
 	}
 	elapsed := time.Now().Sub(c.UpdatedAt)
-	if elapsed > t.timeout {
-		t.log.Debugf("recv: node %s data has not been updated for %s", nodename, elapsed)
-		return
-	}
-	b, msgNodename, err := t.encryptDecrypter.DecryptWithNode([]byte(c.Msg))
+	b, msgNodename, err := t.newEncryptDecrypter().DecryptWithNode([]byte(c.Msg))
 	if err != nil {
 		t.log.Debugf("recv: decrypting node %s: %s", nodename, err)
+		t.setPeerError(nodename, fmt.Errorf("decrypt: %w", err))
 		return
 	}
 
@@ -171,22 +175,67 @@ func (t *rx) recv(nodename string) {
 		return
 	}
 	t.log.Debugf("recv: node %s", nodename)
+	t.cmdC <- hbctrl.CmdAddBytes{HbID: t.id, Count: len(c.Msg)}
 	t.cmdC <- hbctrl.CmdSetPeerSuccess{
 		Nodename: msg.Nodename,
 		HbID:     t.id,
 		Success:  true,
+		Delay:    max(elapsed, 0),
 	}
 	t.msgC <- &msg
-	t.lastAt = c.UpdatedAt
+	t.last[nodename] = c.UpdatedAt
+}
+
+// recvFrom returns the <nodename> message stored on <relay>. A relay without
+// message for <nodename> returns a zero message.
+func (t *rx) recvFrom(relay, nodename string) (api.RelayMessage, error) {
+	cli, err := client.New(
+		client.WithURL(relay),
+		client.WithUsername(t.username),
+		client.WithPassword(t.password),
+		client.WithInsecureSkipVerify(t.insecure),
+	)
+	if err != nil {
+		return api.RelayMessage{}, fmt.Errorf("new client: %w", err)
+	}
+
+	params := api.GetRelayMessageParams{
+		Nodename:  nodename,
+		ClusterID: cluster.ConfigData.Get().ID,
+	}
+	resp, err := cli.GetRelayMessageWithResponse(context.Background(), &params)
+	if err != nil {
+		return api.RelayMessage{}, fmt.Errorf("get relay message: %w", err)
+	}
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return api.RelayMessage{}, nil
+	case resp.StatusCode() != http.StatusOK:
+		return api.RelayMessage{}, fmt.Errorf("get relay message: unexpected status %s", resp.Status())
+	case resp.JSON200 == nil:
+		return api.RelayMessage{}, nil
+	}
+	return *resp.JSON200, nil
+}
+
+// setPeerError reports the <err> receive failure to the <nodename> peer
+// watcher.
+func (t *rx) setPeerError(nodename string, err error) {
+	t.cmdC <- hbctrl.CmdSetPeerError{
+		Nodename: nodename,
+		HbID:     t.id,
+		Err:      err,
+	}
 }
 
-func newRx(ctx context.Context, name string, nodes []string, relay, username, password string, insecure bool, timeout, interval time.Duration) *rx {
+func newRx(ctx context.Context, name string, nodes []string, relays []string, username, password string, insecure bool, timeout, interval time.Duration) *rx {
 	id := name + ".rx"
 	return &rx{
 		ctx:      ctx,
 		id:       id,
 		nodes:    nodes,
-		relay:    relay,
+		relays:   relays,
+		last:     make(map[string]time.Time),
 		username: username,
 		password: password,
 		insecure: insecure,
@@ -199,3 +248,15 @@ func newRx(ctx context.Context, name string, nodes []string, relay, username, pa
 			WithPrefix("daemon: hb: relay: rx: " + name + ": "),
 	}
 }
+
+// newEncryptDecrypter returns a message decrypter using the cluster secrets
+// currently loaded.
+func (t *rx) newEncryptDecrypter() *omcrypto.Factory {
+	clusterConfig := cluster.ConfigData.Get()
+	return &omcrypto.Factory{
+		NodeName:    hostname.Hostname(),
+		ClusterName: clusterConfig.Name,
+		Key:         clusterConfig.Secret(),
+		AltKeys:     clusterConfig.AltSecrets(),
+	}
+}
@@ -2,6 +2,8 @@ package hbrelay
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"sync"
 	"time"
@@ -22,13 +24,24 @@ type (
 		ctx      context.Context
 		id       string
 		nodes    []string
-		relay    string
 		username string
 		password string
 		insecure bool
 		timeout  time.Duration
 		interval time.Duration
 
+		// relays is the list of relays to send to, and current is the
+		// index of the relay used by the last successful send.
+		relays  []string
+		current int
+
+		// seq is the sequence number of the last sent message. The relays
+		// replicating the messages keep the highest sequence number. It
+		// starts at the tx creation time in nanoseconds, so the messages
+		// sent after a daemon restart are still ordered after the previous
+		// ones.
+		seq int64
+
 		name   string
 		log    *plog.Logger
 		cmdC   chan<- interface{}
@@ -73,6 +86,7 @@ func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
 				Nodename: node,
 				Ctx:      ctx,
 				Timeout:  t.timeout,
+				Interval: t.interval,
 			}
 		}
 		var b []byte
@@ -100,16 +114,57 @@ func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
 	return nil
 }
 
+// send posts <b> to the current relay, failing over to the next relays of
+// the list on error.
 func (t *tx) send(b []byte) {
+	var (
+		delay time.Duration
+		errs  error
+		ok    bool
+	)
+	t.seq++
+	for i := range t.relays {
+		index := (t.current + i) % len(t.relays)
+		relay := t.relays[index]
+		begin := time.Now()
+		if err := t.sendTo(relay, b); err != nil {
+			t.log.Debugf("send: %s: %s", relay, err)
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", relay, err))
+			continue
+		}
+		delay = time.Since(begin)
+		if index != t.current {
+			t.log.Infof("send: fail over from relay %s to %s", t.relays[t.current], relay)
+			t.current = index
+		}
+		ok = true
+		break
+	}
+	if !ok {
+		t.setPeersError(errs)
+		return
+	}
+	t.cmdC <- hbctrl.CmdAddBytes{HbID: t.id, Count: len(b)}
+
+	for _, node := range t.nodes {
+		t.cmdC <- hbctrl.CmdSetPeerSuccess{
+			Nodename: node,
+			HbID:     t.id,
+			Success:  true,
+			Delay:    delay,
+		}
+	}
+}
+
+func (t *tx) sendTo(relay string, b []byte) error {
 	cli, err := client.New(
-		client.WithURL(t.relay),
+		client.WithURL(relay),
 		client.WithUsername(t.username),
 		client.WithPassword(t.password),
 		client.WithInsecureSkipVerify(t.insecure),
 	)
 	if err != nil {
-		t.log.Debugf("send: new client: %s", err)
-		return
+		return fmt.Errorf("new client: %w", err)
 	}
 
 	clusterConfig := cluster.ConfigData.Get()
@@ -118,37 +173,43 @@ func (t *tx) send(b []byte) {
 		ClusterID:   clusterConfig.ID,
 		ClusterName: clusterConfig.Name,
 		Msg:         string(b),
+		Seq:         &t.seq,
 	}
 	resp, err := cli.PostRelayMessage(context.Background(), params)
 	if err != nil {
-		t.log.Debugf("send: PostRelayMessage: %s", err)
-		return
-	} else if resp.StatusCode != http.StatusOK {
-		t.log.Debugf("send: unexpected PostRelayMessage status: %s", resp.Status)
-		return
+		return fmt.Errorf("post relay message: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("post relay message: unexpected status %s", resp.Status)
 	}
+	return nil
+}
 
+// setPeersError reports the <err> send failure to the peer watchers.
+func (t *tx) setPeersError(err error) {
 	for _, node := range t.nodes {
-		t.cmdC <- hbctrl.CmdSetPeerSuccess{
+		t.cmdC <- hbctrl.CmdSetPeerError{
 			Nodename: node,
 			HbID:     t.id,
-			Success:  true,
+			Err:      err,
 		}
 	}
 }
 
-func newTx(ctx context.Context, name string, nodes []string, relay, username, password string, insecure bool, timeout, interval time.Duration) *tx {
+func newTx(ctx context.Context, name string, nodes []string, relays []string, username, password string, insecure bool, timeout, interval time.Duration) *tx {
 	id := name + ".tx"
 	return &tx{
 		ctx:      ctx,
 		id:       id,
 		nodes:    nodes,
-		relay:    relay,
+		relays:   relays,
 		username: username,
 		password: password,
 		insecure: insecure,
 		timeout:  timeout,
 		interval: interval,
+		seq:      time.Now().UnixNano(),
 		log: plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbrelay").
 			Attr("hb_func", "tx").
 			Attr("hb_name", name).
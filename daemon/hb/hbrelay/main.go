@@ -55,8 +55,8 @@ func (t *T) Configure(ctx context.Context) {
 		timeout = interval*2 + 1*time.Second
 		log.Warnf("reajust timeout: %s => %s (<interval>*2+1s)", oldTimeout, timeout)
 	}
-	relay := t.GetString("relay")
-	if relay == "" {
+	relays := t.GetStrings("relay")
+	if len(relays) == 0 {
 		log.Errorf("no %s.relay is not set in node.conf", t.Name())
 		return
 	}
@@ -73,15 +73,15 @@ func (t *T) Configure(ctx context.Context) {
 		nodes = t.Config().GetStrings(k)
 	}
 	oNodes := hostname.OtherNodes(nodes)
-	log.Debugf("timeout=%s interval=%s relay=%s insecure=%t nodes=%s onodes=%s", timeout, interval, relay, insecure, nodes, oNodes)
+	log.Debugf("timeout=%s interval=%s relay=%s insecure=%t nodes=%s onodes=%s", timeout, interval, relays, insecure, nodes, oNodes)
 	t.SetNodes(oNodes)
 	t.SetTimeout(timeout)
-	signature := fmt.Sprintf("type: hb.relay nodes: %s relay: %s timeout: %s interval: %s", nodes, relay, timeout, interval)
+	signature := fmt.Sprintf("type: hb.relay nodes: %s relay: %s timeout: %s interval: %s", nodes, relays, timeout, interval)
 	t.SetSignature(signature)
 	name := t.Name()
-	tx := newTx(ctx, name, oNodes, relay, username, password, insecure, timeout, interval)
+	tx := newTx(ctx, name, oNodes, relays, username, password, insecure, timeout, interval)
 	t.SetTx(tx)
-	rx := newRx(ctx, name, oNodes, relay, username, password, insecure, timeout, interval)
+	rx := newRx(ctx, name, oNodes, relays, username, password, insecure, timeout, interval)
 	t.SetRx(rx)
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net"
 	"strings"
 	"sync"
@@ -22,13 +23,14 @@ type (
 	// rx holds a hb unicast receiver
 	rx struct {
 		sync.WaitGroup
-		ctx     context.Context
-		id      string
-		nodes   map[string]string
-		addr    string
-		port    string
-		intf    string
-		timeout time.Duration
+		ctx      context.Context
+		id       string
+		nodes    map[string]string
+		addr     string
+		port     string
+		intf     string
+		timeout  time.Duration
+		interval time.Duration
 
 		name   string
 		log    *plog.Logger
@@ -94,7 +96,8 @@ func (t *rx) Start(cmdC chan<- interface{}, msgC chan<- *hbtype.Msg) error {
 	t.Add(1)
 	go func() {
 		defer t.Done()
-		otherNodeIPM := make(map[string]struct{})
+		// otherNodeIPM is the nodename of the other nodes addresses
+		otherNodeIPM := make(map[string]string)
 		otherNodeIPL := make([]string, 0)
 		resolver := net.Resolver{}
 
@@ -104,13 +107,14 @@ func (t *rx) Start(cmdC chan<- interface{}, msgC chan<- *hbtype.Msg) error {
 				Nodename: node,
 				Ctx:      ctx,
 				Timeout:  t.timeout,
+				Interval: t.interval,
 			}
 			addrs, err := resolver.LookupHost(ctx, addr)
 			if err != nil {
 				continue
 			}
 			for _, addr := range addrs {
-				otherNodeIPM[addr] = struct{}{}
+				otherNodeIPM[addr] = node
 				otherNodeIPL = append(otherNodeIPL, addr)
 			}
 		}
@@ -135,11 +139,13 @@ func (t *rx) Start(cmdC chan<- interface{}, msgC chan<- *hbtype.Msg) error {
 					break
 				} else {
 					t.log.Errorf("listener accept: %s", err)
+					t.setPeersError(fmt.Errorf("accept: %w", err))
 					continue
 				}
 			}
 			connAddr := strings.Split(conn.RemoteAddr().String(), ":")[0]
-			if _, ok := otherNodeIPM[connAddr]; !ok {
+			nodename, ok := otherNodeIPM[connAddr]
+			if !ok {
 				t.log.Warnf("drop message from unexpected connection from %s", connAddr)
 				if err := conn.Close(); err != nil {
 					t.log.Warnf("close unexpected connection from %s: %s", connAddr, err)
@@ -148,6 +154,7 @@ func (t *rx) Start(cmdC chan<- interface{}, msgC chan<- *hbtype.Msg) error {
 			}
 			if err := conn.SetDeadline(time.Now().Add(messageTimeout)); err != nil {
 				t.log.Infof("can't set read deadline for %s: %s", connAddr, err)
+				t.setPeerError(nodename, fmt.Errorf("set read deadline: %w", err))
 				continue
 			}
 			clusterConfig := cluster.ConfigData.Get()
@@ -155,9 +162,10 @@ func (t *rx) Start(cmdC chan<- interface{}, msgC chan<- *hbtype.Msg) error {
 				NodeName:    hostname.Hostname(),
 				ClusterName: clusterConfig.Name,
 				Key:         clusterConfig.Secret(),
+				AltKeys:     clusterConfig.AltSecrets(),
 			})
 			t.Add(1)
-			go t.handle(clearConn)
+			go t.handle(clearConn, nodename)
 		}
 		t.log.Infof("stopped %s", t.addr)
 	}()
@@ -166,7 +174,9 @@ func (t *rx) Start(cmdC chan<- interface{}, msgC chan<- *hbtype.Msg) error {
 	return nil
 }
 
-func (t *rx) handle(conn encryptconn.ConnNoder) {
+// handle reads the message of the connection from the node <addrNode>, the
+// node owning the connection source address.
+func (t *rx) handle(conn encryptconn.ConnNoder, addrNode string) {
 	defer t.Done()
 	defer func() {
 		if err := conn.Close(); err != nil {
@@ -178,6 +188,7 @@ func (t *rx) handle(conn encryptconn.ConnNoder) {
 	i, nodename, err := conn.ReadWithNode(data)
 	if err != nil {
 		t.log.Warnf("read failed from %s: %s", conn.RemoteAddr(), err)
+		t.setPeerError(addrNode, fmt.Errorf("read: %w", err))
 		return
 	}
 	if i >= (msgMaxSize - 10000) {
@@ -186,8 +197,10 @@ func (t *rx) handle(conn encryptconn.ConnNoder) {
 	msg := hbtype.Msg{}
 	if err := json.Unmarshal(data[:i], &msg); err != nil {
 		t.log.Warnf("unmarshal message failed from node %s:%s: %s", nodename, conn.RemoteAddr(), err)
+		t.setPeerError(addrNode, fmt.Errorf("unmarshal: %w", err))
 		return
 	}
+	t.cmdC <- hbctrl.CmdAddBytes{HbID: t.id, Count: i}
 	t.cmdC <- hbctrl.CmdSetPeerSuccess{
 		Nodename: msg.Nodename,
 		HbID:     t.id,
@@ -196,16 +209,35 @@ func (t *rx) handle(conn encryptconn.ConnNoder) {
 	t.msgC <- &msg
 }
 
-func newRx(ctx context.Context, name string, nodes map[string]string, addr, port, intf string, timeout time.Duration) *rx {
+// setPeerError reports the <err> receive failure to the <nodename> peer
+// watcher.
+func (t *rx) setPeerError(nodename string, err error) {
+	t.cmdC <- hbctrl.CmdSetPeerError{
+		Nodename: nodename,
+		HbID:     t.id,
+		Err:      err,
+	}
+}
+
+// setPeersError reports the <err> receive failure to all the peer watchers,
+// for the failures not related to a single peer.
+func (t *rx) setPeersError(err error) {
+	for nodename := range t.nodes {
+		t.setPeerError(nodename, err)
+	}
+}
+
+func newRx(ctx context.Context, name string, nodes map[string]string, addr, port, intf string, timeout, interval time.Duration) *rx {
 	id := name + ".rx"
 	return &rx{
-		ctx:     ctx,
-		id:      id,
-		nodes:   nodes,
-		addr:    addr,
-		port:    port,
-		intf:    intf,
-		timeout: timeout,
+		ctx:      ctx,
+		id:       id,
+		nodes:    nodes,
+		addr:     addr,
+		port:     port,
+		intf:     intf,
+		timeout:  timeout,
+		interval: interval,
 		log: plog.NewDefaultLogger().Attr("pkg", "daemon/hb/hbucast").
 			Attr("hb_func", "rx").
 			Attr("hb_name", name).
@@ -2,6 +2,7 @@ package hbucast
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"sync"
 	"time"
@@ -67,6 +68,7 @@ func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
 				Nodename: node,
 				Ctx:      ctx,
 				Timeout:  t.timeout,
+				Interval: t.interval,
 			}
 		}
 		started <- true
@@ -101,9 +103,11 @@ func (t *tx) Start(cmdC chan<- interface{}, msgC <-chan []byte) error {
 }
 
 func (t *tx) send(node, addr string, b []byte) {
+	begin := time.Now()
 	conn, err := net.DialTimeout("tcp", addr+":"+t.port, t.timeout)
 	if err != nil {
 		t.log.Debugf("dial timeout %s %s:%s: %s", node, addr, t.port, err)
+		t.setPeerError(node, fmt.Errorf("dial %s:%s: %w", addr, t.port, err))
 		return
 	}
 	defer func() {
@@ -115,15 +119,28 @@ func (t *tx) send(node, addr string, b []byte) {
 	}
 	if n, err := conn.Write(b); err != nil {
 		t.log.Debugf("write %s %s: %s", node, addr, err)
+		t.setPeerError(node, fmt.Errorf("write %s: %w", addr, err))
 		return
 	} else if n != len(b) {
 		t.log.Debugf("write %s %s: %d instead of %d", node, addr, n, len(b))
+		t.setPeerError(node, fmt.Errorf("write %s: %d instead of %d", addr, n, len(b)))
 		return
 	}
+	t.cmdC <- hbctrl.CmdAddBytes{HbID: t.id, Count: len(b)}
 	t.cmdC <- hbctrl.CmdSetPeerSuccess{
 		Nodename: node,
 		HbID:     t.id,
 		Success:  true,
+		Delay:    time.Since(begin),
+	}
+}
+
+// setPeerError reports the <err> send failure to the <node> peer watcher.
+func (t *tx) setPeerError(node string, err error) {
+	t.cmdC <- hbctrl.CmdSetPeerError{
+		Nodename: node,
+		HbID:     t.id,
+		Err:      err,
 	}
 }
 
@@ -87,6 +87,6 @@ func (t *T) Configure(ctx context.Context) {
 	name := t.Name()
 	tx := newTx(ctx, name, peerMap, port, intf, timeout, interval)
 	t.SetTx(tx)
-	rx := newRx(ctx, name, peerMap, addr, port, intf, timeout)
+	rx := newRx(ctx, name, peerMap, addr, port, intf, timeout, interval)
 	t.SetRx(rx)
 }
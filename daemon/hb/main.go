@@ -20,6 +20,7 @@ import (
 	"github.com/opensvc/om3/daemon/daemondata"
 	"github.com/opensvc/om3/daemon/daemonenv"
 	"github.com/opensvc/om3/daemon/hb/hbctrl"
+	"github.com/opensvc/om3/daemon/hb/hbfault"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/funcopt"
 	"github.com/opensvc/om3/util/hostname"
@@ -44,6 +45,15 @@ type (
 
 		ridSignature map[string]string
 
+		// faults is the registry of the fault injections applied by the
+		// interposers between the hb drivers and the hb component.
+		faults *hbfault.Registry
+
+		// faultCancel is the cancel function of the fault interposer of
+		// the running hb drivers, indexed by hb driver id.
+		faultCancel map[string]context.CancelFunc
+
+		bus *pubsub.Bus
 		sub *pubsub.Subscription
 
 		// ctx is the main context for controller, and started hb drivers
@@ -72,6 +82,8 @@ func New(_ context.Context, opts ...funcopt.O) *T {
 	t.rxs = make(map[string]hbtype.Receiver)
 	t.readMsgQueue = make(chan *hbtype.Msg)
 	t.ridSignature = make(map[string]string)
+	t.faults = hbfault.NewRegistry()
+	t.faultCancel = make(map[string]context.CancelFunc)
 	return t
 }
 
@@ -97,6 +109,8 @@ func (t *T) Start(ctx context.Context) error {
 
 	// t.ctx will be used to start hb drivers
 	t.ctx = ctx
+	t.bus = pubsub.BusFromContext(ctx)
+	t.faults.OnClear = t.onFaultCleared
 
 	// create cancelable context to cancel other routines
 	ctx, cancel := context.WithCancel(ctx)
@@ -143,6 +157,7 @@ func (t *T) Stop() error {
 	}
 
 	t.wg.Wait()
+	t.faults.Stop()
 
 	// We can now stop the controller
 	if err := t.ctrl.Stop(); err != nil {
@@ -163,6 +178,7 @@ func (t *T) stopHb(hb hbtype.IDStopper) error {
 		}
 	}
 	t.ctrlC <- hbctrl.CmdUnregister{ID: hbID}
+	defer t.stopFaultInterposer(hbID)
 	return hb.Stop()
 }
 
@@ -191,11 +207,19 @@ func (t *T) startHbTx(hb hbcfg.Confer) error {
 	msgToSendQ := make(chan []byte)
 	go debounceLatestMsgToTx(t.msgToTxCtx, msgToSendQ, debouncedMsgQ)
 
-	if err := tx.Start(t.ctrlC, debouncedMsgQ); err != nil {
+	// start the fault interposer between the debounced messages and the
+	// hb tx driver.
+	dataQ := make(chan []byte)
+	faultCtx := t.startFaultInterposer(tx.ID())
+	go t.faults.Tx(faultCtx, tx.ID(), debouncedMsgQ, dataQ)
+
+	if err := tx.Start(t.ctrlC, dataQ); err != nil {
 		t.log.Errorf("start %s failed: %s", tx.ID(), err)
+		t.stopFaultInterposer(tx.ID())
 		t.ctrlC <- hbctrl.CmdSetState{ID: tx.ID(), State: "failed"}
 		return err
 	}
+	t.ctrlC <- hbctrl.CmdSetFaults{HbID: tx.ID(), Faults: t.faults.List(tx.ID())}
 	select {
 	case <-t.msgToTxCtx.Done():
 		// don't hang up when context is done
@@ -211,11 +235,21 @@ func (t *T) startHbRx(hb hbcfg.Confer) error {
 		return fmt.Errorf("nil rx for %s", hb.Name())
 	}
 	t.ctrlC <- hbctrl.CmdRegister{ID: rx.ID(), Type: hb.Type()}
-	if err := rx.Start(t.ctrlC, t.readMsgQueue); err != nil {
+
+	// start the fault interposer between the hb rx driver and the hb
+	// controller and message queues.
+	cmdC := make(chan any)
+	msgC := make(chan *hbtype.Msg)
+	faultCtx := t.startFaultInterposer(rx.ID())
+	go t.faults.Rx(faultCtx, rx.ID(), cmdC, t.ctrlC, msgC, t.readMsgQueue)
+
+	if err := rx.Start(cmdC, msgC); err != nil {
+		t.stopFaultInterposer(rx.ID())
 		t.ctrlC <- hbctrl.CmdSetState{ID: rx.ID(), State: "failed"}
 		t.log.Errorf("start %s failed: %s", rx.ID(), err)
 		return err
 	}
+	t.ctrlC <- hbctrl.CmdSetFaults{HbID: rx.ID(), Faults: t.faults.List(rx.ID())}
 	t.rxs[hb.Name()] = rx
 	return nil
 }
@@ -365,6 +399,7 @@ func (t *T) msgToTx(ctx context.Context) error {
 					NodeName:    hostname.Hostname(),
 					ClusterName: clusterConfig.Name,
 					Key:         clusterConfig.Secret(),
+					AltKeys:     clusterConfig.AltSecrets(),
 				}
 				b, err := json.Marshal(msg)
 				if err != nil {
@@ -452,6 +487,7 @@ func (t *T) startSubscriptions(ctx context.Context) {
 	t.sub = bus.Sub("daemon.hb")
 	t.sub.AddFilter(&msgbus.InstanceConfigUpdated{}, pubsub.Label{"path", naming.Cluster.String()})
 	t.sub.AddFilter(&msgbus.DaemonCtl{})
+	t.sub.AddFilter(&msgbus.HbFaultCtl{})
 	t.sub.Start()
 }
 
@@ -503,6 +539,8 @@ func (t *T) janitor(ctx context.Context) {
 					case "start":
 						t.daemonCtlStart(t.ctx, hbID, action)
 					}
+				case *msgbus.HbFaultCtl:
+					t.onHbFaultCtl(msg)
 				}
 			}
 		}
@@ -566,7 +604,9 @@ func (t *T) daemonCtlStop(hbID string, action string) {
 		case t.msgToTxUnregister <- hbID:
 		}
 	}
-	if err := hbI.(hbtype.IDStopper).Stop(); err != nil {
+	err := hbI.(hbtype.IDStopper).Stop()
+	t.stopFaultInterposer(hbID)
+	if err != nil {
 		t.log.Errorf("daemonctl %s %s stop failed: %s", action, hbID, err)
 	} else {
 		t.ctrlC <- hbctrl.CmdSetState{ID: hbI.(hbtype.IDStopper).ID(), State: "stopped"}
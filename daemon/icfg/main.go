@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/opensvc/om3/core/capacity"
 	"github.com/opensvc/om3/core/clusternode"
 	"github.com/opensvc/om3/core/instance"
 	"github.com/opensvc/om3/core/naming"
@@ -70,22 +71,33 @@ var (
 
 	errConfigFileCheck = errors.New("config file check")
 
-	keyApp              = key.New("DEFAULT", "app")
-	keyChildren         = key.New("DEFAULT", "children")
-	keyEnv              = key.New("DEFAULT", "env")
-	keyFlexMax          = key.New("DEFAULT", "flex_max")
-	keyFlexMin          = key.New("DEFAULT", "flex_min")
-	keyFlexTarget       = key.New("DEFAULT", "flex_target")
-	keyMonitorAction    = key.New("DEFAULT", "monitor_action")
-	keyNodes            = key.New("DEFAULT", "nodes")
-	keyOrchestrate      = key.New("DEFAULT", "orchestrate")
-	keyParents          = key.New("DEFAULT", "parents")
-	keyPool             = key.New("DEFAULT", "pool")
-	keyPlacement        = key.New("DEFAULT", "placement")
-	keyPreMonitorAction = key.New("DEFAULT", "pre_monitor_action")
-	keyPriority         = key.New("DEFAULT", "priority")
-	keySize             = key.New("DEFAULT", "size")
-	keyTopology         = key.New("DEFAULT", "topology")
+	keyApp                    = key.New("DEFAULT", "app")
+	keyChildren               = key.New("DEFAULT", "children")
+	keyCPURequest             = key.New("DEFAULT", "cpu_request")
+	keyEnv                    = key.New("DEFAULT", "env")
+	keyFlexMax                = key.New("DEFAULT", "flex_max")
+	keyFlexMin                = key.New("DEFAULT", "flex_min")
+	keyFlexTarget             = key.New("DEFAULT", "flex_target")
+	keyHardAffinity           = key.New("DEFAULT", "hard_affinity")
+	keyHardAntiAffinity       = key.New("DEFAULT", "hard_anti_affinity")
+	keyLabelRequest           = key.New("DEFAULT", "label_request")
+	keyMaintenanceWindow      = key.New("DEFAULT", "maintenance_window")
+	keyMaintenanceWindowAllow = key.New("DEFAULT", "maintenance_window_allow")
+	keyMemRequest             = key.New("DEFAULT", "mem_request")
+	keyMonitorAction          = key.New("DEFAULT", "monitor_action")
+	keyNodes                  = key.New("DEFAULT", "nodes")
+	keyOrchestrate            = key.New("DEFAULT", "orchestrate")
+	keyParents                = key.New("DEFAULT", "parents")
+	keyPool                   = key.New("DEFAULT", "pool")
+	keyPlacement              = key.New("DEFAULT", "placement")
+	keyPreMonitorAction       = key.New("DEFAULT", "pre_monitor_action")
+	keyPriority               = key.New("DEFAULT", "priority")
+	keyScale                  = key.New("DEFAULT", "scale")
+	keySize                   = key.New("DEFAULT", "size")
+	keySoftAffinity           = key.New("DEFAULT", "soft_affinity")
+	keySoftAntiAffinity       = key.New("DEFAULT", "soft_anti_affinity")
+	keyStonith                = key.New("DEFAULT", "stonith")
+	keyTopology               = key.New("DEFAULT", "topology")
 )
 
 // Start launch goroutine instConfig worker for a local instance config
@@ -309,22 +321,31 @@ func (t *Manager) configFileCheck() error {
 	cfg.Checksum = fmt.Sprintf("%x", checksum)
 	cfg.Children = t.getChildren(cf)
 	cfg.Env = cf.GetString(keyEnv)
+	cfg.HardAffinity = cf.GetStrings(keyHardAffinity)
+	cfg.HardAntiAffinity = cf.GetStrings(keyHardAntiAffinity)
+	cfg.MaintenanceWindow = cf.GetString(keyMaintenanceWindow)
+	cfg.MaintenanceWindowAllow = cf.GetStrings(keyMaintenanceWindowAllow)
 	cfg.MonitorAction = t.getMonitorAction(cf)
 	cfg.Orchestrate = t.getOrchestrate(cf)
 	cfg.Parents = t.getParents(cf)
 	cfg.PlacementPolicy = t.getPlacementPolicy(cf)
 	cfg.PreMonitorAction = cf.GetString(keyPreMonitorAction)
 	cfg.Priority = t.getPriority(cf)
+	cfg.Requests = t.getRequests(cf)
 	cfg.Resources = t.getResources(cf)
 	cfg.Scope = scope
 	cfg.Topology = t.getTopology(cf)
 	cfg.UpdatedAt = mtime
 	cfg.Size = cf.GetSize(keySize)
+	cfg.SoftAffinity = cf.GetStrings(keySoftAffinity)
+	cfg.SoftAntiAffinity = cf.GetStrings(keySoftAntiAffinity)
+	cfg.Stonith = cf.GetBool(keyStonith)
 	cfg.Subsets = t.getSubsets(cf)
 
 	if pool := cf.GetString(keyPool); pool != "" {
 		cfg.Pool = &pool
 	}
+	cfg.Scale = t.getScale(cf)
 	if cfg.Topology == topology.Flex {
 		instanceCount := len(scope)
 		cfg.FlexMin = t.getFlexMin(cf, instanceCount)
@@ -416,16 +437,46 @@ func (t *Manager) getResources(cf *xconfig.T) instance.ResourceConfigs {
 			continue
 		}
 		m[section] = instance.ResourceConfig{
-			RestartDelay: cf.GetDuration(key.New(section, "restart_delay")),
-			Restart:      cf.GetInt(key.New(section, "restart")),
-			IsDisabled:   cf.GetBool(key.New(section, "disable")),
-			IsMonitored:  cf.GetBool(key.New(section, "monitor")),
-			IsStandby:    cf.GetBool(key.New(section, "standby")),
+			RestartDelay:    cf.GetDuration(key.New(section, "restart_delay")),
+			RestartDelayMax: cf.GetDuration(key.New(section, "restart_delay_max")),
+			RestartWindow:   cf.GetDuration(key.New(section, "restart_window")),
+			Restart:         cf.GetInt(key.New(section, "restart")),
+			IsDisabled:      cf.GetBool(key.New(section, "disable")),
+			IsMonitored:     cf.GetBool(key.New(section, "monitor")),
+			IsStandby:       cf.GetBool(key.New(section, "standby")),
 		}
 	}
 	return m
 }
 
+// getRequests returns the amount of node resources an instance needs to run.
+func (t *Manager) getRequests(cf *xconfig.T) capacity.T {
+	var requests capacity.T
+	if t.path.Kind != naming.KindSvc {
+		return requests
+	}
+	if cf.HasKey(keyMemRequest) {
+		if i, err := cf.GetSizeStrict(keyMemRequest); err != nil {
+			t.log.Warnf("get mem_request value: %s", err)
+		} else if i != nil {
+			requests.Mem = *i
+		}
+	}
+	if cf.HasKey(keyCPURequest) {
+		if f, err := cf.GetFloat64Strict(keyCPURequest); err != nil {
+			t.log.Warnf("get cpu_request value: %s", err)
+		} else {
+			requests.CPU = f
+		}
+	}
+	if m, err := capacity.ParseLabels(cf.GetStrings(keyLabelRequest)); err != nil {
+		t.log.Warnf("get label_request value: %s", err)
+	} else {
+		requests.Labels = m
+	}
+	return requests
+}
+
 func (t *Manager) getPriority(cf *xconfig.T) priority.T {
 	s := cf.GetInt(keyPriority)
 	return priority.T(s)
@@ -459,6 +510,24 @@ func (t *Manager) getFlexMin(cf *xconfig.T, maxInstanceCount int) int {
 	return i
 }
 
+// getScale returns the scale value of a scaler object, or nil if the object
+// is not a scaler.
+func (t *Manager) getScale(cf *xconfig.T) *int {
+	if t.path.Kind != naming.KindSvc || !cf.HasKey(keyScale) {
+		return nil
+	}
+	i, err := cf.GetIntStrict(keyScale)
+	if err != nil {
+		t.log.Warnf("get scale value: %s", err)
+		return nil
+	}
+	if i < 0 {
+		t.log.Warnf("increase scale value %d to 0", i)
+		i = 0
+	}
+	return &i
+}
+
 func (t *Manager) getFlexMax(cf *xconfig.T, minInstanceCount, maxInstanceCount int) int {
 	switch t.path.Kind {
 	case naming.KindSvc, naming.KindVol:
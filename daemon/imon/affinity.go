@@ -0,0 +1,172 @@
+package imon
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+// affinityPaths returns the deduplicated list of object paths referenced by
+// the hard and soft affinity and anti-affinity keywords.
+func (t *Manager) affinityPaths() []string {
+	var l []string
+	for _, refs := range [][]string{
+		t.instConfig.HardAffinity,
+		t.instConfig.HardAntiAffinity,
+		t.instConfig.SoftAffinity,
+		t.instConfig.SoftAntiAffinity,
+	} {
+		for _, s := range refs {
+			if !slices.Contains(l, s) {
+				l = append(l, s)
+			}
+		}
+	}
+	return l
+}
+
+// janitorAffinities subscribes to the instance status updates and deletes of
+// the objects referenced by the affinity keywords, and unsubscribes from the
+// objects no longer referenced.
+func (t *Manager) janitorAffinities(previous []string) {
+	current := t.affinityPaths()
+	for _, s := range current {
+		if slices.Contains(previous, s) {
+			continue
+		}
+		p, err := naming.ParsePathRel(s, t.path.Namespace)
+		if err != nil {
+			t.log.Warnf("janitor affinities: parse path %s: %s", s, err)
+			continue
+		}
+		t.log.Infof("janitor affinities subscribe to %s instance avail status updates and deletes", p)
+		t.sub.AddFilter(&msgbus.InstanceStatusUpdated{}, pubsub.Label{"path", p.String()})
+		t.sub.AddFilter(&msgbus.InstanceStatusDeleted{}, pubsub.Label{"path", p.String()})
+	}
+	for _, s := range previous {
+		if slices.Contains(current, s) {
+			continue
+		}
+		p, err := naming.ParsePathRel(s, t.path.Namespace)
+		if err != nil {
+			continue
+		}
+		t.log.Infof("janitor affinities unsubscribe from %s instance avail status updates and deletes", p)
+		t.sub.DelFilter(&msgbus.InstanceStatusUpdated{}, pubsub.Label{"path", p.String()})
+		t.sub.DelFilter(&msgbus.InstanceStatusDeleted{}, pubsub.Label{"path", p.String()})
+	}
+}
+
+// onAffinityInstanceStatusChange asks for a new orchestration when an
+// instance of an object referenced by the affinity keywords changes.
+func (t *Manager) onAffinityInstanceStatusChange(p naming.Path) {
+	if p == t.path {
+		return
+	}
+	for _, s := range t.affinityPaths() {
+		if affinityPath, err := naming.ParsePathRel(s, t.path.Namespace); err == nil && affinityPath == p {
+			t.onChange()
+			return
+		}
+	}
+}
+
+// affinityAvail returns the avail status of the s object instance on node.
+// status.Undef is returned when the instance status is not known.
+func (t *Manager) affinityAvail(s, node string) status.T {
+	p, err := naming.ParsePathRel(s, t.path.Namespace)
+	if err != nil {
+		return status.Undef
+	}
+	if instStatus := instance.StatusData.Get(p, node); instStatus != nil {
+		return instStatus.Avail
+	}
+	return status.Undef
+}
+
+// isAffinitySatisfied returns true if the s object instance on node is up.
+func (t *Manager) isAffinitySatisfied(s, node string) bool {
+	return t.affinityAvail(s, node).Is(status.Up, status.NotApplicable)
+}
+
+// isAntiAffinitySatisfied returns true if the s object instance on node is
+// not up.
+func (t *Manager) isAntiAffinitySatisfied(s, node string) bool {
+	return t.affinityAvail(s, node).Is(status.Down, status.StandbyUp, status.StandbyDown, status.NotApplicable, status.Undef)
+}
+
+// hardAffinityViolations returns the list of hard affinity and anti-affinity
+// constraints not satisfied on node. A node with hard violations is not a
+// valid ha candidate.
+func (t *Manager) hardAffinityViolations(node string) []string {
+	var l []string
+	for _, s := range t.instConfig.HardAffinity {
+		if !t.isAffinitySatisfied(s, node) {
+			l = append(l, fmt.Sprintf("hard affinity with %s (%s)", s, t.affinityAvail(s, node)))
+		}
+	}
+	for _, s := range t.instConfig.HardAntiAffinity {
+		if !t.isAntiAffinitySatisfied(s, node) {
+			l = append(l, fmt.Sprintf("hard anti affinity with %s (%s)", s, t.affinityAvail(s, node)))
+		}
+	}
+	return l
+}
+
+// softAffinityViolations returns the list of soft affinity and anti-affinity
+// constraints not satisfied on node. Nodes with fewer soft violations are
+// ranked first.
+func (t *Manager) softAffinityViolations(node string) []string {
+	var l []string
+	for _, s := range t.instConfig.SoftAffinity {
+		if !t.isAffinitySatisfied(s, node) {
+			l = append(l, fmt.Sprintf("soft affinity with %s (%s)", s, t.affinityAvail(s, node)))
+		}
+	}
+	for _, s := range t.instConfig.SoftAntiAffinity {
+		if !t.isAntiAffinitySatisfied(s, node) {
+			l = append(l, fmt.Sprintf("soft anti affinity with %s (%s)", s, t.affinityAvail(s, node)))
+		}
+	}
+	return l
+}
+
+// sortWithSoftAffinity moves the candidates satisfying the more soft affinity
+// constraints first, preserving the placement policy order between
+// candidates with the same violation count.
+func (t *Manager) sortWithSoftAffinity(candidates []string) []string {
+	if len(t.instConfig.SoftAffinity)+len(t.instConfig.SoftAntiAffinity) == 0 {
+		return candidates
+	}
+	violations := make(map[string]int)
+	for _, node := range candidates {
+		violations[node] = len(t.softAffinityViolations(node))
+	}
+	l := append([]string{}, candidates...)
+	sort.SliceStable(l, func(i, j int) bool {
+		return violations[l[i]] < violations[l[j]]
+	})
+	return l
+}
+
+// updatePlacementViolations sets the local instance monitor list of
+// unsatisfied affinity constraints.
+func (t *Manager) updatePlacementViolations() {
+	violations := append(t.hardAffinityViolations(t.localhost), t.softAffinityViolations(t.localhost)...)
+	if slices.Equal(violations, t.state.PlacementViolations) {
+		return
+	}
+	if len(violations) > 0 {
+		t.log.Infof("placement violations: %s", violations)
+	} else {
+		t.log.Infof("placement violations cleared")
+	}
+	t.change = true
+	t.state.PlacementViolations = violations
+}
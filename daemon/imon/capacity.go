@@ -0,0 +1,82 @@
+package imon
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/capacity"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/daemon/msgbus"
+)
+
+// capacityShortages returns the list of the resources requested by the
+// object that don't fit in the <nodename> headroom. A node where the
+// instance is already running or starting has no shortage, as the instance
+// requests are already accounted in the node allocations.
+func (t *Manager) capacityShortages(nodename string) []string {
+	requests := t.instConfig.Requests
+	if requests.IsZero() {
+		return nil
+	}
+	var (
+		instStatus  *instance.Status
+		instMonitor *instance.Monitor
+	)
+	if v, ok := t.instStatus[nodename]; ok {
+		instStatus = &v
+	}
+	if nodename == t.localhost {
+		instMonitor = &t.state
+	} else if v, ok := t.instMonitor[nodename]; ok {
+		instMonitor = &v
+	}
+	if instance.IsAllocating(instStatus, instMonitor) {
+		return nil
+	}
+	nodeStats := t.nodeStats[nodename]
+	nodeStatus := t.nodeStatus[nodename]
+	nodeCapacity := capacity.NodeCapacity(int64(nodeStats.MemTotalMB)*1024*1024, nodeStats.NumCPU, nodeStatus.Labels)
+	return requests.Shortages(nodeCapacity, nodeStatus.Allocated)
+}
+
+// updateNoCapacity sets the nodes shortages preventing any instance start,
+// and publishes an ObjectOrchestrationRefused with the "no capacity" reason
+// when the object is not started and no node has enough headroom.
+func (t *Manager) updateNoCapacity(shortages map[string][]string) {
+	t.noCapacity = shortages
+	refused := shortages != nil && !t.isStarted()
+	if refused == t.noCapacityRefused {
+		return
+	}
+	t.noCapacityRefused = refused
+	if !refused {
+		t.log.Infof("capacity available")
+		return
+	}
+	nodenames := make([]string, 0, len(shortages))
+	for nodename := range shortages {
+		nodenames = append(nodenames, nodename)
+	}
+	sort.Strings(nodenames)
+	l := make([]string, len(nodenames))
+	for i, nodename := range nodenames {
+		l[i] = fmt.Sprintf("%s: %s", nodename, strings.Join(shortages[nodename], ", "))
+	}
+	t.log.Warnf("no capacity: %s", strings.Join(l, "; "))
+	var id string
+	if t.state.OrchestrationID != uuid.Nil {
+		id = t.state.OrchestrationID.String()
+	}
+	t.pubsubBus.Pub(&msgbus.ObjectOrchestrationRefused{
+		Node:   t.localhost,
+		Path:   t.path,
+		ID:     id,
+		Reason: "no capacity",
+	},
+		t.labelPath,
+		t.labelLocalhost,
+	)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/opensvc/om3/core/env"
 	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/daemon/job"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/daemon/runner"
 	"github.com/opensvc/om3/util/command"
@@ -154,12 +155,14 @@ func (t *Manager) crmAction(title string, cmdArgs ...string) error {
 	return t.crmDefaultAction(title, cmdArgs...)
 }
 
-func (t *Manager) crmDefaultAction(title string, cmdArgs ...string) error {
+func (t *Manager) crmDefaultAction(title string, cmdArgs ...string) (err error) {
 	sid := uuid.New()
 	cmd := command.New(
 		command.WithName(cmdPath),
 		command.WithArgs(cmdArgs),
 		command.WithLogger(t.log),
+		command.WithBufferedStdout(),
+		command.WithBufferedStderr(),
 		command.WithVarEnv(
 			env.OriginSetenvArg(env.ActionOriginDaemonMonitor),
 			env.ActionOrchestrationIDVar+"="+t.state.OrchestrationID.String(),
@@ -173,8 +176,18 @@ func (t *Manager) crmDefaultAction(title string, cmdArgs ...string) error {
 		t.loggerWithState().Debugf("-> exec %s", append([]string{cmdPath}, cmdArgs...))
 	}
 	t.pubsubBus.Pub(&msgbus.Exec{Command: cmd.String(), Node: t.localhost, Origin: "imon", Title: title}, labels...)
+
+	// record the command output in the orchestration job
+	jobID := t.state.OrchestrationID
+	if jobID != uuid.Nil {
+		execIndex := job.Jobs.ExecStarted(jobID, t.path, title, cmd.String())
+		defer func() {
+			job.Jobs.ExecEnded(jobID, execIndex, cmd.ExitCode(), cmd.Stdout(), cmd.Stderr(), err)
+		}()
+	}
+
 	startTime := time.Now()
-	if err := cmd.Run(); err != nil {
+	if err = cmd.Run(); err != nil {
 		duration := time.Now().Sub(startTime)
 		t.pubsubBus.Pub(&msgbus.ExecFailed{Command: cmd.String(), Duration: duration, ErrS: err.Error(), Node: t.localhost, Origin: "imon", Title: title}, labels...)
 		t.loggerWithState().Errorf("<- exec %s: %s", append([]string{cmdPath}, cmdArgs...), err)
@@ -89,6 +89,10 @@ type (
 		// priors is the list of peer instance nodenames that need restarting before we can restart locally
 		priors []string
 
+		// stonithCandidates is the set of peer nodenames where the instance
+		// was up when its instance status was dropped.
+		stonithCandidates map[string]bool
+
 		sub *pubsub.Subscription
 
 		pubsubBus *pubsub.Bus
@@ -130,6 +134,33 @@ type (
 		// It is used during enableDelayTimer():
 		// When false the delay timer is reset with delayDuration
 		delayTimerEnabled bool
+
+		// restartWindowTimer fires at the earliest restart window expiry of
+		// the restarted resources seen up, so their restart counters are
+		// reset even when no other event triggers an orchestration.
+		restartWindowTimer *time.Timer
+
+		// maintenanceWindowTicker is the ticker of the object maintenance
+		// window evaluations. It is stopped when the object has no
+		// maintenance window.
+		maintenanceWindowTicker *time.Ticker
+
+		// noCapacity is the resources shortages indexed by nodename, when
+		// no HA candidate node has the headroom to run an instance. It is
+		// nil when a candidate node has the headroom.
+		noCapacity map[string][]string
+
+		// noCapacityRefused is true when the "no capacity" orchestration
+		// refusal has been published.
+		noCapacityRefused bool
+
+		// scalerSlicesWriting is true while a goroutine writes the scaler
+		// slices config files.
+		scalerSlicesWriting bool
+
+		// scalerSlicesPending is true when some scaler slices needed a
+		// write while scalerSlicesWriting was true.
+		scalerSlicesPending bool
 	}
 
 	// cmdOrchestrate can be used from post action go routines
@@ -138,6 +169,10 @@ type (
 		newState instance.MonitorState
 	}
 
+	// cmdScalerSlicesWritten is sent by the scaler slices write goroutine
+	// when it is done.
+	cmdScalerSlicesWritten struct{}
+
 	Factory struct {
 		DrainDuration time.Duration
 		SubQS         pubsub.QueueSizer
@@ -160,6 +195,12 @@ var (
 	// databus or event bus. We must prevent such situations
 	// TODO: not anymore usefull since delayTimer
 	updateRate rate.Limit = 25
+
+	// loadAvgHysteresis is the 15 minutes load average bonus given to the
+	// incumbent candidates of the "load avg" placement policy. A node must be
+	// less loaded than the incumbent by more than this value to take over the
+	// leadership.
+	loadAvgHysteresis = 0.5
 )
 
 // start launch goroutine imon worker for a local instance state
@@ -204,6 +245,8 @@ func start(parent context.Context, qs pubsub.QueueSizer, p naming.Path, nodes []
 
 		waitConvergedOrchestrationMsg: make(map[string]string),
 
+		stonithCandidates: make(map[string]bool),
+
 		drainDuration: drainDuration,
 
 		updateLimiter: rate.NewLimiter(updateRate, int(updateRate)),
@@ -232,6 +275,7 @@ func (t *Manager) newLogger(i uuid.UUID) *plog.Logger {
 
 func (t *Manager) startSubscriptions(qs pubsub.QueueSizer) {
 	sub := t.pubsubBus.Sub("daemon.imon "+t.id, qs)
+	sub.AddFilter(&msgbus.ForgetPeer{})
 	sub.AddFilter(&msgbus.NodeConfigUpdated{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.NodeMonitorUpdated{})
 	sub.AddFilter(&msgbus.NodeRejoin{}, t.labelLocalhost)
@@ -293,7 +337,17 @@ func (t *Manager) worker(initialNodes []string) {
 		<-t.delayTimer.C
 	}
 
+	t.restartWindowTimer = time.NewTimer(time.Second)
+	t.restartWindowTimer.Stop()
+	defer t.restartWindowTimer.Stop()
+
+	t.maintenanceWindowTicker = time.NewTicker(maintenanceWindowInterval)
+	t.maintenanceWindowTicker.Stop()
+	defer t.maintenanceWindowTicker.Stop()
+	t.onMaintenanceWindowConfigUpdated()
+
 	t.initRelationAvailStatus()
+	t.janitorAffinities(nil)
 	t.initResourceMonitor()
 	t.updateIsLeader()
 	t.updateIfChange()
@@ -340,8 +394,10 @@ func (t *Manager) worker(initialNodes []string) {
 			switch c := i.(type) {
 			case *msgbus.InstanceStatusDeleted:
 				t.onInstanceStatusDeleted(c)
+				t.onAffinityInstanceStatusChange(c.Path)
 			case *msgbus.InstanceStatusUpdated:
 				t.onRelationInstanceStatusUpdated(c)
+				t.onAffinityInstanceStatusChange(c.Path)
 			case *msgbus.ObjectStatusDeleted:
 				t.onObjectStatusDeleted(c)
 			case *msgbus.ObjectStatusUpdated:
@@ -350,6 +406,8 @@ func (t *Manager) worker(initialNodes []string) {
 				t.onProgressInstanceMonitor(c)
 			case *msgbus.SetInstanceMonitor:
 				t.onSetInstanceMonitor(c)
+			case *msgbus.ForgetPeer:
+				t.onForgetPeer(c)
 			case *msgbus.NodeConfigUpdated:
 				t.onNodeConfigUpdated(c)
 			case *msgbus.NodeMonitorUpdated:
@@ -370,9 +428,15 @@ func (t *Manager) worker(initialNodes []string) {
 			switch c := i.(type) {
 			case cmdOrchestrate:
 				t.needOrchestrate(c)
+			case cmdScalerSlicesWritten:
+				t.onScalerSlicesWritten()
 			}
 		case <-t.delayTimer.C:
 			t.onDelayTimer()
+		case <-t.restartWindowTimer.C:
+			t.onRestartWindowTimer()
+		case <-t.maintenanceWindowTicker.C:
+			t.onMaintenanceWindowTicker()
 		}
 	}
 }
@@ -340,8 +340,11 @@ func (t *Manager) onInstanceConfigUpdated(srcNode string, srcCmd *msgbus.Instanc
 				t.log.Warnf("evaluate instance status via CRM: %s", err)
 			}
 		}()
+		previousAffinityPaths := t.affinityPaths()
 		t.instConfig = srcCmd.Value
 		t.initResourceMonitor()
+		t.onMaintenanceWindowConfigUpdated()
+		t.janitorAffinities(previousAffinityPaths)
 		janitorInstStatus(srcCmd.Value.Scope)
 		janitorRelations(srcCmd.Value.Children, "Child", t.state.Children)
 		janitorRelations(srcCmd.Value.Parents, "Parent", t.state.Parents)
@@ -352,6 +355,7 @@ func (t *Manager) onInstanceConfigUpdated(srcNode string, srcCmd *msgbus.Instanc
 
 func (t *Manager) onMyInstanceStatusDeleted(c *msgbus.InstanceStatusDeleted) {
 	if _, ok := t.instStatus[c.Node]; ok {
+		t.stonithCandidateFromDeleted(c.Node)
 		t.log.Debugf("drop deleted instance status from node %s", c.Node)
 		delete(t.instStatus, c.Node)
 	}
@@ -472,6 +476,10 @@ func (t *Manager) onSetInstanceMonitor(c *msgbus.SetInstanceMonitor) {
 		return nil
 	}
 
+	// refusedReason is the ObjectOrchestrationRefused reason when the
+	// request is refused by the object orchestration state.
+	var refusedReason string
+
 	globalExpectRefused := func() {
 		t.pubsubBus.Pub(&msgbus.SetInstanceMonitorRefused{
 			Path:  t.path,
@@ -534,6 +542,7 @@ func (t *Manager) onSetInstanceMonitor(c *msgbus.SetInstanceMonitor) {
 				err := fmt.Errorf("%w: daemon: imon: %s: %s", instance.ErrInvalidGlobalExpect, *c.Value.GlobalExpect, reason)
 				t.log.Infof("set instance monitor %s", t.path, err)
 				globalExpectRefused()
+				refusedReason = reason
 				return err
 			}
 		}
@@ -609,13 +618,22 @@ func (t *Manager) onSetInstanceMonitor(c *msgbus.SetInstanceMonitor) {
 		}
 		t.state.OrchestrationID = c.Value.CandidateOrchestrationID
 		t.acceptedOrchestrationID = c.Value.CandidateOrchestrationID
+		if c.Value.GlobalExpect != nil {
+			orchestrationsTotal.WithLabelValues(c.Value.GlobalExpect.String(), "accepted").Inc()
+		}
 		t.onChange()
 	} else {
+		if c.Value.GlobalExpect != nil {
+			orchestrationsTotal.WithLabelValues(c.Value.GlobalExpect.String(), "refused").Inc()
+		}
+		if refusedReason == "" {
+			refusedReason = fmt.Sprintf("set instance monitor request => no changes: %v", c.Value)
+		}
 		t.pubsubBus.Pub(&msgbus.ObjectOrchestrationRefused{
 			Node:   t.localhost,
 			Path:   t.path,
 			ID:     c.Value.CandidateOrchestrationID.String(),
-			Reason: fmt.Sprintf("set instance monitor request => no changes: %v", c.Value),
+			Reason: refusedReason,
 		},
 			t.labelPath,
 			t.labelLocalhost,
@@ -631,17 +649,25 @@ func (t *Manager) onNodeConfigUpdated(c *msgbus.NodeConfigUpdated) {
 
 func (t *Manager) onNodeMonitorUpdated(c *msgbus.NodeMonitorUpdated) {
 	t.nodeMonitor[c.Node] = c.Value
+	t.cancelStonithPending(c.Node)
 	t.onChange()
 }
 
 func (t *Manager) onNodeStatusUpdated(c *msgbus.NodeStatusUpdated) {
 	t.nodeStatus[c.Node] = c.Value
+	t.updateStonithPending()
 	t.onChange()
 }
 
 func (t *Manager) onNodeStatsUpdated(c *msgbus.NodeStatsUpdated) {
 	t.nodeStats[c.Node] = c.Value
-	if t.objStatus.PlacementPolicy == placement.Score {
+	switch t.objStatus.PlacementPolicy {
+	case placement.Score, placement.LoadAvg:
+		t.onChange()
+		return
+	}
+	if !t.instConfig.Requests.IsZero() {
+		// the node memory and cpu capacities are in the stats
 		t.onChange()
 	}
 }
@@ -706,6 +732,9 @@ func (t *Manager) AllInstanceMonitors() map[string]instance.Monitor {
 }
 
 func (t *Manager) isHAOrchestrateable() (bool, string) {
+	if t.isScaler() {
+		return false, "scaler object instances are not orchestrated, its slices are"
+	}
 	if (t.objStatus.Topology == topology.Failover) && (t.objStatus.Avail == status.Warn) {
 		return false, "failover object is warn state"
 	}
@@ -725,6 +754,9 @@ func (t *Manager) isStartable() (bool, string) {
 	if t.isStarted() {
 		return false, "already started"
 	}
+	if t.noCapacity != nil {
+		return false, "no capacity"
+	}
 	return true, "object is startable"
 }
 
@@ -761,7 +793,13 @@ func (t *Manager) needOrchestrate(c cmdOrchestrate) {
 	t.orchestrate()
 }
 
+// sortCandidates returns the candidates ordered by the placement policy, then
+// by the soft affinity constraints.
 func (t *Manager) sortCandidates(candidates []string) []string {
+	return t.sortWithSoftAffinity(t.sortWithPlacementPolicy(candidates))
+}
+
+func (t *Manager) sortWithPlacementPolicy(candidates []string) []string {
 	switch t.objStatus.PlacementPolicy {
 	case placement.NodesOrder:
 		return t.sortWithNodesOrderPolicy(candidates)
@@ -773,6 +811,8 @@ func (t *Manager) sortCandidates(candidates []string) []string {
 		return t.sortWithShiftPolicy(candidates)
 	case placement.LastStart:
 		return t.sortWithLastStartPolicy(candidates)
+	case placement.LoadAvg:
+		return t.sortWithLoadAvgPolicy(candidates)
 	default:
 		return []string{}
 	}
@@ -806,21 +846,44 @@ func (t *Manager) sortWithScorePolicy(candidates []string) []string {
 	return l
 }
 
+// sortWithLoadAvgPolicy sorts candidates by ascending node.Stats.Load15M.
+//
+// The candidates already running the instance or already elected ha leader
+// are favored by loadAvgHysteresis, so the leadership does not flap between
+// nodes with close load averages.
 func (t *Manager) sortWithLoadAvgPolicy(candidates []string) []string {
-	l := append([]string{}, candidates...)
-	sort.SliceStable(l, func(i, j int) bool {
-		var si, sj float64
-		if stats, ok := t.nodeStats[l[i]]; ok {
-			si = stats.Load15M
+	l := t.sortWithNodesOrderPolicy(candidates)
+	load := func(node string) float64 {
+		var v float64
+		if stats, ok := t.nodeStats[node]; ok {
+			v = stats.Load15M
 		}
-		if stats, ok := t.nodeStats[l[j]]; ok {
-			sj = stats.Load15M
+		if t.isLoadAvgIncumbent(node) {
+			v -= loadAvgHysteresis
 		}
-		return si > sj
+		return v
+	}
+	sort.SliceStable(l, func(i, j int) bool {
+		return load(l[i]) < load(l[j])
 	})
 	return l
 }
 
+// isLoadAvgIncumbent returns true if the instance is up on node, or if
+// node is the current ha leader.
+func (t *Manager) isLoadAvgIncumbent(node string) bool {
+	if instStatus, ok := t.instStatus[node]; ok && instStatus.Avail == status.Up {
+		return true
+	}
+	if node == t.localhost {
+		return t.state.IsHALeader
+	}
+	if instMon, ok := t.instMonitor[node]; ok {
+		return instMon.IsHALeader
+	}
+	return false
+}
+
 func (t *Manager) sortWithLastStartPolicy(candidates []string) []string {
 	l := append([]string{}, candidates...)
 	sort.SliceStable(l, func(i, j int) bool {
@@ -883,6 +946,9 @@ func (t *Manager) nextPlacedAtCandidate() string {
 	candidates = t.sortCandidates(candidates)
 
 	for _, candidate := range candidates {
+		if len(t.hardAffinityViolations(candidate)) > 0 {
+			continue
+		}
 		if instStatus, ok := t.instStatus[candidate]; ok {
 			switch instStatus.Avail {
 			case status.Down, status.StandbyDown, status.StandbyUp:
@@ -927,8 +993,14 @@ func (t *Manager) IsNodeMonitorStatusRankable(node string) (bool, bool) {
 	return nodeMonitor.State.IsRankable(), true
 }
 
-func (t *Manager) newIsHALeader() bool {
-	var candidates []string
+// newIsHALeader returns true if the local node is a HA leader, and the
+// resources shortages of the scope nodes if no node has the headroom to run
+// an instance.
+func (t *Manager) newIsHALeader() (bool, map[string][]string) {
+	var (
+		candidates []string
+		shortages  map[string][]string
+	)
 
 	for _, node := range t.scopeNodes {
 		if v, ok := t.IsInstanceStatusNotApplicable(node); !ok || v {
@@ -949,8 +1021,21 @@ func (t *Manager) newIsHALeader() bool {
 		if v, ok := t.IsNodeMonitorStatusRankable(node); !ok || !v {
 			continue
 		}
+		if len(t.hardAffinityViolations(node)) > 0 {
+			continue
+		}
+		if l := t.capacityShortages(node); len(l) > 0 {
+			if shortages == nil {
+				shortages = make(map[string][]string)
+			}
+			shortages[node] = l
+			continue
+		}
 		candidates = append(candidates, node)
 	}
+	if len(candidates) > 0 {
+		shortages = nil
+	}
 	candidates = t.sortCandidates(candidates)
 
 	var maxLeaders int = 1
@@ -960,9 +1045,9 @@ func (t *Manager) newIsHALeader() bool {
 
 	i := stringslice.Index(t.localhost, candidates)
 	if i < 0 {
-		return false
+		return false, shortages
 	}
-	return i < maxLeaders
+	return i < maxLeaders, shortages
 }
 
 func (t *Manager) newIsLeader() bool {
@@ -996,11 +1081,13 @@ func (t *Manager) updateIsLeader() {
 		t.change = true
 		t.state.IsLeader = isLeader
 	}
-	isHALeader := t.newIsHALeader()
+	isHALeader, shortages := t.newIsHALeader()
 	if isHALeader != t.state.IsHALeader {
 		t.change = true
 		t.state.IsHALeader = isHALeader
 	}
+	t.updateNoCapacity(shortages)
+	t.updatePlacementViolations()
 	return
 }
 
@@ -0,0 +1,67 @@
+package imon
+
+import (
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/core/maintenance"
+)
+
+// maintenanceWindowInterval is the interval duration between 2 object
+// maintenance window evaluations. The ticker runs only when the object has
+// a maintenance_window keyword.
+var maintenanceWindowInterval = 30 * time.Second
+
+// isMaintenanceAllowed returns false if the active object or node
+// maintenance window suspends the HA orchestration actions of this kind.
+func (t *Manager) isMaintenanceAllowed(kind string) bool {
+	if !t.state.MaintenanceWindow.IsAllowed(kind) {
+		return false
+	}
+	return t.nodeMonitor[t.localhost].MaintenanceWindow.IsAllowed(kind)
+}
+
+// onMaintenanceWindowConfigUpdated starts or stops the maintenance window
+// ticker depending on the object config, and evaluates the window.
+func (t *Manager) onMaintenanceWindowConfigUpdated() {
+	if t.instConfig.MaintenanceWindow == "" {
+		t.maintenanceWindowTicker.Stop()
+	} else {
+		t.maintenanceWindowTicker.Reset(maintenanceWindowInterval)
+	}
+	if err := t.updateMaintenanceWindow(); err != nil {
+		t.log.Warnf("maintenance window: %s", err)
+	}
+}
+
+// onMaintenanceWindowTicker evaluates the maintenance window, and resumes the
+// HA orchestration at the end of the window.
+func (t *Manager) onMaintenanceWindowTicker() {
+	if err := t.updateMaintenanceWindow(); err != nil {
+		t.log.Debugf("maintenance window: %s", err)
+	}
+	if t.change {
+		t.orchestrate()
+		t.updateIfChange()
+	}
+}
+
+// updateMaintenanceWindow sets the active object maintenance window in the
+// instance monitor state.
+func (t *Manager) updateMaintenanceWindow() error {
+	window, err := maintenance.Active(t.instConfig.MaintenanceWindow, t.instConfig.MaintenanceWindowAllow, time.Now())
+	if window.Equal(t.state.MaintenanceWindow) {
+		return err
+	}
+	switch {
+	case window == nil:
+		t.log.Infof("maintenance window ended: resume the HA orchestration")
+	case len(window.Allow) > 0:
+		t.log.Infof("maintenance window until %s: suspend the HA orchestration, except %s", window.Until, strings.Join(window.Allow, ", "))
+	default:
+		t.log.Infof("maintenance window until %s: suspend the HA orchestration", window.Until)
+	}
+	t.state.MaintenanceWindow = window
+	t.change = true
+	return err
+}
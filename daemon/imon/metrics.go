@@ -0,0 +1,15 @@
+package imon
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	orchestrationsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "opensvc_imon_orchestrations_total",
+			Help: "The total number of orchestrations accepted, refused and ended by the local instance monitors",
+		},
+		[]string{"global_expect", "event"})
+)
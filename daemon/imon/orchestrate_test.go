@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"slices"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -17,10 +18,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/opensvc/om3/core/clusternode"
 	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/maintenance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
 	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/resource"
 	"github.com/opensvc/om3/core/status"
 	"github.com/opensvc/om3/daemon/daemonhelper"
 	"github.com/opensvc/om3/daemon/icfg"
@@ -29,7 +34,9 @@ import (
 	"github.com/opensvc/om3/daemon/omon"
 	"github.com/opensvc/om3/testhelper"
 	"github.com/opensvc/om3/util/bootid"
+	"github.com/opensvc/om3/util/file"
 	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/plog"
 	"github.com/opensvc/om3/util/pubsub"
 )
 
@@ -58,15 +65,66 @@ type (
 		lastBootID  string
 		sideEffects map[string]sideEffect
 
+		// clusterConfigFile is the cluster config file to install, defaults
+		// to the single node cluster config installed by daemonhelper.
+		clusterConfigFile string
+
 		nodeMonitorStates []node.MonitorState
 		nodeFrozen        bool
 
+		// nodeIsLeader is the local node status IsLeader value.
+		nodeIsLeader bool
+
+		// relatedAvail is the local instance avail status of the objects
+		// referenced by affinity keywords, indexed by object name.
+		relatedAvail map[string]status.T
+
+		// load15M is the node.Stats.Load15M, indexed by node name. The node
+		// other than localhost are considered idle peers with a down
+		// instance.
+		load15M map[string]float64
+
+		// peerIsHALeader is the IsHALeader value of the peer instance
+		// monitors.
+		peerIsHALeader bool
+
+		// lostPeers is the list of peer nodes with an up instance, that are
+		// lost after the instance monitor startup.
+		lostPeers []string
+
+		// lostPeersStonithDone is true when the local node status reports a
+		// successful stonith of the lost peers.
+		lostPeersStonithDone bool
+
+		// nodeMaintenanceWindow is the active node maintenance window of
+		// the local node monitor.
+		nodeMaintenanceWindow *maintenance.Window
+
+		// nodeStats is the local node stats, advertising the node memory
+		// and cpu capacities.
+		nodeStats *node.Stats
+
+		// nodeLabels is the local node status labels, advertising the node
+		// custom countable resources.
+		nodeLabels node.Labels
+
 		expectedState        instance.MonitorState
 		expectedGlobalExpect instance.MonitorGlobalExpect
 		expectedLocalExpect  instance.MonitorLocalExpect
 		expectedIsLeader     bool
 		expectedIsHALeader   bool
 
+		expectedPlacementViolations []string
+		expectedStonithPending      []string
+
+		// expectedMaintenanceWindow is true if the instance monitor is
+		// expected to have an active object maintenance window.
+		expectedMaintenanceWindow bool
+
+		// expectedOrchestrationRefusedReason is the reason of the expected
+		// ObjectOrchestrationRefused.
+		expectedOrchestrationRefusedReason string
+
 		// expectedDeleteSuccess is true if check delete orchestration with a
 		// successfully crm delete
 		expectedDeleteSuccess bool
@@ -76,6 +134,10 @@ type (
 		expectedDeleteFailed bool
 
 		expectedCrm [][]string
+
+		// expectedScalerSlices is the list of scaler slice names expected to
+		// have a config file.
+		expectedScalerSlices []string
 	}
 )
 
@@ -479,6 +541,444 @@ func Test_Orchestrate_HA_that_calls_start(t *testing.T) {
 	}
 }
 
+func Test_Orchestrate_HA_affinity(t *testing.T) {
+	cases := []tCase{
+		{
+			name:    "if hard anti affinity object is up then instance is not ha leader and is not started",
+			srcFile: "./testdata/orchestrate-ha-anti-affinity.conf",
+			obj:     "obj",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			relatedAvail:                map[string]status.T{"db1": status.Up},
+			nodeMonitorStates:           []node.MonitorState{node.MonitorStateIdle},
+			expectedState:               instance.MonitorStateIdle,
+			expectedGlobalExpect:        instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:         instance.MonitorLocalExpectNone,
+			expectedIsLeader:            true,
+			expectedIsHALeader:          false,
+			expectedPlacementViolations: []string{"hard anti affinity with db1 (up)"},
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+			},
+		},
+
+		{
+			name:    "if hard anti affinity object is down then instance is started",
+			srcFile: "./testdata/orchestrate-ha-anti-affinity.conf",
+			obj:     "obj",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			relatedAvail:         map[string]status.T{"db1": status.Down},
+			nodeMonitorStates:    []node.MonitorState{node.MonitorStateIdle},
+			expectedState:        instance.MonitorStateIdle,
+			expectedGlobalExpect: instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:  instance.MonitorLocalExpectStarted,
+			expectedIsLeader:     true,
+			expectedIsHALeader:   true,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+				{"obj", "start", "--local"},
+			},
+		},
+
+		{
+			name:    "if hard affinity object is not up then instance is not ha leader and is not started",
+			srcFile: "./testdata/orchestrate-ha-affinity.conf",
+			obj:     "obj",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			relatedAvail:                map[string]status.T{"db1": status.Down},
+			nodeMonitorStates:           []node.MonitorState{node.MonitorStateIdle},
+			expectedState:               instance.MonitorStateIdle,
+			expectedGlobalExpect:        instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:         instance.MonitorLocalExpectNone,
+			expectedIsLeader:            true,
+			expectedIsHALeader:          false,
+			expectedPlacementViolations: []string{"hard affinity with db1 (down)"},
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			orchestrateTestFunc(t, c)
+		})
+	}
+}
+
+func Test_Orchestrate_HA_load_avg(t *testing.T) {
+	cases := []tCase{
+		{
+			name:              "if local node is the less loaded node then instance is started",
+			srcFile:           "./testdata/orchestrate-ha-load-avg.conf",
+			obj:               "obj",
+			clusterConfigFile: "./testdata/cluster-2-nodes.conf",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			load15M:              map[string]float64{"node1": 0.2, "node2": 3.0},
+			nodeMonitorStates:    []node.MonitorState{node.MonitorStateIdle},
+			expectedState:        instance.MonitorStateIdle,
+			expectedGlobalExpect: instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:  instance.MonitorLocalExpectStarted,
+			expectedIsLeader:     true,
+			expectedIsHALeader:   true,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+				{"obj", "start", "--local"},
+			},
+		},
+
+		{
+			name:              "if peer node is the less loaded node then instance is not started",
+			srcFile:           "./testdata/orchestrate-ha-load-avg.conf",
+			obj:               "obj",
+			clusterConfigFile: "./testdata/cluster-2-nodes.conf",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			load15M:              map[string]float64{"node1": 3.0, "node2": 0.2},
+			nodeMonitorStates:    []node.MonitorState{node.MonitorStateIdle},
+			expectedState:        instance.MonitorStateIdle,
+			expectedGlobalExpect: instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:  instance.MonitorLocalExpectNone,
+			expectedIsLeader:     false,
+			expectedIsHALeader:   false,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+			},
+		},
+
+		{
+			name:              "if up local instance node is more loaded than peer within the hysteresis then instance stays leader",
+			srcFile:           "./testdata/orchestrate-ha-load-avg.conf",
+			obj:               "obj",
+			clusterConfigFile: "./testdata/cluster-2-nodes.conf",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			load15M:              map[string]float64{"node1": 0.6, "node2": 0.3},
+			nodeMonitorStates:    []node.MonitorState{node.MonitorStateIdle},
+			expectedState:        instance.MonitorStateIdle,
+			expectedGlobalExpect: instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:  instance.MonitorLocalExpectStarted,
+			expectedIsLeader:     true,
+			expectedIsHALeader:   true,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+			},
+		},
+
+		{
+			name:              "if local node is less loaded than the ha leader peer beyond the hysteresis then instance is started",
+			srcFile:           "./testdata/orchestrate-ha-load-avg.conf",
+			obj:               "obj",
+			clusterConfigFile: "./testdata/cluster-2-nodes.conf",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			load15M:              map[string]float64{"node1": 0.2, "node2": 1.5},
+			peerIsHALeader:       true,
+			nodeMonitorStates:    []node.MonitorState{node.MonitorStateIdle},
+			expectedState:        instance.MonitorStateIdle,
+			expectedGlobalExpect: instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:  instance.MonitorLocalExpectStarted,
+			expectedIsLeader:     true,
+			expectedIsHALeader:   true,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+				{"obj", "start", "--local"},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			orchestrateTestFunc(t, c)
+		})
+	}
+}
+
+func Test_Orchestrate_HA_stonith(t *testing.T) {
+	cases := []tCase{
+		{
+			name:              "if the peer with the up instance is lost then instance waits for its stonith",
+			srcFile:           "./testdata/orchestrate-ha-stonith.conf",
+			obj:               "obj",
+			clusterConfigFile: "./testdata/cluster-2-nodes.conf",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			lostPeers:              []string{"node2"},
+			nodeMonitorStates:      []node.MonitorState{node.MonitorStateIdle},
+			expectedState:          instance.MonitorStateIdle,
+			expectedGlobalExpect:   instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:    instance.MonitorLocalExpectNone,
+			expectedIsLeader:       true,
+			expectedIsHALeader:     true,
+			expectedStonithPending: []string{"node2"},
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+			},
+		},
+
+		{
+			name:              "if the lost peer stonith is done then instance is started",
+			srcFile:           "./testdata/orchestrate-ha-stonith.conf",
+			obj:               "obj",
+			clusterConfigFile: "./testdata/cluster-2-nodes.conf",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			lostPeers:            []string{"node2"},
+			lostPeersStonithDone: true,
+			nodeMonitorStates:    []node.MonitorState{node.MonitorStateIdle},
+			expectedState:        instance.MonitorStateIdle,
+			expectedGlobalExpect: instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:  instance.MonitorLocalExpectStarted,
+			expectedIsLeader:     true,
+			expectedIsHALeader:   true,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+				{"obj", "start", "--local"},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			orchestrateTestFunc(t, c)
+		})
+	}
+}
+
+func Test_Orchestrate_HA_maintenance_window(t *testing.T) {
+	cases := []tCase{
+		{
+			name:    "if the object maintenance window is active then instance is not started",
+			srcFile: "./testdata/orchestrate-ha-maintenance.conf",
+			obj:     "obj",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			nodeMonitorStates:         []node.MonitorState{node.MonitorStateIdle},
+			expectedState:             instance.MonitorStateIdle,
+			expectedGlobalExpect:      instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:       instance.MonitorLocalExpectNone,
+			expectedIsLeader:          true,
+			expectedIsHALeader:        true,
+			expectedMaintenanceWindow: true,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+			},
+		},
+
+		{
+			name:    "if the object maintenance window allows start then instance is started",
+			srcFile: "./testdata/orchestrate-ha-maintenance-allow-start.conf",
+			obj:     "obj",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			nodeMonitorStates:         []node.MonitorState{node.MonitorStateIdle},
+			expectedState:             instance.MonitorStateIdle,
+			expectedGlobalExpect:      instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:       instance.MonitorLocalExpectStarted,
+			expectedIsLeader:          true,
+			expectedIsHALeader:        true,
+			expectedMaintenanceWindow: true,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+				{"obj", "start", "--local"},
+			},
+		},
+
+		{
+			name:    "if the node maintenance window is active then instance is not started",
+			srcFile: "./testdata/orchestrate-ha.conf",
+			obj:     "obj",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			nodeMonitorStates:     []node.MonitorState{node.MonitorStateIdle},
+			nodeMaintenanceWindow: &maintenance.Window{Schedule: "*", Until: time.Now().Add(time.Hour)},
+			expectedState:         instance.MonitorStateIdle,
+			expectedGlobalExpect:  instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:   instance.MonitorLocalExpectNone,
+			expectedIsLeader:      true,
+			expectedIsHALeader:    true,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			orchestrateTestFunc(t, c)
+		})
+	}
+}
+
+func Test_Orchestrate_HA_capacity(t *testing.T) {
+	cases := []tCase{
+		{
+			name:    "if the node has not enough memory headroom then instance is not started",
+			srcFile: "./testdata/orchestrate-ha-capacity-mem.conf",
+			obj:     "obj",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			nodeMonitorStates:                  []node.MonitorState{node.MonitorStateIdle},
+			nodeStats:                          &node.Stats{MemTotalMB: 8192, NumCPU: 4},
+			expectedState:                      instance.MonitorStateIdle,
+			expectedGlobalExpect:               instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:                instance.MonitorLocalExpectNone,
+			expectedIsLeader:                   true,
+			expectedIsHALeader:                 false,
+			expectedOrchestrationRefusedReason: "no capacity",
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+			},
+		},
+
+		{
+			name:    "if the node does not advertise the requested label then instance is not started",
+			srcFile: "./testdata/orchestrate-ha-capacity-label.conf",
+			obj:     "obj",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			nodeMonitorStates:                  []node.MonitorState{node.MonitorStateIdle},
+			expectedState:                      instance.MonitorStateIdle,
+			expectedGlobalExpect:               instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:                instance.MonitorLocalExpectNone,
+			expectedIsLeader:                   true,
+			expectedIsHALeader:                 false,
+			expectedOrchestrationRefusedReason: "no capacity",
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+			},
+		},
+
+		{
+			name:    "if the node has the requests headroom then instance is started",
+			srcFile: "./testdata/orchestrate-ha-capacity-label.conf",
+			obj:     "obj",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True},
+					err:     nil,
+				},
+				"start": {
+					iStatus: &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True},
+					err:     nil,
+				},
+			},
+			nodeMonitorStates:    []node.MonitorState{node.MonitorStateIdle},
+			nodeStats:            &node.Stats{MemTotalMB: 8192, NumCPU: 4},
+			nodeLabels:           node.Labels{"gpu": "2"},
+			expectedState:        instance.MonitorStateIdle,
+			expectedGlobalExpect: instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:  instance.MonitorLocalExpectStarted,
+			expectedIsLeader:     true,
+			expectedIsHALeader:   true,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+				{"obj", "start", "--local"},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			orchestrateTestFunc(t, c)
+		})
+	}
+}
+
 func Test_Orchestrate_No(t *testing.T) {
 	cases := []tCase{
 		{
@@ -523,6 +1023,141 @@ func Test_Orchestrate_No(t *testing.T) {
 	}
 }
 
+func Test_Orchestrate_scaler(t *testing.T) {
+	cases := []tCase{
+		{
+			name:    "scaler instance is not started and its slices are created on the leader node",
+			srcFile: "./testdata/orchestrate-scaler.conf",
+			obj:     "obj",
+			sideEffects: map[string]sideEffect{
+				"status": {
+					iStatus: &instance.Status{Avail: status.NotApplicable, Overall: status.NotApplicable, Provisioned: provisioned.NotApplicable},
+					err:     nil,
+				},
+			},
+			nodeIsLeader:         true,
+			nodeMonitorStates:    []node.MonitorState{node.MonitorStateIdle},
+			expectedState:        instance.MonitorStateIdle,
+			expectedGlobalExpect: instance.MonitorGlobalExpectNone,
+			expectedLocalExpect:  instance.MonitorLocalExpectNone,
+			expectedIsLeader:     false,
+			expectedIsHALeader:   false,
+			expectedCrm: [][]string{
+				{"obj", "status", "-r"},
+			},
+			expectedScalerSlices: []string{"0.obj", "1.obj"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			orchestrateTestFunc(t, c)
+		})
+	}
+}
+
+func Test_Orchestrate_resource_restart(t *testing.T) {
+	rid := "app#1"
+	window := 50 * time.Millisecond
+	delay := time.Hour
+
+	newManager := func(t *testing.T, resStatus status.T, rcfg instance.ResourceConfig, restart instance.ResourceMonitorRestart) *Manager {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		bus := pubsub.NewBus(t.Name())
+		bus.Start(ctx)
+		t.Cleanup(bus.Stop)
+		localhost := hostname.Hostname()
+		m := &Manager{
+			path:       naming.Path{Kind: naming.KindSvc, Name: "obj"},
+			localhost:  localhost,
+			log:        plog.NewDefaultLogger(),
+			pubsubBus:  bus,
+			instConfig: instance.Config{Resources: instance.ResourceConfigs{rid: rcfg}},
+			instStatus: map[string]instance.Status{localhost: {
+				Provisioned: provisioned.True,
+				Resources:   instance.ResourceStatuses{rid: {Status: resStatus}},
+			}},
+			nodeMonitor: map[string]node.Monitor{localhost: {State: node.MonitorStateIdle}},
+			nodeStatus:  map[string]node.Status{localhost: {}},
+			state: instance.Monitor{
+				State:       instance.MonitorStateIdle,
+				LocalExpect: instance.MonitorLocalExpectStarted,
+				Resources:   instance.ResourceMonitors{rid: {Restart: restart}},
+			},
+			restartWindowTimer: time.NewTimer(time.Second),
+		}
+		m.restartWindowTimer.Stop()
+		t.Cleanup(func() {
+			m.restartWindowTimer.Stop()
+			if rmon := m.state.Resources.Get(rid); rmon != nil {
+				rmon.StopRestartTimer()
+			}
+		})
+		return m
+	}
+
+	t.Run("a down resource restarted again is flapping", func(t *testing.T) {
+		rcfg := instance.ResourceConfig{Restart: 3, RestartDelay: &delay, RestartWindow: &window}
+		m := newManager(t, status.Down, rcfg, instance.ResourceMonitorRestart{Remaining: 2, Count: 1, LastAt: time.Now()})
+		m.orchestrateResourceRestart()
+		rmon := m.state.Resources.Get(rid)
+		require.NotNil(t, rmon)
+		assert.Equal(t, 1, rmon.Restart.Remaining)
+		assert.Equal(t, 2, rmon.Restart.Count)
+		assert.True(t, rmon.Restart.IsFlapping)
+		assert.NotNil(t, rmon.Restart.Timer, "expected a delayed restart")
+	})
+
+	t.Run("a down resource without remaining restart triggers the monitor action", func(t *testing.T) {
+		rcfg := instance.ResourceConfig{Restart: 2, RestartDelay: &delay, RestartWindow: &window}
+		m := newManager(t, status.Down, rcfg, instance.ResourceMonitorRestart{Remaining: 0, Count: 2, IsFlapping: true})
+		m.orchestrateResourceRestart()
+		rmon := m.state.Resources.Get(rid)
+		require.NotNil(t, rmon)
+		assert.Nil(t, rmon.Restart.Timer, "expected no more restart")
+		assert.Equal(t, 2, rmon.Restart.Count)
+		assert.False(t, m.state.MonitorActionExecutedAt.IsZero(), "expected the monitor action executed")
+		assert.Equal(t, instance.MonitorLocalExpectEvicted, m.state.LocalExpect)
+	})
+
+	t.Run("a flapping resource up for the restart window is reset by the window timer", func(t *testing.T) {
+		rcfg := instance.ResourceConfig{Restart: 3, RestartDelay: &delay, RestartWindow: &window}
+		m := newManager(t, status.Up, rcfg, instance.ResourceMonitorRestart{Remaining: 1, Count: 2, IsFlapping: true})
+		m.orchestrateResourceRestart()
+		rmon := m.state.Resources.Get(rid)
+		require.NotNil(t, rmon)
+		assert.False(t, rmon.Restart.UpAt.IsZero(), "expected the up time recorded")
+		assert.Equal(t, 2, rmon.Restart.Count, "expected no reset before the end of the window")
+
+		select {
+		case <-m.restartWindowTimer.C:
+		case <-time.After(time.Second):
+			require.Fail(t, "expected the restart window timer to fire")
+		}
+		m.orchestrateResourceRestart()
+		rmon = m.state.Resources.Get(rid)
+		require.NotNil(t, rmon)
+		assert.Equal(t, 3, rmon.Restart.Remaining)
+		assert.Equal(t, 0, rmon.Restart.Count)
+		assert.False(t, rmon.Restart.IsFlapping)
+		assert.True(t, rmon.Restart.UpAt.IsZero())
+	})
+
+	t.Run("a resource down again during the restart window is not reset", func(t *testing.T) {
+		rcfg := instance.ResourceConfig{Restart: 3, RestartDelay: &delay, RestartWindow: &window}
+		m := newManager(t, status.Up, rcfg, instance.ResourceMonitorRestart{Remaining: 1, Count: 2, IsFlapping: true})
+		m.orchestrateResourceRestart()
+		m.instStatus[m.localhost].Resources[rid] = resource.Status{Status: status.Down}
+		m.orchestrateResourceRestart()
+		rmon := m.state.Resources.Get(rid)
+		require.NotNil(t, rmon)
+		assert.Equal(t, 0, rmon.Restart.Remaining)
+		assert.Equal(t, 3, rmon.Restart.Count)
+		assert.True(t, rmon.Restart.IsFlapping)
+		assert.True(t, rmon.Restart.UpAt.IsZero())
+	})
+}
+
 func orchestrateTestFunc(t *testing.T, c tCase) {
 	var err error
 	maxRoutine := 10
@@ -544,6 +1179,12 @@ func orchestrateTestFunc(t *testing.T, c tCase) {
 
 	setup.InstallFile("./testdata/nodes_info.json", "var/nodes_info.json")
 
+	if c.clusterConfigFile != "" {
+		setup.InstallFile(c.clusterConfigFile, "etc/cluster.conf")
+	}
+	_, err = object.SetClusterConfig()
+	require.NoError(t, err)
+
 	p := naming.Path{Kind: naming.KindSvc, Name: c.obj}
 
 	if c.lastBootID != "" {
@@ -555,14 +1196,14 @@ func orchestrateTestFunc(t *testing.T, c tCase) {
 
 	for _, nmonState := range c.nodeMonitorStates {
 		t.Logf("publish NodeMonitorUpdated state: %s", nmonState)
-		nodeMonitor := node.Monitor{State: nmonState, StateUpdatedAt: time.Now(), GlobalExpectUpdatedAt: now, LocalExpectUpdatedAt: now}
+		nodeMonitor := node.Monitor{State: nmonState, StateUpdatedAt: time.Now(), GlobalExpectUpdatedAt: now, LocalExpectUpdatedAt: now, MaintenanceWindow: c.nodeMaintenanceWindow}
 		node.MonitorData.Set(hostname.Hostname(), nodeMonitor.DeepCopy())
 		bus.Pub(&msgbus.NodeMonitorUpdated{Node: hostname.Hostname(), Value: nodeMonitor},
 			pubsub.Label{"node", hostname.Hostname()})
 	}
 
 	t.Logf("publish initial node status with frozen %v", c.nodeFrozen)
-	nodeStatus := node.Status{}
+	nodeStatus := node.Status{IsLeader: c.nodeIsLeader, Labels: c.nodeLabels}
 	if c.nodeFrozen {
 		nodeStatus.FrozenAt = time.Now()
 	}
@@ -570,6 +1211,42 @@ func orchestrateTestFunc(t *testing.T, c tCase) {
 	bus.Pub(&msgbus.NodeStatusUpdated{Node: hostname.Hostname(), Value: *nodeStatus.DeepCopy()},
 		pubsub.Label{"node", hostname.Hostname()})
 
+	for name, avail := range c.relatedAvail {
+		t.Logf("set related object %s instance avail status %s", name, avail)
+		relatedPath := naming.Path{Kind: naming.KindSvc, Name: name}
+		instance.StatusData.Set(relatedPath, hostname.Hostname(), &instance.Status{Avail: avail, UpdatedAt: time.Now()})
+	}
+
+	if c.nodeStats != nil {
+		t.Logf("set node stats mem total %dMB, %d cpus", c.nodeStats.MemTotalMB, c.nodeStats.NumCPU)
+		node.StatsData.Set(hostname.Hostname(), c.nodeStats.DeepCopy())
+	}
+
+	for nodename, load := range c.load15M {
+		t.Logf("set node %s stats load 15m %.2f", nodename, load)
+		node.StatsData.Set(nodename, &node.Stats{Load15M: load})
+		if nodename == hostname.Hostname() {
+			continue
+		}
+		t.Logf("set peer node %s idle with down instance (ha leader %v)", nodename, c.peerIsHALeader)
+		node.StatusData.Set(nodename, &node.Status{})
+		node.MonitorData.Set(nodename, &node.Monitor{State: node.MonitorStateIdle, StateUpdatedAt: now, GlobalExpectUpdatedAt: now, LocalExpectUpdatedAt: now})
+		instance.StatusData.Set(p, nodename, &instance.Status{Avail: status.Down, Overall: status.Down, Provisioned: provisioned.True, UpdatedAt: now})
+		instance.MonitorData.Set(p, nodename, &instance.Monitor{State: instance.MonitorStateIdle, IsHALeader: c.peerIsHALeader, UpdatedAt: now})
+	}
+
+	if len(c.lostPeers) > 0 {
+		clusternode.Set(append([]string{hostname.Hostname()}, c.lostPeers...))
+		defer clusternode.Set([]string{})
+	}
+	for _, nodename := range c.lostPeers {
+		t.Logf("set peer node %s idle with up instance", nodename)
+		node.StatusData.Set(nodename, &node.Status{})
+		node.MonitorData.Set(nodename, &node.Monitor{State: node.MonitorStateIdle, StateUpdatedAt: now, GlobalExpectUpdatedAt: now, LocalExpectUpdatedAt: now})
+		instance.StatusData.Set(p, nodename, &instance.Status{Avail: status.Up, Overall: status.Up, Provisioned: provisioned.True, UpdatedAt: now})
+		instance.MonitorData.Set(p, nodename, &instance.Monitor{State: instance.MonitorStateIdle, LocalExpect: instance.MonitorLocalExpectStarted, IsHALeader: true, UpdatedAt: now})
+	}
+
 	initialReadyDuration := defaultReadyDuration
 	defaultReadyDuration = 1 * time.Millisecond
 
@@ -592,6 +1269,13 @@ func orchestrateTestFunc(t *testing.T, c tCase) {
 
 	evC, errC := waitExpectations(t, setup, maxWaitTime, c)
 
+	refusedSub := bus.Sub(t.Name() + ": orchestration refused")
+	refusedSub.AddFilter(&msgbus.ObjectOrchestrationRefused{}, pubsub.Label{"path", p.String()})
+	refusedSub.Start()
+	defer func() {
+		_ = refusedSub.Stop()
+	}()
+
 	factory := Factory{
 		DrainDuration: setup.DrainDuration,
 		DelayDuration: 50 * time.Millisecond,
@@ -605,10 +1289,19 @@ func orchestrateTestFunc(t *testing.T, c tCase) {
 	err = icfg.Start(setup.Ctx, p, filepath.Join(setup.Env.Root, cfgEtcFile), make(chan any, 20))
 	require.Nil(t, err)
 
+	if len(c.lostPeers) > 0 {
+		losePeers(t, setup, p, c)
+	}
+
 	t.Logf("waiting for watcher result")
 	evImon, err := <-evC, <-errC
 	assert.NoError(t, err)
 
+	if (len(c.lostPeers) > 0 && !c.lostPeersStonithDone) || c.expectedMaintenanceWindow || c.nodeMaintenanceWindow != nil || c.expectedOrchestrationRefusedReason != "" {
+		// give a chance to an unexpected takeover before verifying calls
+		time.Sleep(300 * time.Millisecond)
+	}
+
 	calls := crm.getCalls()
 	t.Logf("crm calls: %v", calls)
 
@@ -632,10 +1325,41 @@ func orchestrateTestFunc(t *testing.T, c tCase) {
 	assert.Equalf(t, c.expectedIsHALeader, evImon.Value.IsHALeader,
 		"expected IsHALeader %v found %v", c.expectedIsHALeader, evImon.Value.IsHALeader)
 
+	t.Logf("verify placement violations")
+	assert.Equalf(t, c.expectedPlacementViolations, evImon.Value.PlacementViolations,
+		"expected placement violations %v found %v", c.expectedPlacementViolations, evImon.Value.PlacementViolations)
+
+	t.Logf("verify stonith pending")
+	assert.Equalf(t, c.expectedStonithPending, evImon.Value.StonithPending,
+		"expected stonith pending %v found %v", c.expectedStonithPending, evImon.Value.StonithPending)
+
+	t.Logf("verify maintenance window")
+	assert.Equalf(t, c.expectedMaintenanceWindow, evImon.Value.MaintenanceWindow != nil,
+		"expected maintenance window %v found %v", c.expectedMaintenanceWindow, evImon.Value.MaintenanceWindow)
+
 	t.Logf("verify calls")
 	assert.Equalf(t, c.expectedCrm, calls,
 		"expected calls %v, found %v", c.expectedCrm, calls)
 
+	if c.expectedOrchestrationRefusedReason != "" {
+		t.Logf("verify orchestration refused")
+		var reason string
+		select {
+		case i := <-refusedSub.C:
+			reason = i.(*msgbus.ObjectOrchestrationRefused).Reason
+		default:
+		}
+		assert.Equalf(t, c.expectedOrchestrationRefusedReason, reason,
+			"expected orchestration refused reason %q found %q", c.expectedOrchestrationRefusedReason, reason)
+	}
+
+	for _, name := range c.expectedScalerSlices {
+		slicePath := naming.Path{Kind: naming.KindSvc, Name: name}
+		t.Logf("verify scaler slice %s config file", slicePath)
+		assert.Eventuallyf(t, func() bool { return file.Exists(slicePath.ConfigFile()) }, time.Second, 10*time.Millisecond,
+			"expected scaler slice %s config file", slicePath)
+	}
+
 	var deleteTest string
 	require.False(t, c.expectedDeleteFailed && c.expectedDeleteSuccess,
 		"can't test with both expectedDeleteFailed and expectedDeleteSuccess")
@@ -856,7 +1580,10 @@ func waitExpectations(t *testing.T, setup *daemonhelper.D, timeout time.Duration
 						c.expectedIsLeader == v.IsLeader &&
 						c.expectedGlobalExpect == v.GlobalExpect &&
 						c.expectedState == v.State &&
-						c.expectedLocalExpect == v.LocalExpect {
+						c.expectedLocalExpect == v.LocalExpect &&
+						slices.Equal(c.expectedPlacementViolations, v.PlacementViolations) &&
+						slices.Equal(c.expectedStonithPending, v.StonithPending) &&
+						c.expectedMaintenanceWindow == (v.MaintenanceWindow != nil) {
 						t.Logf("----  matched InstanceMonitorUpdated %s state: %s localExpect: %s globalExpect: %s isLeader: %v isHaLeader: %v",
 							o.Path,
 							value.State,
@@ -877,6 +1604,42 @@ func waitExpectations(t *testing.T, setup *daemonhelper.D, timeout time.Duration
 	return evC, errC
 }
 
+// losePeers waits for the local instance monitor startup, then simulates
+// the loss of the c.lostPeers nodes the way daemondata drops a peer.
+// When c.lostPeersStonithDone is set, a successful stonith of the lost peers
+// is then published in the local node status.
+func losePeers(t *testing.T, setup *daemonhelper.D, p naming.Path, c tCase) {
+	stateC, errC := waitNmonStates(setup.Ctx, "waiting for idle", time.Second, p, instance.MonitorStateIdle)
+	state, err := <-stateC, <-errC
+	require.NoError(t, err)
+	t.Logf("found initial state: %s", state)
+
+	bus := pubsub.BusFromContext(setup.Ctx)
+	for _, nodename := range c.lostPeers {
+		t.Logf("lose peer %s", nodename)
+		bus.Pub(&msgbus.ForgetPeer{Node: nodename}, pubsub.Label{"node", nodename})
+		instance.StatusData.Unset(p, nodename)
+		bus.Pub(&msgbus.InstanceStatusDeleted{Path: p, Node: nodename}, pubsub.Label{"path", p.String()}, pubsub.Label{"node", nodename})
+	}
+	if !c.lostPeersStonithDone {
+		return
+	}
+	time.Sleep(100 * time.Millisecond)
+	nodeStatus := node.Status{}
+	instMonitor := instance.MonitorData.Get(p, hostname.Hostname())
+	require.NotNil(t, instMonitor)
+	for _, nodename := range c.lostPeers {
+		id, ok := instMonitor.StonithRequests[nodename]
+		require.Truef(t, ok, "expected a stonith request for peer %s", nodename)
+		t.Logf("publish stonith of peer %s done for request %s", nodename, id)
+		now := time.Now()
+		nodeStatus.Stonith = append(nodeStatus.Stonith, node.StonithRecord{Peer: nodename, StartedAt: now, EndedAt: now, Success: true, Requests: []uuid.UUID{id}})
+	}
+	node.StatusData.Set(hostname.Hostname(), nodeStatus.DeepCopy())
+	bus.Pub(&msgbus.NodeStatusUpdated{Node: hostname.Hostname(), Value: *nodeStatus.DeepCopy()},
+		pubsub.Label{"node", hostname.Hostname()})
+}
+
 func waitNmonStates(ctx context.Context, desc string, d time.Duration, p naming.Path, states ...instance.MonitorState) (<-chan instance.MonitorState, <-chan error) {
 	stateC := make(chan instance.MonitorState)
 	errC := make(chan error)
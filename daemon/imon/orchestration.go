@@ -76,6 +76,10 @@ func (t *Manager) orchestrate() {
 		return
 	}
 
+	if t.isScaler() {
+		t.orchestrateScaler()
+	}
+
 	switch t.state.GlobalExpect {
 	case instance.MonitorGlobalExpectDeleted:
 		t.orchestrateDeleted()
@@ -146,6 +150,9 @@ func (t *Manager) setWaitChildren() bool {
 
 // endOrchestration is called when orchestration has been reached on all nodes
 func (t *Manager) endOrchestration() {
+	if t.acceptedOrchestrationID != uuid.Nil {
+		orchestrationsTotal.WithLabelValues(t.state.GlobalExpect.String(), "ended").Inc()
+	}
 	t.change = true
 	t.state.GlobalExpect = instance.MonitorGlobalExpectNone
 	t.state.GlobalExpectOptions = nil
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/maintenance"
 	"github.com/opensvc/om3/core/status"
 	"github.com/opensvc/om3/core/topology"
 )
@@ -30,6 +31,8 @@ func (t *Manager) orchestrateHAStop() {
 		return
 	} else if v, _ := t.isHAOrchestrateable(); !v {
 		return
+	} else if !t.isMaintenanceAllowed(maintenance.Stop) {
+		return
 	} else if t.objStatus.Avail != status.Up {
 		return
 	}
@@ -55,7 +58,7 @@ func (t *Manager) orchestrateHAStart() {
 		}
 		return
 	}
-	if v, reason := t.isStartable(); !v {
+	if v, reason := t.isHAStartable(); !v {
 		if t.pendingCancel != nil && t.state.State == instance.MonitorStateReady {
 			t.log.Infof("instance is not startable, clear the ready state: %s", reason)
 			t.clearPending()
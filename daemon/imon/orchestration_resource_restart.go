@@ -6,6 +6,7 @@ import (
 	"github.com/rs/zerolog"
 
 	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/maintenance"
 	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/provisioned"
@@ -117,6 +118,19 @@ func (t *Manager) pubMonitorAction(rid string, action instance.MonitorAction) {
 		t.labelLocalhost)
 }
 
+func (t *Manager) pubMonitorFlapping(rid string, rmon *instance.ResourceMonitor) {
+	t.pubsubBus.Pub(
+		&msgbus.InstanceMonitorFlapping{
+			Path:       t.path,
+			Node:       t.localhost,
+			RID:        rid,
+			IsFlapping: rmon.Restart.IsFlapping,
+			Count:      rmon.Restart.Count,
+		},
+		t.labelPath,
+		t.labelLocalhost)
+}
+
 func (t *Manager) orchestrateResourceRestart() {
 	todoRestart := newTodoMap()
 	todoStandby := newTodoMap()
@@ -135,9 +149,29 @@ func (t *Manager) orchestrateResourceRestart() {
 		if rmon.Restart.Remaining != rcfg.Restart {
 			t.log.Infof("resource %s is up, reset restart count to the max (%d -> %d)", rid, rmon.Restart.Remaining, rcfg.Restart)
 			t.state.MonitorActionExecutedAt = time.Time{}
-			rmon.Restart.Remaining = rcfg.Restart
-			t.state.Resources.Set(rid, *rmon)
-			t.change = true
+		} else if rmon.Restart.Count == 0 && !rmon.Restart.IsFlapping {
+			return
+		}
+		if rmon.ResetRestart(rcfg.Restart) {
+			t.log.Infof("resource %s is stable, no longer flapping", rid)
+			t.pubMonitorFlapping(rid, rmon)
+		}
+		t.state.Resources.Set(rid, *rmon)
+		t.change = true
+	}
+
+	// isStable returns true if the restarted resource has been up for more
+	// than its restart window.
+	isStable := func(rcfg *instance.ResourceConfig, rmon *instance.ResourceMonitor) bool {
+		switch {
+		case rmon.Restart.Count == 0:
+			return false
+		case rmon.Restart.UpAt.IsZero():
+			return false
+		case rcfg.RestartWindow == nil:
+			return true
+		default:
+			return time.Since(rmon.Restart.UpAt) >= *rcfg.RestartWindow
 		}
 	}
 
@@ -155,6 +189,9 @@ func (t *Manager) orchestrateResourceRestart() {
 	planFor := func(rid string, resStatus status.T, started bool) {
 		rcfg := t.instConfig.Resources.Get(rid)
 		rmon := t.state.Resources.Get(rid)
+		if rcfg != nil && rmon != nil && isStable(rcfg, rmon) {
+			resetRemaining(rid, rcfg, rmon)
+		}
 		switch {
 		case rcfg == nil:
 			return
@@ -168,7 +205,16 @@ func (t *Manager) orchestrateResourceRestart() {
 			resetRemainingAndTimer(rid, rcfg, rmon)
 		case resStatus.Is(status.Up, status.StandbyUp):
 			t.log.Debugf("resource %s restart skip: status=%s", rid, resStatus)
-			resetRemainingAndTimer(rid, rcfg, rmon)
+			resetTimer(rid, rmon)
+			switch {
+			case rcfg.RestartWindow == nil, rmon.Restart.Count == 0:
+				resetRemaining(rid, rcfg, rmon)
+			case rmon.Restart.UpAt.IsZero():
+				t.log.Infof("resource %s is up, reset restart count if still up in %s", rid, *rcfg.RestartWindow)
+				rmon.Restart.UpAt = time.Now()
+				t.state.Resources.Set(rid, *rmon)
+				t.change = true
+			}
 		case rmon.Restart.Timer != nil:
 			t.log.Debugf("resource %s restart skip: already has a delay timer", rid)
 		case !t.state.MonitorActionExecutedAt.IsZero():
@@ -205,8 +251,8 @@ func (t *Manager) orchestrateResourceRestart() {
 			if rmon == nil {
 				continue
 			}
-			if rcfg.RestartDelay != nil {
-				notBefore := rmon.Restart.LastAt.Add(*rcfg.RestartDelay)
+			if restartDelay := rcfg.NextRestartDelay(rmon.Restart.Count); restartDelay > 0 {
+				notBefore := rmon.Restart.LastAt.Add(restartDelay)
 				if now.Before(notBefore) {
 					delay := notBefore.Sub(now)
 					if delay > maxDelay {
@@ -247,6 +293,10 @@ func (t *Manager) orchestrateResourceRestart() {
 				continue
 			}
 			rmon.DecRestartRemaining()
+			if rmon.IncRestartCount() {
+				t.log.Warnf("resource %s is flapping: %d restarts without being stable", rid, rmon.Restart.Count)
+				t.pubMonitorFlapping(rid, rmon)
+			}
 			rmon.Restart.Timer = timer
 			t.state.Resources.Set(rid, *rmon)
 			t.change = true
@@ -281,6 +331,10 @@ func (t *Manager) orchestrateResourceRestart() {
 				continue
 			}
 			rmon.DecRestartRemaining()
+			if rmon.IncRestartCount() {
+				t.log.Warnf("resource %s is flapping: %d restarts without being stable", rid, rmon.Restart.Count)
+				t.pubMonitorFlapping(rid, rmon)
+			}
 			rmon.Restart.Timer = timer
 			t.state.Resources.Set(rid, *rmon)
 			t.change = true
@@ -317,6 +371,12 @@ func (t *Manager) orchestrateResourceRestart() {
 		return
 	}
 
+	// don't run during a maintenance window not allowing restarts
+	if !t.isMaintenanceAllowed(maintenance.Restart) {
+		resetTimers()
+		return
+	}
+
 	if t.state.LocalExpect == instance.MonitorLocalExpectEvicted && t.state.State == instance.MonitorStateStopFailed {
 		t.state.MonitorActionExecutedAt = time.Now()
 		t.state.LocalExpect = instance.MonitorLocalExpectNone
@@ -361,4 +421,50 @@ func (t *Manager) orchestrateResourceRestart() {
 	}
 	doStandby()
 	doRestart()
+	t.armRestartWindowTimer()
+}
+
+// armRestartWindowTimer arms the restart window timer to the earliest
+// restart window expiry of the restarted resources seen up, or stops it if
+// no such resource is waiting to be considered stable.
+func (t *Manager) armRestartWindowTimer() {
+	var (
+		next  time.Duration
+		found bool
+	)
+	for rid := range t.instStatus[t.localhost].Resources {
+		rcfg := t.instConfig.Resources.Get(rid)
+		rmon := t.state.Resources.Get(rid)
+		switch {
+		case rcfg == nil, rmon == nil:
+			continue
+		case rcfg.RestartWindow == nil:
+			continue
+		case rmon.Restart.Count == 0, rmon.Restart.UpAt.IsZero():
+			continue
+		}
+		d := time.Until(rmon.Restart.UpAt.Add(*rcfg.RestartWindow))
+		if d < 0 {
+			d = 0
+		}
+		if !found || d < next {
+			next, found = d, true
+		}
+	}
+	if !t.restartWindowTimer.Stop() {
+		select {
+		case <-t.restartWindowTimer.C:
+		default:
+		}
+	}
+	if found {
+		t.restartWindowTimer.Reset(next)
+	}
+}
+
+// onRestartWindowTimer orchestrates when a restart window expires, so the
+// restart counters of a resource still up are reset.
+func (t *Manager) onRestartWindowTimer() {
+	t.orchestrate()
+	t.updateIfChange()
 }
@@ -0,0 +1,163 @@
+package imon
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/keyop"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/file"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+var (
+	keyScalerID    = key.New("DEFAULT", "id")
+	keyScalerScale = key.New("DEFAULT", "scale")
+)
+
+// isScaler returns true if the object is a scaler. The scaler instances are
+// never started, the daemon creates and orchestrates its slices instead.
+func (t *Manager) isScaler() bool {
+	return t.instConfig.Scale != nil
+}
+
+// orchestrateScaler creates, updates and deletes the slices of a scaler
+// object to honor its scale value.
+//
+// The slices are managed by the scaler instance monitor of the cluster
+// speaker node. The other nodes fetch the slices configs like any other
+// object config.
+//
+// The slices config files are written by a goroutine, out of the instance
+// monitor loop. The slices needing a write while a previous write is still
+// in progress are handled when it ends.
+func (t *Manager) orchestrateScaler() {
+	if !t.nodeStatus[t.localhost].IsLeader {
+		return
+	}
+	scale := *t.instConfig.Scale
+	switch t.state.GlobalExpect {
+	case instance.MonitorGlobalExpectDeleted, instance.MonitorGlobalExpectPurged:
+		// the slices are deleted with the scaler
+		scale = 0
+	}
+	var todo []naming.Path
+	for i := 0; i < scale; i++ {
+		p := t.path.ScalerSlice(i)
+		if mtime := file.ModTime(p.ConfigFile()); mtime.IsZero() || mtime.Before(t.instConfig.UpdatedAt) {
+			todo = append(todo, p)
+		}
+	}
+	switch {
+	case len(todo) == 0:
+	case t.scalerSlicesWriting:
+		t.scalerSlicesPending = true
+	default:
+		t.scalerSlicesWriting = true
+		go t.ensureScalerSlices(todo)
+	}
+	for p := range instance.ConfigData.GetByNode(t.localhost) {
+		if p.IsScalerSliceOf(t.path) && p.ScalerSliceIndex() >= scale {
+			t.deleteScalerSlice(p)
+		}
+	}
+}
+
+// ensureScalerSlices writes the slices config files, then notifies the
+// instance monitor loop the write is done.
+func (t *Manager) ensureScalerSlices(paths []naming.Path) {
+	for _, p := range paths {
+		t.ensureScalerSlice(p)
+	}
+	select {
+	case <-t.ctx.Done():
+	case t.cmdC <- cmdScalerSlicesWritten{}:
+	}
+}
+
+// onScalerSlicesWritten orchestrates again if some slices needed a write
+// while the previous write was in progress.
+func (t *Manager) onScalerSlicesWritten() {
+	t.scalerSlicesWriting = false
+	if !t.scalerSlicesPending {
+		return
+	}
+	t.scalerSlicesPending = false
+	t.orchestrate()
+	t.updateIfChange()
+}
+
+// ensureScalerSlice writes the slice config file, creating it if it does
+// not exist.
+func (t *Manager) ensureScalerSlice(p naming.Path) {
+	exists := file.Exists(p.ConfigFile())
+	if err := t.writeScalerSlice(p); err != nil {
+		t.log.Errorf("scaler slice %s: %s", p, err)
+		return
+	}
+	if exists {
+		t.log.Infof("scaler slice %s updated", p)
+	} else {
+		t.log.Infof("scaler slice %s created", p)
+	}
+}
+
+// writeScalerSlice writes the slice config file from the scaler config,
+// without the scale keyword. The slice id is preserved on update.
+func (t *Manager) writeScalerSlice(p naming.Path) error {
+	b, err := os.ReadFile(t.path.ConfigFile())
+	if err != nil {
+		return err
+	}
+	var id string
+	if file.Exists(p.ConfigFile()) {
+		if o, err := object.New(p, object.WithVolatile(true)); err != nil {
+			return fmt.Errorf("load current config: %w", err)
+		} else {
+			id = o.(object.Configurer).Config().Get(keyScalerID)
+		}
+	}
+	o, err := object.New(p, object.WithConfigData(b))
+	if err != nil {
+		return err
+	}
+	cf := o.(object.Configurer).Config()
+	if err := cf.PrepareUnset(keyScalerScale, keyScalerID); err != nil {
+		return err
+	}
+	if id != "" {
+		op := keyop.T{
+			Key:   keyScalerID,
+			Op:    keyop.Set,
+			Value: id,
+		}
+		if err := cf.PrepareSet(op); err != nil {
+			return err
+		}
+	}
+	return cf.Recommit()
+}
+
+// deleteScalerSlice asks for the orchestrated deletion of a slice beyond the
+// scaler scale.
+func (t *Manager) deleteScalerSlice(p naming.Path) {
+	if instMon := instance.MonitorData.Get(p, t.localhost); instMon != nil && instMon.GlobalExpect == instance.MonitorGlobalExpectDeleted {
+		return
+	}
+	t.log.Infof("scaler slice %s is beyond scale %d: delete", p, *t.instConfig.Scale)
+	globalExpect := instance.MonitorGlobalExpectDeleted
+	t.pubsubBus.Pub(&msgbus.SetInstanceMonitor{
+		Path: p,
+		Node: t.localhost,
+		Value: instance.MonitorUpdate{
+			GlobalExpect:             &globalExpect,
+			CandidateOrchestrationID: uuid.New(),
+		},
+	}, pubsub.Label{"path", p.String()}, t.labelLocalhost)
+}
@@ -0,0 +1,166 @@
+package imon
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/clusternode"
+	"github.com/opensvc/om3/core/maintenance"
+	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/core/topology"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/xmap"
+)
+
+// isStonithRequired returns true if the object asks for its lost peers
+// to be fenced before a takeover.
+func (t *Manager) isStonithRequired() bool {
+	return t.instConfig.Stonith && t.instConfig.Topology == topology.Failover
+}
+
+// wasUpOn returns true if the last known instance status of the peer node
+// was up.
+func (t *Manager) wasUpOn(peer string) bool {
+	instStatus, ok := t.instStatus[peer]
+	if !ok {
+		return false
+	}
+	switch instStatus.Avail {
+	case status.Up, status.Warn:
+		return true
+	default:
+		return false
+	}
+}
+
+// stonithCandidateFromDeleted records the peer as a stonith candidate when
+// its instance status is dropped while the instance was up. The ForgetPeer
+// event that follows a peer loss will turn the candidate into a pending
+// stonith.
+func (t *Manager) stonithCandidateFromDeleted(peer string) {
+	if peer == t.localhost || !t.isStonithRequired() {
+		return
+	}
+	if t.wasUpOn(peer) {
+		t.stonithCandidates[peer] = true
+	}
+}
+
+// onForgetPeer adds the peer to the pending stonith list if it was lost
+// (not removed from the cluster) while our object instance was up on it.
+//
+// The InstanceStatusDeleted event of the peer may be processed before or
+// after this event, so both the stonith candidates and the instance status
+// cache are verified.
+func (t *Manager) onForgetPeer(c *msgbus.ForgetPeer) {
+	peer := c.Node
+	isCandidate := t.stonithCandidates[peer] || t.wasUpOn(peer)
+	delete(t.stonithCandidates, peer)
+	if !isCandidate || !t.isStonithRequired() {
+		return
+	}
+	if !clusternode.Has(peer) {
+		// removed from the cluster, not lost.
+		return
+	}
+	if slices.Contains(t.state.StonithPending, peer) {
+		return
+	}
+	t.log.Warnf("lost peer %s had the instance up: wait for its stonith before takeover", peer)
+	// the state maps and slices are shared with the published values:
+	// replace them instead of modifying them in place.
+	requests := xmap.Copy(t.state.StonithRequests)
+	requests[peer] = uuid.New()
+	t.state.StonithRequests = requests
+	t.state.StonithPending = append(slices.Clone(t.state.StonithPending), peer)
+	t.change = true
+	t.onChange()
+}
+
+// updateStonithPending drops from the pending stonith list the peers fenced
+// for our stonith requests, as reported by the node status stonith
+// histories.
+func (t *Manager) updateStonithPending() {
+	if len(t.state.StonithPending) == 0 {
+		return
+	}
+	pending := make([]string, 0, len(t.state.StonithPending))
+	for _, peer := range t.state.StonithPending {
+		if t.isStonithDone(peer) {
+			t.log.Infof("lost peer %s stonith done", peer)
+			continue
+		}
+		pending = append(pending, peer)
+	}
+	t.setStonithPending(pending)
+}
+
+// cancelStonithPending drops the peer from the pending stonith list, when
+// the peer is alive again.
+func (t *Manager) cancelStonithPending(peer string) {
+	if !slices.Contains(t.state.StonithPending, peer) {
+		return
+	}
+	t.log.Infof("lost peer %s is back: cancel its pending stonith", peer)
+	t.setStonithPending(slices.DeleteFunc(slices.Clone(t.state.StonithPending), func(s string) bool {
+		return s == peer
+	}))
+}
+
+// setStonithPending sets the pending stonith list, and drops the stonith
+// requests of the peers no longer pending.
+func (t *Manager) setStonithPending(pending []string) {
+	if len(pending) == len(t.state.StonithPending) {
+		return
+	}
+	var requests map[string]uuid.UUID
+	if len(pending) == 0 {
+		pending = nil
+	} else {
+		// replace the published map instead of modifying it in place.
+		requests = make(map[string]uuid.UUID, len(pending))
+		for _, peer := range pending {
+			if id, ok := t.state.StonithRequests[peer]; ok {
+				requests[peer] = id
+			}
+		}
+	}
+	t.state.StonithRequests = requests
+	t.state.StonithPending = pending
+	t.change = true
+}
+
+// isStonithDone returns true if a node status reports a successful stonith
+// of the peer fulfilling our stonith request. The request ids are used
+// instead of the peer loss time, because the node clocks may differ.
+func (t *Manager) isStonithDone(peer string) bool {
+	id, ok := t.state.StonithRequests[peer]
+	if !ok {
+		return false
+	}
+	for _, nodeStatus := range t.nodeStatus {
+		if nodeStatus.IsStonithDone(peer, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// isHAStartable is isStartable with the extra stonith constraint: the
+// takeover is blocked until all the lost peers where the instance was up
+// are fenced. An explicit start request is not subject to this constraint.
+func (t *Manager) isHAStartable() (bool, string) {
+	if v, reason := t.isStartable(); !v {
+		return false, reason
+	}
+	if len(t.state.StonithPending) > 0 {
+		return false, fmt.Sprintf("waiting stonith of lost peers %s", strings.Join(t.state.StonithPending, " "))
+	}
+	if !t.isMaintenanceAllowed(maintenance.Start) {
+		return false, "start suspended by the maintenance window"
+	}
+	return true, "object is startable"
+}
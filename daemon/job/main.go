@@ -0,0 +1,287 @@
+/*
+Package job tracks the asynchronous action jobs submitted to the daemon api.
+
+An object action job is identified by the orchestration id, and an instance
+action job by the session id of its command. Each node records the commands
+it executes for a job, with their exit code and captured output, so the api
+can merge the nodes records into the cluster view of a job.
+*/
+package job
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+	"github.com/opensvc/om3/util/hostname"
+)
+
+type (
+	// T is a bounded store of the jobs known by the local node. It records
+	// the jobs submitted to the local node, and the commands executed by the
+	// local node for the jobs submitted to any node.
+	T struct {
+		mu        sync.RWMutex
+		jobs      map[uuid.UUID]*api.Job
+		order     []uuid.UUID
+		max       int
+		localhost string
+	}
+)
+
+var (
+	// DefaultSize is the maximum number of jobs kept by the daemon store.
+	DefaultSize = 1000
+
+	// Jobs is the daemon job store.
+	Jobs = New(DefaultSize)
+
+	// maxOutputSize is the maximum size of the stdout and stderr captured
+	// for a command. The last bytes are kept.
+	maxOutputSize = 64 * 1024
+)
+
+// New returns a job store keeping the <max> most recent jobs.
+func New(max int) *T {
+	return &T{
+		jobs:      make(map[uuid.UUID]*api.Job),
+		max:       max,
+		localhost: hostname.Hostname(),
+	}
+}
+
+// Submit records the job <id> submitted to the local node, for the action
+// <action> on the object <p>.
+func (t *T) Submit(id uuid.UUID, typ api.JobType, p naming.Path, action string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	job := t.getOrCreate(id, p)
+	job.Type = typ
+	job.Node = t.localhost
+	job.Action = action
+	job.State = api.Queued
+}
+
+// Forget removes the job <id>, for example when its submission is refused.
+func (t *T) Forget(id uuid.UUID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.jobs[id]; !ok {
+		return
+	}
+	delete(t.jobs, id)
+	for i, v := range t.order {
+		if v == id {
+			t.order = append(t.order[:i], t.order[i+1:]...)
+			break
+		}
+	}
+}
+
+// ExecStarted records the start of the command <command> executed by the
+// local node for the job <id>, and returns the index to pass to ExecEnded.
+func (t *T) ExecStarted(id uuid.UUID, p naming.Path, title, command string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	job := t.getOrCreate(id, p)
+	if job.StartedAt == nil {
+		job.StartedAt = &now
+	}
+	if job.State == api.Queued {
+		job.State = api.Running
+	}
+	if len(job.Instances) == 0 {
+		job.Instances = []api.JobInstance{{Node: t.localhost, Execs: make([]api.JobExec, 0)}}
+	}
+	inst := &job.Instances[0]
+	inst.Execs = append(inst.Execs, api.JobExec{
+		Title:     title,
+		Command:   command,
+		StartedAt: now,
+	})
+	return len(inst.Execs) - 1
+}
+
+// ExecEnded records the exit code and output of the command <index> of the
+// job <id>.
+func (t *T) ExecEnded(id uuid.UUID, index int, exitCode int, stdout, stderr []byte, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	job, ok := t.jobs[id]
+	if !ok || len(job.Instances) == 0 || index >= len(job.Instances[0].Execs) {
+		// evicted
+		return
+	}
+	now := time.Now()
+	exec := &job.Instances[0].Execs[index]
+	exec.EndedAt = &now
+	exec.ExitCode = &exitCode
+	exec.Stdout = truncate(stdout)
+	exec.Stderr = truncate(stderr)
+	if err != nil {
+		s := err.Error()
+		exec.Error = &s
+	}
+}
+
+// End marks the job <id> submitted to the local node as ended.
+func (t *T) End(id uuid.UUID) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if job, ok := t.jobs[id]; ok {
+		t.end(job)
+	}
+}
+
+// EndOrchestration marks the orchestration job <id> submitted to the local
+// node for the object <p> as ended. An orchestration, like an abort, can
+// replace the running one, so the jobs of the object submitted before <id>
+// and still running are ended too. The jobs submitted after <id> are not
+// ended.
+func (t *T) EndOrchestration(id uuid.UUID, p naming.Path) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.jobs[id]; !ok {
+		return
+	}
+	s := p.String()
+	for _, v := range t.order {
+		job := t.jobs[v]
+		if v == id {
+			t.end(job)
+			return
+		}
+		if job.Path == s && job.Type == api.Orchestration {
+			t.end(job)
+		}
+	}
+}
+
+// EndPath marks the orchestration jobs submitted to the local node for the
+// object <p> as ended, for example when the object is deleted.
+func (t *T) EndPath(p naming.Path) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	s := p.String()
+	for _, job := range t.jobs {
+		if job.Path == s && job.Type == api.Orchestration {
+			t.end(job)
+		}
+	}
+}
+
+func (t *T) end(job *api.Job) {
+	if job.Node != t.localhost || job.EndedAt != nil {
+		return
+	}
+	now := time.Now()
+	job.EndedAt = &now
+	job.State = endState(job.Instances)
+}
+
+// Get returns a copy of the local node view of the job <id>.
+func (t *T) Get(id uuid.UUID) (api.Job, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	job, ok := t.jobs[id]
+	if !ok {
+		return api.Job{}, false
+	}
+	return deepCopy(*job), true
+}
+
+func (t *T) getOrCreate(id uuid.UUID, p naming.Path) *api.Job {
+	if job, ok := t.jobs[id]; ok {
+		return job
+	}
+	job := &api.Job{
+		Id:        id,
+		Path:      p.String(),
+		State:     api.Unknown,
+		CreatedAt: time.Now(),
+		Instances: make([]api.JobInstance, 0),
+	}
+	t.jobs[id] = job
+	t.order = append(t.order, id)
+	if n := len(t.order) - t.max; n > 0 {
+		for _, evicted := range t.order[:n] {
+			delete(t.jobs, evicted)
+		}
+		t.order = t.order[n:]
+	}
+	return job
+}
+
+// Merge returns the cluster view of a job from the nodes views <l>. The
+// submit node view holds the job attributes, and each node view holds the
+// commands executed by this node.
+func Merge(l []api.Job) (api.Job, bool) {
+	var (
+		merged api.Job
+		found  bool
+	)
+	for _, job := range l {
+		if !found || job.Node != "" {
+			instances := merged.Instances
+			merged = job
+			merged.Instances = instances
+			found = true
+		}
+	}
+	if !found {
+		return merged, false
+	}
+	merged.Instances = make([]api.JobInstance, 0)
+	for _, job := range l {
+		merged.Instances = append(merged.Instances, job.Instances...)
+		if job.StartedAt != nil && (merged.StartedAt == nil || job.StartedAt.Before(*merged.StartedAt)) {
+			merged.StartedAt = job.StartedAt
+		}
+	}
+	switch {
+	case merged.Node == "":
+		// the submit node view is not available
+		merged.State = api.Unknown
+	case merged.EndedAt != nil:
+		merged.State = endState(merged.Instances)
+	case merged.State == api.Queued && merged.StartedAt != nil:
+		merged.State = api.Running
+	}
+	return merged, true
+}
+
+// endState returns the state of an ended job executing the commands of
+// <instances>.
+func endState(instances []api.JobInstance) api.JobState {
+	for _, inst := range instances {
+		for _, exec := range inst.Execs {
+			if exec.ExitCode == nil || *exec.ExitCode != 0 || exec.Error != nil {
+				return api.Failed
+			}
+		}
+	}
+	return api.Succeeded
+}
+
+func truncate(b []byte) string {
+	if n := len(b) - maxOutputSize; n > 0 {
+		b = b[n:]
+	}
+	return string(b)
+}
+
+func deepCopy(job api.Job) api.Job {
+	instances := make([]api.JobInstance, len(job.Instances))
+	for i, inst := range job.Instances {
+		instances[i] = api.JobInstance{
+			Node:  inst.Node,
+			Execs: append(make([]api.JobExec, 0, len(inst.Execs)), inst.Execs...),
+		}
+	}
+	job.Instances = instances
+	return job
+}
@@ -0,0 +1,148 @@
+package job
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/api"
+)
+
+func TestJobs(t *testing.T) {
+	p := naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: "foo"}
+
+	t.Run("an exec job ends with its command", func(t *testing.T) {
+		jobs := New(10)
+		id := uuid.New()
+		jobs.Submit(id, api.Exec, p, "foo start --local")
+		i := jobs.ExecStarted(id, p, "start", "om foo start --local")
+
+		j, ok := jobs.Get(id)
+		require.True(t, ok)
+		require.Equal(t, api.Running, j.State)
+		require.NotNil(t, j.StartedAt)
+		require.Nil(t, j.Instances[0].Execs[0].ExitCode)
+
+		jobs.ExecEnded(id, i, 1, []byte("out"), []byte("err"), errors.New("exit status 1"))
+		jobs.End(id)
+
+		j, ok = jobs.Get(id)
+		require.True(t, ok)
+		require.Equal(t, api.Failed, j.State)
+		require.NotNil(t, j.EndedAt)
+		exec := j.Instances[0].Execs[0]
+		require.Equal(t, 1, *exec.ExitCode)
+		require.Equal(t, "out", exec.Stdout)
+		require.Equal(t, "err", exec.Stderr)
+	})
+
+	t.Run("the object deletion ends the jobs of the object", func(t *testing.T) {
+		jobs := New(10)
+		replaced, id := uuid.New(), uuid.New()
+		jobs.Submit(replaced, api.Orchestration, p, "stopped")
+		jobs.Submit(id, api.Orchestration, p, "started")
+		jobs.EndPath(p)
+		for _, v := range []uuid.UUID{replaced, id} {
+			j, _ := jobs.Get(v)
+			require.Equal(t, api.Succeeded, j.State)
+		}
+	})
+
+	t.Run("the end of an orchestration ends its job and the replaced ones", func(t *testing.T) {
+		jobs := New(10)
+		other := naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: "other"}
+		replaced, id, next, otherID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+		jobs.Submit(replaced, api.Orchestration, p, "started")
+		jobs.Submit(otherID, api.Orchestration, other, "started")
+		jobs.Submit(id, api.Orchestration, p, "aborted")
+		jobs.Submit(next, api.Orchestration, p, "stopped")
+		jobs.EndOrchestration(id, p)
+		for _, v := range []uuid.UUID{replaced, id} {
+			j, _ := jobs.Get(v)
+			require.Equal(t, api.Succeeded, j.State)
+		}
+		for _, v := range []uuid.UUID{next, otherID} {
+			j, _ := jobs.Get(v)
+			require.Nil(t, j.EndedAt, "a job submitted later or on another object is not ended")
+			require.Equal(t, api.Queued, j.State)
+		}
+	})
+
+	t.Run("the jobs submitted to a peer are not ended", func(t *testing.T) {
+		jobs := New(10)
+		id := uuid.New()
+		jobs.ExecStarted(id, p, "start", "om foo start --local")
+		jobs.EndPath(p)
+		j, _ := jobs.Get(id)
+		require.Nil(t, j.EndedAt)
+		require.Equal(t, api.Unknown, j.State)
+	})
+
+	t.Run("the oldest jobs are evicted", func(t *testing.T) {
+		jobs := New(2)
+		ids := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+		for _, id := range ids {
+			jobs.Submit(id, api.Orchestration, p, "started")
+		}
+		_, ok := jobs.Get(ids[0])
+		require.False(t, ok)
+		_, ok = jobs.Get(ids[2])
+		require.True(t, ok)
+	})
+
+	t.Run("the output is truncated", func(t *testing.T) {
+		jobs := New(10)
+		id := uuid.New()
+		i := jobs.ExecStarted(id, p, "start", "om foo start --local")
+		jobs.ExecEnded(id, i, 0, []byte(strings.Repeat("a", maxOutputSize)+"b"), nil, nil)
+		j, _ := jobs.Get(id)
+		stdout := j.Instances[0].Execs[0].Stdout
+		require.Len(t, stdout, maxOutputSize)
+		require.True(t, strings.HasSuffix(stdout, "b"))
+	})
+}
+
+func TestMerge(t *testing.T) {
+	_, ok := Merge(nil)
+	require.False(t, ok)
+
+	p := naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: "foo"}
+	id := uuid.New()
+
+	submitter := New(10)
+	submitter.localhost = "n1"
+	submitter.Submit(id, api.Orchestration, p, "started")
+	submitter.ExecEnded(id, submitter.ExecStarted(id, p, "start", "om foo start --local"), 0, nil, nil, nil)
+
+	peer := New(10)
+	peer.localhost = "n2"
+	peer.ExecEnded(id, peer.ExecStarted(id, p, "start", "om foo start --local"), 0, nil, nil, nil)
+
+	view := func(jobs *T) api.Job {
+		j, _ := jobs.Get(id)
+		return j
+	}
+
+	merged, ok := Merge([]api.Job{view(peer), view(submitter)})
+	require.True(t, ok)
+	require.Equal(t, "n1", merged.Node)
+	require.Equal(t, api.Running, merged.State)
+	require.Len(t, merged.Instances, 2)
+
+	submitter.End(id)
+	merged, _ = Merge([]api.Job{view(submitter), view(peer)})
+	require.Equal(t, api.Succeeded, merged.State)
+
+	peer = New(10)
+	peer.localhost = "n2"
+	peer.ExecEnded(id, peer.ExecStarted(id, p, "start", "om foo start --local"), 1, nil, nil, nil)
+	merged, _ = Merge([]api.Job{view(submitter), view(peer)})
+	require.Equal(t, api.Failed, merged.State, "a peer command failure fails the job")
+
+	merged, _ = Merge([]api.Job{view(peer)})
+	require.Equal(t, api.Unknown, merged.State, "the submit node view is missing")
+}
@@ -0,0 +1,78 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	// Tracker ends the orchestration jobs submitted to the local node when
+	// their orchestration ends, or when their object is deleted.
+	Tracker struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		log    *plog.Logger
+		wg     sync.WaitGroup
+
+		subQS pubsub.QueueSizer
+	}
+)
+
+func NewTracker(subQS pubsub.QueueSizer) *Tracker {
+	return &Tracker{
+		log: plog.NewDefaultLogger().
+			Attr("pkg", "daemon/job").
+			WithPrefix("daemon: job: "),
+		subQS: subQS,
+	}
+}
+
+func (t *Tracker) Start(parent context.Context) error {
+	t.ctx, t.cancel = context.WithCancel(parent)
+	labelLocalhost := pubsub.Label{"node", hostname.Hostname()}
+	sub := pubsub.BusFromContext(t.ctx).Sub("daemon.job", t.subQS)
+	sub.AddFilter(&msgbus.ObjectOrchestrationEnd{}, labelLocalhost)
+	sub.AddFilter(&msgbus.ObjectStatusDeleted{}, labelLocalhost)
+	sub.Start()
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		defer func() {
+			if err := sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Errorf("subscription stop error %s", err)
+			}
+		}()
+		for {
+			select {
+			case <-t.ctx.Done():
+				return
+			case i := <-sub.C:
+				switch m := i.(type) {
+				case *msgbus.ObjectOrchestrationEnd:
+					if id, err := uuid.Parse(m.ID); err != nil {
+						t.log.Warnf("%s: orchestration end with invalid id %s", m.Path, m.ID)
+					} else {
+						Jobs.EndOrchestration(id, m.Path)
+					}
+				case *msgbus.ObjectStatusDeleted:
+					Jobs.EndPath(m.Path)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (t *Tracker) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
@@ -54,6 +54,10 @@ func (t *T) Start(ctx context.Context) error {
 	} else {
 		t.listener = &listener
 	}
+	if err := os.Chmod(t.addr, socketMode); err != nil {
+		t.log.Errorf("chmod: %s", err)
+		return err
+	}
 	t.wg.Add(1)
 	go func(errC chan<- error) {
 		defer t.wg.Done()
@@ -61,8 +65,9 @@ func (t *T) Start(ctx context.Context) error {
 
 		s := &http2.Server{}
 		server := http.Server{
-			Handler:  h2c.NewHandler(routehttp.New(ctx, false), s),
-			ErrorLog: golog.New(t.log.Logger(), "", 0),
+			Handler:     h2c.NewHandler(routehttp.New(ctx, false), s),
+			ErrorLog:    golog.New(t.log.Logger(), "", 0),
+			ConnContext: t.connContext,
 		}
 		t.log.Infof("started")
 		errC <- nil
@@ -75,6 +80,17 @@ func (t *T) Start(ctx context.Context) error {
 	return <-errC
 }
 
+// connContext adds the peer credentials of the accepted connection <c> to
+// the context of its requests, for the ux auth strategy.
+func (t *T) connContext(ctx context.Context, c net.Conn) context.Context {
+	cred, err := peerCred(c)
+	if err != nil {
+		t.log.Warnf("%s", err)
+		return ctx
+	}
+	return daemonctx.WithPeerCred(ctx, cred)
+}
+
 func (t *T) Stop() error {
 	t.log.Infof("stopping")
 	defer t.log.Infof("stopped")
@@ -0,0 +1,22 @@
+//go:build !linux
+
+package lsnrhttpux
+
+import (
+	"net"
+	"os"
+
+	"github.com/opensvc/om3/daemon/daemonctx"
+)
+
+// socketMode is the unix socket file mode. The peer credentials are not
+// supported on this os, so the socket is kept accessible to the daemon
+// user only.
+const socketMode os.FileMode = 0600
+
+// peerCred returns the root credentials, as the socket restricted to the
+// daemon user grants its clients root, like before the peer credentials
+// mapping.
+func peerCred(conn net.Conn) (daemonctx.PeerCredentials, error) {
+	return daemonctx.PeerCredentials{}, nil
+}
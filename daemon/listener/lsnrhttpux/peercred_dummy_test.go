@@ -0,0 +1,33 @@
+//go:build !linux
+
+package lsnrhttpux
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerCredUnsupported(t *testing.T) {
+	require.Equal(t, os.FileMode(0600), socketMode)
+
+	addr := filepath.Join(t.TempDir(), "test.sock")
+	listener, err := net.Listen("unix", addr)
+	require.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+
+	client, err := net.Dial("unix", addr)
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	cred, err := peerCred(conn)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), cred.UID, "expected the root credentials")
+}
@@ -0,0 +1,46 @@
+//go:build linux
+
+package lsnrhttpux
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/opensvc/om3/daemon/daemonctx"
+)
+
+// socketMode is the unix socket file mode. The ux auth strategy grants the
+// clients from their peer credentials, so the socket can be opened to all
+// local users.
+const socketMode os.FileMode = 0666
+
+// peerCred returns the credentials of the process connected to the unix
+// socket <conn>, using SO_PEERCRED.
+func peerCred(conn net.Conn) (cred daemonctx.PeerCredentials, err error) {
+	unixConn, ok := conn.(*net.UnixConn)
+	if !ok {
+		err = fmt.Errorf("peer credentials: unexpected connection type %T", conn)
+		return
+	}
+	rawConn, err := unixConn.SyscallConn()
+	if err != nil {
+		return
+	}
+	var (
+		ucred   *unix.Ucred
+		credErr error
+	)
+	if err = rawConn.Control(func(fd uintptr) {
+		ucred, credErr = unix.GetsockoptUcred(int(fd), unix.SOL_SOCKET, unix.SO_PEERCRED)
+	}); err != nil {
+		return
+	} else if credErr != nil {
+		err = fmt.Errorf("peer credentials: %w", credErr)
+		return
+	}
+	cred = daemonctx.PeerCredentials{PID: ucred.Pid, UID: ucred.Uid, GID: ucred.Gid}
+	return
+}
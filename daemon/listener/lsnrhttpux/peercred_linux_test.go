@@ -0,0 +1,36 @@
+package lsnrhttpux
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPeerCred(t *testing.T) {
+	require.Equal(t, os.FileMode(0666), socketMode)
+
+	addr := filepath.Join(t.TempDir(), "test.sock")
+	listener, err := net.Listen("unix", addr)
+	require.NoError(t, err)
+	defer func() { _ = listener.Close() }()
+
+	client, err := net.Dial("unix", addr)
+	require.NoError(t, err)
+	defer func() { _ = client.Close() }()
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	cred, err := peerCred(conn)
+	require.NoError(t, err)
+	require.Equal(t, uint32(os.Getuid()), cred.UID)
+	require.Equal(t, uint32(os.Getgid()), cred.GID)
+	require.Equal(t, int32(os.Getpid()), cred.PID)
+
+	_, err = peerCred(&net.TCPConn{})
+	require.ErrorContains(t, err, "unexpected connection type")
+}
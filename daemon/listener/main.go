@@ -35,6 +35,27 @@ func (a *authOption) ListenAddr(ctx context.Context) string {
 	return daemonctx.ListenAddr(ctx)
 }
 
+func (a *authOption) PeerCred(ctx context.Context) (daemonctx.PeerCredentials, bool) {
+	return daemonctx.PeerCred(ctx)
+}
+
+func (a *authOption) UxGrants() []string {
+	return cluster.ConfigData.Get().Listener.UxGrants
+}
+
+func (a *authOption) OpenIDConfig() daemonauth.OpenIDConfig {
+	config := cluster.ConfigData.Get()
+	clientID := config.Listener.OpenIDClientID
+	if clientID == "" {
+		clientID = config.Name
+	}
+	return daemonauth.OpenIDConfig{
+		WellKnown:  config.Listener.OpenIDWellKnown,
+		ClientID:   clientID,
+		GrantClaim: config.Listener.OpenIDGrantClaim,
+	}
+}
+
 func (a *authOption) X509CACertFile() string {
 	return daemonenv.CAsCertFile()
 }
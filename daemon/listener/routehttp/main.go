@@ -45,6 +45,7 @@ func New(ctx context.Context, enableUI bool) *T {
 	e.Use(daemonapi.AuthMiddleware(ctx))
 	e.Use(daemonapi.LogUserMiddleware(ctx))
 	e.Use(daemonapi.LogRequestMiddleWare(ctx))
+	e.Use(daemonapi.AuditMiddleware(ctx))
 	api.RegisterHandlers(e, daemonapi.New(ctx))
 	g := e.Group("/public/ui")
 	if enableUI {
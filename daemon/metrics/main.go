@@ -0,0 +1,234 @@
+/*
+Package metrics exposes the cluster, node, object and instance states known
+by the daemon as prometheus gauges.
+
+The gauges are computed on scrape from the daemon data caches, so they
+report the same cluster view as the daemon status. The state gauges have the
+current state as label and the value 1, so alert rules can select the
+unexpected states.
+*/
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/placement"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+)
+
+type (
+	// T is the daemon component registering the cache collector to the
+	// default prometheus registry.
+	T struct {
+		collector *collector
+	}
+
+	// collector is a prometheus collector of the daemon data caches.
+	collector struct{}
+)
+
+var (
+	objectAvailDesc = prometheus.NewDesc(
+		"opensvc_object_avail",
+		"The object aggregated availability status",
+		[]string{"path", "status"}, nil)
+
+	objectOverallDesc = prometheus.NewDesc(
+		"opensvc_object_overall",
+		"The object aggregated overall status",
+		[]string{"path", "status"}, nil)
+
+	objectFrozenDesc = prometheus.NewDesc(
+		"opensvc_object_frozen",
+		"The object aggregated frozen state: frozen, thawed, mixed or n/a",
+		[]string{"path", "state"}, nil)
+
+	objectProvisionedDesc = prometheus.NewDesc(
+		"opensvc_object_provisioned",
+		"The object aggregated provisioned state",
+		[]string{"path", "state"}, nil)
+
+	objectPlacementOptimalDesc = prometheus.NewDesc(
+		"opensvc_object_placement_optimal",
+		"1 if the object instances placement is optimal, else 0",
+		[]string{"path"}, nil)
+
+	objectUpInstancesDesc = prometheus.NewDesc(
+		"opensvc_object_up_instances",
+		"The number of up instances of the object",
+		[]string{"path"}, nil)
+
+	instanceAvailDesc = prometheus.NewDesc(
+		"opensvc_instance_avail",
+		"The instance availability status",
+		[]string{"path", "node", "status"}, nil)
+
+	instanceOverallDesc = prometheus.NewDesc(
+		"opensvc_instance_overall",
+		"The instance overall status",
+		[]string{"path", "node", "status"}, nil)
+
+	instanceFrozenDesc = prometheus.NewDesc(
+		"opensvc_instance_frozen",
+		"1 if the instance is frozen, else 0",
+		[]string{"path", "node"}, nil)
+
+	instanceProvisionedDesc = prometheus.NewDesc(
+		"opensvc_instance_provisioned",
+		"The instance provisioned state",
+		[]string{"path", "node", "state"}, nil)
+
+	instanceMonitorStateDesc = prometheus.NewDesc(
+		"opensvc_instance_monitor_state",
+		"The instance monitor state",
+		[]string{"path", "node", "state"}, nil)
+
+	instanceMonitorGlobalExpectDesc = prometheus.NewDesc(
+		"opensvc_instance_monitor_global_expect",
+		"The global expect of the orchestration running on the instance",
+		[]string{"path", "node", "global_expect"}, nil)
+
+	nodeMonitorStateDesc = prometheus.NewDesc(
+		"opensvc_node_monitor_state",
+		"The node monitor state",
+		[]string{"node", "state"}, nil)
+
+	nodeFrozenDesc = prometheus.NewDesc(
+		"opensvc_node_frozen",
+		"1 if the node is frozen, else 0",
+		[]string{"node"}, nil)
+
+	nodeSpeakerDesc = prometheus.NewDesc(
+		"opensvc_node_speaker",
+		"1 if the node is the cluster speaker, else 0",
+		[]string{"node"}, nil)
+
+	nodeArbitratorStatusDesc = prometheus.NewDesc(
+		"opensvc_node_arbitrator_status",
+		"The arbitrator status, as seen by the node",
+		[]string{"node", "arbitrator", "status"}, nil)
+
+	nodeHeartbeatPeerBeatingDesc = prometheus.NewDesc(
+		"opensvc_node_heartbeat_peer_beating",
+		"1 if the heartbeat peer is beating, as seen by the node, else 0",
+		[]string{"node", "hb_id", "peer"}, nil)
+)
+
+func New() *T {
+	return &T{collector: &collector{}}
+}
+
+// Start registers the cache collector.
+func (t *T) Start(ctx context.Context) error {
+	return prometheus.Register(t.collector)
+}
+
+// Stop unregisters the cache collector.
+func (t *T) Stop() error {
+	prometheus.Unregister(t.collector)
+	return nil
+}
+
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		objectAvailDesc,
+		objectOverallDesc,
+		objectFrozenDesc,
+		objectProvisionedDesc,
+		objectPlacementOptimalDesc,
+		objectUpInstancesDesc,
+		instanceAvailDesc,
+		instanceOverallDesc,
+		instanceFrozenDesc,
+		instanceProvisionedDesc,
+		instanceMonitorStateDesc,
+		instanceMonitorGlobalExpectDesc,
+		nodeMonitorStateDesc,
+		nodeFrozenDesc,
+		nodeSpeakerDesc,
+		nodeArbitratorStatusDesc,
+		nodeHeartbeatPeerBeatingDesc,
+	} {
+		ch <- desc
+	}
+}
+
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	c.collectObjects(ch)
+	c.collectInstances(ch)
+	c.collectNodes(ch)
+}
+
+func (c *collector) collectObjects(ch chan<- prometheus.Metric) {
+	for _, e := range object.StatusData.GetAll() {
+		p := e.Path.String()
+		v := e.Value
+		ch <- gauge(objectAvailDesc, 1, p, v.Avail.String())
+		ch <- gauge(objectOverallDesc, 1, p, v.Overall.String())
+		ch <- gauge(objectFrozenDesc, 1, p, v.Frozen)
+		ch <- gauge(objectProvisionedDesc, 1, p, v.Provisioned.String())
+		ch <- gauge(objectUpInstancesDesc, float64(v.UpInstancesCount), p)
+		switch v.PlacementState {
+		case placement.Optimal:
+			ch <- gauge(objectPlacementOptimalDesc, 1, p)
+		case placement.NonOptimal:
+			ch <- gauge(objectPlacementOptimalDesc, 0, p)
+		}
+	}
+}
+
+func (c *collector) collectInstances(ch chan<- prometheus.Metric) {
+	for _, e := range instance.StatusData.GetAll() {
+		p := e.Path.String()
+		v := e.Value
+		ch <- gauge(instanceAvailDesc, 1, p, e.Node, v.Avail.String())
+		ch <- gauge(instanceOverallDesc, 1, p, e.Node, v.Overall.String())
+		ch <- gauge(instanceFrozenDesc, boolValue(!v.FrozenAt.IsZero()), p, e.Node)
+		ch <- gauge(instanceProvisionedDesc, 1, p, e.Node, v.Provisioned.String())
+	}
+	for _, e := range instance.MonitorData.GetAll() {
+		p := e.Path.String()
+		v := e.Value
+		ch <- gauge(instanceMonitorStateDesc, 1, p, e.Node, v.State.String())
+		if v.GlobalExpect != instance.MonitorGlobalExpectNone {
+			ch <- gauge(instanceMonitorGlobalExpectDesc, 1, p, e.Node, v.GlobalExpect.String())
+		}
+	}
+}
+
+func (c *collector) collectNodes(ch chan<- prometheus.Metric) {
+	for _, e := range node.MonitorData.GetAll() {
+		ch <- gauge(nodeMonitorStateDesc, 1, e.Node, e.Value.State.String())
+	}
+	for _, e := range node.StatusData.GetAll() {
+		v := e.Value
+		ch <- gauge(nodeFrozenDesc, boolValue(!v.FrozenAt.IsZero()), e.Node)
+		ch <- gauge(nodeSpeakerDesc, boolValue(v.IsLeader), e.Node)
+		for name, arbitrator := range v.Arbitrators {
+			ch <- gauge(nodeArbitratorStatusDesc, 1, e.Node, name, arbitrator.Status.String())
+		}
+	}
+	for _, e := range daemonsubsystem.DataHeartbeat.GetAll() {
+		for _, stream := range e.Value.Streams {
+			for peer, peerStatus := range stream.Peers {
+				ch <- gauge(nodeHeartbeatPeerBeatingDesc, boolValue(peerStatus.IsBeating), e.Node, stream.ID, peer)
+			}
+		}
+	}
+}
+
+func gauge(desc *prometheus.Desc, value float64, labelValues ...string) prometheus.Metric {
+	return prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, labelValues...)
+}
+
+func boolValue(v bool) float64 {
+	if v {
+		return 1
+	}
+	return 0
+}
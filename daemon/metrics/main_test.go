@@ -0,0 +1,88 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/placement"
+	"github.com/opensvc/om3/core/provisioned"
+	"github.com/opensvc/om3/core/status"
+)
+
+func TestCollector(t *testing.T) {
+	object.InitData()
+	instance.InitData()
+	node.InitData()
+
+	p := naming.Path{Namespace: "root", Kind: naming.KindSvc, Name: "foo"}
+	object.StatusData.Set(p, &object.Status{
+		Avail:            status.Up,
+		Overall:          status.Warn,
+		Frozen:           "thawed",
+		PlacementState:   placement.NonOptimal,
+		Provisioned:      provisioned.True,
+		UpInstancesCount: 1,
+	})
+	instance.StatusData.Set(p, "n1", &instance.Status{
+		Avail:    status.Up,
+		Overall:  status.Up,
+		FrozenAt: time.Now(),
+	})
+	instance.MonitorData.Set(p, "n1", &instance.Monitor{
+		State:        instance.MonitorStateStarting,
+		GlobalExpect: instance.MonitorGlobalExpectStarted,
+	})
+	node.MonitorData.Set("n1", &node.Monitor{State: node.MonitorStateIdle})
+	node.StatusData.Set("n1", &node.Status{
+		IsLeader: true,
+		Arbitrators: map[string]node.ArbitratorStatus{
+			"a1": {Status: status.Down},
+		},
+	})
+
+	expected := `
+# HELP opensvc_instance_frozen 1 if the instance is frozen, else 0
+# TYPE opensvc_instance_frozen gauge
+opensvc_instance_frozen{node="n1",path="foo"} 1
+# HELP opensvc_instance_monitor_global_expect The global expect of the orchestration running on the instance
+# TYPE opensvc_instance_monitor_global_expect gauge
+opensvc_instance_monitor_global_expect{global_expect="started",node="n1",path="foo"} 1
+# HELP opensvc_instance_monitor_state The instance monitor state
+# TYPE opensvc_instance_monitor_state gauge
+opensvc_instance_monitor_state{node="n1",path="foo",state="starting"} 1
+# HELP opensvc_node_arbitrator_status The arbitrator status, as seen by the node
+# TYPE opensvc_node_arbitrator_status gauge
+opensvc_node_arbitrator_status{arbitrator="a1",node="n1",status="down"} 1
+# HELP opensvc_node_speaker 1 if the node is the cluster speaker, else 0
+# TYPE opensvc_node_speaker gauge
+opensvc_node_speaker{node="n1"} 1
+# HELP opensvc_object_overall The object aggregated overall status
+# TYPE opensvc_object_overall gauge
+opensvc_object_overall{path="foo",status="warn"} 1
+# HELP opensvc_object_placement_optimal 1 if the object instances placement is optimal, else 0
+# TYPE opensvc_object_placement_optimal gauge
+opensvc_object_placement_optimal{path="foo"} 0
+# HELP opensvc_object_up_instances The number of up instances of the object
+# TYPE opensvc_object_up_instances gauge
+opensvc_object_up_instances{path="foo"} 1
+`
+	err := testutil.CollectAndCompare(&collector{}, strings.NewReader(expected),
+		"opensvc_instance_frozen",
+		"opensvc_instance_monitor_global_expect",
+		"opensvc_instance_monitor_state",
+		"opensvc_node_arbitrator_status",
+		"opensvc_node_speaker",
+		"opensvc_object_overall",
+		"opensvc_object_placement_optimal",
+		"opensvc_object_up_instances",
+	)
+	require.NoError(t, err)
+}
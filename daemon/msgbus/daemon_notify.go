@@ -0,0 +1,7 @@
+package msgbus
+
+func (data *ClusterData) onDaemonNotifyUpdated(m *DaemonNotifyUpdated) {
+	v := data.Cluster.Node[m.Node]
+	v.Daemon.Notify = m.Value
+	data.Cluster.Node[m.Node] = v
+}
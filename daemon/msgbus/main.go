@@ -14,6 +14,12 @@ type (
 	}
 )
 
+var (
+	// LabelDropPeer labels the messages published by a node about the
+	// components of a lost peer it drops. The lost peer can't publish them.
+	LabelDropPeer = pubsub.Label{"drop", "peer"}
+)
+
 func (data *ClusterData) ApplyMessage(m pubsub.Messager) {
 	switch c := m.(type) {
 	case *ClusterStatusUpdated:
@@ -30,6 +36,8 @@ func (data *ClusterData) ApplyMessage(m pubsub.Messager) {
 		data.onDaemonHeartbeatUpdated(c)
 	case *DaemonListenerUpdated:
 		data.onDaemonListenerUpdated(c)
+	case *DaemonNotifyUpdated:
+		data.onDaemonNotifyUpdated(c)
 	case *DaemonRunnerImonUpdated:
 		data.onDaemonRunnerImonUpdated(c)
 	case *DaemonSchedulerUpdated:
@@ -51,6 +51,8 @@ var (
 
 		"ClusterConfigUpdated": func() any { return &ClusterConfigUpdated{} },
 
+		"ClusterSecretRotationUpdated": func() any { return &ClusterSecretRotationUpdated{} },
+
 		"ClusterStatusUpdated": func() any { return &ClusterStatusUpdated{} },
 
 		"ConfigFileRemoved": func() any { return &ConfigFileRemoved{} },
@@ -73,6 +75,8 @@ var (
 
 		"DaemonListenerUpdated": func() any { return &DaemonListenerUpdated{} },
 
+		"DaemonNotifyUpdated": func() any { return &DaemonNotifyUpdated{} },
+
 		"DaemonRunnerImonUpdated": func() any { return &DaemonRunnerImonUpdated{} },
 
 		"DaemonSchedulerUpdated": func() any { return &DaemonSchedulerUpdated{} },
@@ -92,6 +96,12 @@ var (
 		// TODO: remove when CHANGELOG.md: forget_peer (b2.1) -> ForgetPeer
 		"forget_peer": func() any { return &ForgetPeer{} },
 
+		"HbFaultCleared": func() any { return &HbFaultCleared{} },
+
+		"HbFaultCtl": func() any { return &HbFaultCtl{} },
+
+		"HbFaultInjected": func() any { return &HbFaultInjected{} },
+
 		"HbMessageTypeUpdated": func() any { return &HbMessageTypeUpdated{} },
 
 		"HbNodePing": func() any { return &HbNodePing{} },
@@ -116,6 +126,8 @@ var (
 
 		"InstanceMonitorAction": func() any { return &InstanceMonitorAction{} },
 
+		"InstanceMonitorFlapping": func() any { return &InstanceMonitorFlapping{} },
+
 		"InstanceMonitorDeleted": func() any { return &InstanceMonitorDeleted{} },
 
 		"InstanceMonitorUpdated": func() any { return &InstanceMonitorUpdated{} },
@@ -170,8 +182,14 @@ var (
 
 		"NodeStatusLabelsUpdated": func() any { return &NodeStatusLabelsUpdated{} },
 
+		"NodeRollingRestartProgress": func() any { return &NodeRollingRestartProgress{} },
+
 		"NodeSplitAction": func() any { return &NodeSplitAction{} },
 
+		"NodeStonithFinished": func() any { return &NodeStonithFinished{} },
+
+		"NodeStonithStarted": func() any { return &NodeStonithStarted{} },
+
 		"NodeStatusUpdated": func() any { return &NodeStatusUpdated{} },
 
 		"ObjectCreated": func() any { return &ObjectCreated{} },
@@ -277,6 +295,15 @@ type (
 		NodesRemoved []string       `json:"nodes_removed" yaml:"nodes_removed"`
 	}
 
+	// ClusterSecretRotationUpdated is emitted by the cluster config manager
+	// when the localhost cluster secret rotation progresses.
+	ClusterSecretRotationUpdated struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string `json:"node" yaml:"node"`
+
+		Value daemonsubsystem.HeartbeatSecretRotation `json:"secret_rotation" yaml:"secret_rotation"`
+	}
+
 	ClusterStatusUpdated struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string             `json:"node" yaml:"node"`
@@ -323,6 +350,12 @@ type (
 		Value      daemonsubsystem.Listener `json:"listener" yaml:"listener"`
 	}
 
+	DaemonNotifyUpdated struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string                 `json:"node" yaml:"node"`
+		Value      daemonsubsystem.Notify `json:"notify" yaml:"notify"`
+	}
+
 	DaemonRunnerImonUpdated struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string `json:"node" yaml:"node"`
@@ -407,6 +440,38 @@ type (
 		Time       time.Time `json:"at" yaml:"at"`
 	}
 
+	// HbFaultCleared is emitted by the hb component when a heartbeat fault
+	// injection is cleared, on expire or on HbFaultCtl clear request.
+	HbFaultCleared struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string `json:"node" yaml:"node"`
+
+		// Reason is the clear reason: "expired", "cleared" or "replaced".
+		Reason string `json:"reason" yaml:"reason"`
+
+		Value daemonsubsystem.HeartbeatFault `json:"fault" yaml:"fault"`
+	}
+
+	// HbFaultCtl is the request to inject or clear a heartbeat fault.
+	HbFaultCtl struct {
+		pubsub.Msg `yaml:",inline"`
+
+		// Action is "inject" or "clear". The clear action only uses the
+		// Value ID and Peer.
+		Action string `json:"action" yaml:"action"`
+
+		Value daemonsubsystem.HeartbeatFault `json:"fault" yaml:"fault"`
+	}
+
+	// HbFaultInjected is emitted by the hb component when a heartbeat fault
+	// injection is applied.
+	HbFaultInjected struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string `json:"node" yaml:"node"`
+
+		Value daemonsubsystem.HeartbeatFault `json:"fault" yaml:"fault"`
+	}
+
 	HbMessageTypeUpdated struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string   `json:"node" yaml:"node"`
@@ -495,6 +560,18 @@ type (
 		RID        string                 `json:"rid" yaml:"rid"`
 	}
 
+	// InstanceMonitorFlapping is published by imon when a resource starts
+	// or stops flapping: restarted more than once without being stable for
+	// its restart window.
+	InstanceMonitorFlapping struct {
+		pubsub.Msg `yaml:",inline"`
+		Path       naming.Path `json:"path" yaml:"path"`
+		Node       string      `json:"node" yaml:"node"`
+		RID        string      `json:"rid" yaml:"rid"`
+		IsFlapping bool        `json:"is_flapping" yaml:"is_flapping"`
+		Count      int         `json:"count" yaml:"count"`
+	}
+
 	InstanceMonitorDeleted struct {
 		pubsub.Msg `yaml:",inline"`
 		Path       naming.Path `json:"path" yaml:"path"`
@@ -661,6 +738,34 @@ type (
 		ProVoters       int    `json:"pro_voters" yaml:"pro_voters"`
 	}
 
+	// NodeRollingRestartProgress is published by nmon when the local node
+	// enters a new stage of the cluster rolling restart.
+	NodeRollingRestartProgress struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string              `json:"node" yaml:"node"`
+		Stage      string              `json:"stage" yaml:"stage"`
+		Done       []string            `json:"done" yaml:"done"`
+		Pending    []string            `json:"pending" yaml:"pending"`
+		Value      node.RollingRestart `json:"rolling_restart" yaml:"rolling_restart"`
+	}
+
+	// NodeStonithStarted is published by the speaker nmon when it starts the
+	// stonith command fencing a lost peer node.
+	NodeStonithStarted struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string   `json:"node" yaml:"node"`
+		Peer       string   `json:"peer" yaml:"peer"`
+		Cmd        []string `json:"cmd" yaml:"cmd"`
+	}
+
+	// NodeStonithFinished is published by the speaker nmon when the stonith
+	// command fencing a lost peer node has returned.
+	NodeStonithFinished struct {
+		pubsub.Msg `yaml:",inline"`
+		Node       string             `json:"node" yaml:"node"`
+		Value      node.StonithRecord `json:"stonith" yaml:"stonith"`
+	}
+
 	NodeStatsUpdated struct {
 		pubsub.Msg `yaml:",inline"`
 		Node       string     `json:"node" yaml:"node"`
@@ -844,6 +949,10 @@ func (e *ClusterConfigUpdated) Kind() string {
 	return "ClusterConfigUpdated"
 }
 
+func (e *ClusterSecretRotationUpdated) Kind() string {
+	return "ClusterSecretRotationUpdated"
+}
+
 func (e *ClusterStatusUpdated) Kind() string {
 	return "ClusterStatusUpdated"
 }
@@ -896,6 +1005,10 @@ func (e *DaemonListenerUpdated) Kind() string {
 	return "DaemonListenerUpdated"
 }
 
+func (e *DaemonNotifyUpdated) Kind() string {
+	return "DaemonNotifyUpdated"
+}
+
 func (e *DaemonRunnerImonUpdated) Kind() string {
 	return "DaemonRunnerImonUpdated"
 }
@@ -932,6 +1045,18 @@ func (e *ForgetPeer) Kind() string {
 	return "forget_peer"
 }
 
+func (e *HbFaultCleared) Kind() string {
+	return "HbFaultCleared"
+}
+
+func (e *HbFaultCtl) Kind() string {
+	return "HbFaultCtl"
+}
+
+func (e *HbFaultInjected) Kind() string {
+	return "HbFaultInjected"
+}
+
 func (e *HbMessageTypeUpdated) Kind() string {
 	return "HbMessageTypeUpdated"
 }
@@ -996,6 +1121,10 @@ func (e *InstanceMonitorAction) Kind() string {
 	return "InstanceMonitorAction"
 }
 
+func (e *InstanceMonitorFlapping) Kind() string {
+	return "InstanceMonitorFlapping"
+}
+
 func (e *InstanceMonitorDeleted) Kind() string {
 	return "InstanceMonitorDeleted"
 }
@@ -1088,10 +1217,22 @@ func (e *NodeOsPathsUpdated) Kind() string {
 	return "NodeOsPathsUpdated"
 }
 
+func (e *NodeRollingRestartProgress) Kind() string {
+	return "NodeRollingRestartProgress"
+}
+
 func (e *NodeSplitAction) Kind() string {
 	return "NodeSplitAction"
 }
 
+func (e *NodeStonithFinished) Kind() string {
+	return "NodeStonithFinished"
+}
+
+func (e *NodeStonithStarted) Kind() string {
+	return "NodeStonithStarted"
+}
+
 func (e *NodeStatsUpdated) Kind() string {
 	return "NodeStatsUpdated"
 }
@@ -1,10 +1,12 @@
 package msgbus
 
+import "reflect"
+
 // onNodeConfigUpdated updates .cluster.node.<node>.config
 func (data *ClusterData) onNodeConfigUpdated(m *NodeConfigUpdated) {
 	newConfig := m.Value
 	v := data.Cluster.Node[m.Node]
-	if v.Config == newConfig {
+	if reflect.DeepEqual(v.Config, newConfig) {
 		return
 	}
 	v.Config = m.Value
@@ -1,16 +1,21 @@
 package nmon
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/opensvc/om3/core/cluster"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/status"
+	"github.com/opensvc/om3/daemon/arbitrator"
 	"github.com/opensvc/om3/daemon/msgbus"
 	"github.com/opensvc/om3/util/key"
 )
@@ -20,6 +25,16 @@ type (
 		Name     string `json:"name"`
 		URI      string `json:"uri"`
 		Insecure bool
+
+		// Dev is the shared block device of a disk arbitrator. The node
+		// holding the device claim gets the vote.
+		Dev string `json:"dev"`
+
+		// Secret is the cluster password of an arbitrator server. When set,
+		// the http checks are authenticated and the votes are asked
+		// explicitly, so the arbitrator server can grant its vote to a
+		// single partition.
+		Secret string `json:"-"`
 	}
 )
 
@@ -35,13 +50,19 @@ func (t *Manager) setArbitratorConfig() {
 			Name:     name,
 			URI:      t.config.GetString(key.New(s, "uri")),
 			Insecure: t.config.GetBool(key.New(s, "insecure")),
+			Dev:      t.config.GetString(key.New(s, "dev")),
+			Secret:   t.config.GetString(key.New(s, "secret")),
 		}
-		if a.URI == "" {
+		if a.URI == "" && a.Dev == "" {
 			t.log.Debugf("arbitrator keyword 'name' is deprecated, use 'uri' instead")
 			a.URI = t.config.GetString(key.New(s, "name"))
 		}
-		if a.URI == "" {
-			t.log.Warnf("ignored arbitrator %s (empty uri)", s)
+		if a.URI == "" && a.Dev == "" {
+			t.log.Warnf("ignored arbitrator %s (empty uri and dev)", s)
+			continue
+		}
+		if a.URI != "" && a.Dev != "" {
+			t.log.Warnf("ignored arbitrator %s (both uri and dev are set)", s)
 			continue
 		}
 		arbitrators[name] = a
@@ -49,25 +70,47 @@ func (t *Manager) setArbitratorConfig() {
 	t.arbitrators = arbitrators
 }
 
-// getStatusArbitrators checks all arbitrators and returns result
-func (t *Manager) getStatusArbitrators() map[string]node.ArbitratorStatus {
+// getStatusArbitrators checks all arbitrators and returns result.
+//
+// When vote is true, the cluster is split and the disk arbitrators are
+// claimed: they are up only if the local node wins the claim. Else the disk
+// arbitrators are up if their claim is readable.
+func (t *Manager) getStatusArbitrators(vote bool) map[string]node.ArbitratorStatus {
 	type res struct {
-		name string
-		err  error
+		name   string
+		holder string
+		err    error
 	}
 	ctx, cancel := context.WithTimeout(t.ctx, arbitratorCheckDuration)
 	defer cancel()
+	var voteRequest *arbitrator.VoteRequest
+	if vote {
+		voteRequest = &arbitrator.VoteRequest{Node: t.localhost}
+		for nodename := range t.livePeers {
+			voteRequest.Partition = append(voteRequest.Partition, nodename)
+		}
+		sort.Strings(voteRequest.Partition)
+	}
 	c := make(chan res, len(t.arbitrators))
 	for _, a := range t.arbitrators {
 		go func(a arbitratorConfig) {
-			c <- res{name: a.Name, err: t.arbitratorCheck(ctx, a)}
+			if a.Dev != "" {
+				holder, err := t.diskArbitratorCheck(ctx, a, vote)
+				c <- res{name: a.Name, holder: holder, err: err}
+				return
+			}
+			c <- res{name: a.Name, err: t.arbitratorCheck(ctx, a, voteRequest)}
 		}(a)
 	}
 	result := make(map[string]node.ArbitratorStatus)
 	for i := 0; i < len(t.arbitrators); i++ {
 		r := <-c
 		name := r.name
-		url := t.arbitrators[name].URI
+		a := t.arbitrators[name]
+		url := a.URI
+		if a.Dev != "" {
+			url = a.Dev
+		}
 		aStatus := status.Up
 		if r.err != nil {
 			t.log.Warnf("arbitrator#%s is down", name)
@@ -78,14 +121,21 @@ func (t *Manager) getStatusArbitrators() map[string]node.ArbitratorStatus {
 				Name: name,
 				ErrS: r.err.Error(),
 			})
+		} else if vote && a.Dev != "" && r.holder != t.localhost {
+			t.log.Warnf("arbitrator#%s is claimed by %s", name, r.holder)
+			aStatus = status.Down
 		}
-		result[name] = node.ArbitratorStatus{URL: url, Status: aStatus}
+		result[name] = node.ArbitratorStatus{URL: url, Status: aStatus, Holder: r.holder}
 	}
 	return result
 }
 
 func (t *Manager) getAndUpdateStatusArbitrator() {
-	t.nodeStatus.Arbitrators = t.getStatusArbitrators()
+	t.updateStatusArbitrators(t.getStatusArbitrators(false))
+}
+
+func (t *Manager) updateStatusArbitrators(m map[string]node.ArbitratorStatus) {
+	t.nodeStatus.Arbitrators = m
 	t.publishNodeStatus()
 	pubValue := make(map[string]node.ArbitratorStatus)
 	for k, v := range t.nodeStatus.Arbitrators {
@@ -94,16 +144,26 @@ func (t *Manager) getAndUpdateStatusArbitrator() {
 	t.bus.Pub(&msgbus.NodeStatusArbitratorsUpdated{Node: t.localhost, Value: pubValue}, t.labelLocalhost)
 }
 
+// arbitratorVotes returns the names of the arbitrators voting for the local
+// node, and updates the node status arbitrators with the vote results.
 func (t *Manager) arbitratorVotes() (votes []string) {
-	for s, v := range t.getStatusArbitrators() {
+	result := t.getStatusArbitrators(true)
+	for s, v := range result {
 		if v.Status == status.Up {
 			votes = append(votes, s)
 		}
 	}
+	t.updateStatusArbitrators(result)
 	return
 }
 
-func (t *Manager) arbitratorCheck(ctx context.Context, a arbitratorConfig) error {
+// arbitratorCheck verifies the <a> arbitrator is reachable. When <vote> is
+// not nil and the arbitrator is an authenticated arbitrator server, the
+// arbitrator is asked for its vote.
+func (t *Manager) arbitratorCheck(ctx context.Context, a arbitratorConfig, vote *arbitrator.VoteRequest) error {
+	if strings.HasPrefix(a.URI, "http") && a.Secret != "" {
+		return a.checkServer(ctx, t.clusterConfig.Name, t.localhost, vote)
+	}
 	if strings.HasPrefix(a.URI, "http") {
 		return a.checkURL(ctx)
 	}
@@ -113,14 +173,69 @@ func (t *Manager) arbitratorCheck(ctx context.Context, a arbitratorConfig) error
 	return fmt.Errorf("invalid arbitrator uri")
 }
 
-func (a *arbitratorConfig) checkURL(ctx context.Context) error {
-	client := &http.Client{
+func (a *arbitratorConfig) httpClient() *http.Client {
+	return &http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{
 				InsecureSkipVerify: a.Insecure,
 			},
 		},
 	}
+}
+
+// checkServer sends an authenticated request to an arbitrator server: a
+// vote request if <vote> is not nil, else a check request.
+//
+// Contrary to checkURL, an error is returned for any response status code
+// other than 200.
+func (a *arbitratorConfig) checkServer(ctx context.Context, clusterName, nodename string, vote *arbitrator.VoteRequest) error {
+	var (
+		req *http.Request
+		err error
+	)
+	if vote != nil {
+		b, err := json.Marshal(vote)
+		if err != nil {
+			return err
+		}
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, a.URI, bytes.NewReader(b))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+	} else {
+		u, err := url.Parse(a.URI)
+		if err != nil {
+			return err
+		}
+		q := u.Query()
+		q.Set("node", nodename)
+		u.RawQuery = q.Encode()
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return err
+		}
+	}
+	req.SetBasicAuth(clusterName, a.Secret)
+	resp, err := a.httpClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusConflict:
+		var data arbitrator.VoteResponse
+		_ = json.NewDecoder(resp.Body).Decode(&data)
+		return fmt.Errorf("vote refused: granted to %s", data.Holder)
+	default:
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+}
+
+func (a *arbitratorConfig) checkURL(ctx context.Context) error {
+	client := a.httpClient()
 	req, err := http.NewRequestWithContext(ctx, "GET", a.URI, nil)
 	if err != nil {
 		return err
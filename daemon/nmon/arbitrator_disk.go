@@ -0,0 +1,73 @@
+package nmon
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opensvc/om3/daemon/hb/hbdisk"
+)
+
+type (
+	// claimDevice is the interface of the disk arbitrator devices.
+	claimDevice interface {
+		ReadClaim() (hbdisk.Claim, error)
+		CompareAndWriteClaim(ctx context.Context, old, c hbdisk.Claim) error
+	}
+)
+
+var (
+	// diskArbitratorClaimTTL is the duration a disk arbitrator claim
+	// prevents the other nodes from claiming.
+	diskArbitratorClaimTTL = 60 * time.Second
+)
+
+// diskArbitratorCheck returns the node holding the disk arbitrator claim.
+// When vote is true, the local node races for the claim first.
+func (t *Manager) diskArbitratorCheck(ctx context.Context, a arbitratorConfig, vote bool) (string, error) {
+	d := hbdisk.NewClaimDevice(a.Dev)
+	if vote {
+		return diskClaim(ctx, d, t.localhost)
+	}
+	return diskHolder(d)
+}
+
+// diskClaim races for the disk arbitrator claim and returns the node
+// holding it.
+//
+// A fresh claim of another node is never overwritten. Else the node
+// replaces the claim it read by its own claim, with an atomic compare and
+// write: if another node replaced the same claim first, the write fails and
+// the other node holds the claim.
+func diskClaim(ctx context.Context, d claimDevice, nodename string) (string, error) {
+	c, err := d.ReadClaim()
+	if err != nil {
+		return "", err
+	}
+	if c.Node != "" && c.Node != nodename && time.Since(c.At) < diskArbitratorClaimTTL {
+		return c.Node, nil
+	}
+	err = d.CompareAndWriteClaim(ctx, c, hbdisk.Claim{Node: nodename, At: time.Now()})
+	if errors.Is(err, hbdisk.ErrClaimChanged) {
+		if c, err = d.ReadClaim(); err != nil {
+			return "", err
+		}
+		return c.Node, nil
+	} else if err != nil {
+		return "", err
+	}
+	return nodename, nil
+}
+
+// diskHolder returns the node holding a fresh disk arbitrator claim, or an
+// empty string if the claim is expired.
+func diskHolder(d claimDevice) (string, error) {
+	c, err := d.ReadClaim()
+	if err != nil {
+		return "", err
+	}
+	if time.Since(c.At) >= diskArbitratorClaimTTL {
+		return "", nil
+	}
+	return c.Node, nil
+}
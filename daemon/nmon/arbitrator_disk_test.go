@@ -0,0 +1,100 @@
+package nmon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/daemon/hb/hbdisk"
+)
+
+type (
+	// testClaimDevice is a claimDevice whose claim can be replaced by a
+	// concurrent claim of another node between the claim read and the
+	// compare and write.
+	testClaimDevice struct {
+		claim      hbdisk.Claim
+		concurrent *hbdisk.Claim
+	}
+)
+
+func (t *testClaimDevice) ReadClaim() (hbdisk.Claim, error) {
+	return t.claim, nil
+}
+
+func (t *testClaimDevice) CompareAndWriteClaim(_ context.Context, old, c hbdisk.Claim) error {
+	if t.concurrent != nil {
+		t.claim = *t.concurrent
+		t.concurrent = nil
+	}
+	if t.claim.Node != old.Node || !t.claim.At.Equal(old.At) {
+		return hbdisk.ErrClaimChanged
+	}
+	t.claim = c
+	return nil
+}
+
+func TestDiskClaim(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("never claimed device is won", func(t *testing.T) {
+		d := &testClaimDevice{}
+		holder, err := diskClaim(ctx, d, "node1")
+		require.NoError(t, err)
+		assert.Equal(t, "node1", holder)
+		assert.Equal(t, "node1", d.claim.Node)
+	})
+
+	t.Run("fresh claim of a peer is not overwritten", func(t *testing.T) {
+		d := &testClaimDevice{claim: hbdisk.Claim{Node: "node2", At: time.Now()}}
+		holder, err := diskClaim(ctx, d, "node1")
+		require.NoError(t, err)
+		assert.Equal(t, "node2", holder)
+		assert.Equal(t, "node2", d.claim.Node)
+	})
+
+	t.Run("expired claim of a peer is overwritten", func(t *testing.T) {
+		d := &testClaimDevice{claim: hbdisk.Claim{Node: "node2", At: time.Now().Add(-diskArbitratorClaimTTL)}}
+		holder, err := diskClaim(ctx, d, "node1")
+		require.NoError(t, err)
+		assert.Equal(t, "node1", holder)
+		assert.Equal(t, "node1", d.claim.Node)
+	})
+
+	t.Run("claim of the local node is renewed", func(t *testing.T) {
+		at := time.Now().Add(-time.Second)
+		d := &testClaimDevice{claim: hbdisk.Claim{Node: "node1", At: at}}
+		holder, err := diskClaim(ctx, d, "node1")
+		require.NoError(t, err)
+		assert.Equal(t, "node1", holder)
+		assert.True(t, d.claim.At.After(at))
+	})
+
+	t.Run("concurrent claim written first wins", func(t *testing.T) {
+		d := &testClaimDevice{concurrent: &hbdisk.Claim{Node: "node2", At: time.Now()}}
+		holder, err := diskClaim(ctx, d, "node1")
+		require.NoError(t, err)
+		assert.Equal(t, "node2", holder)
+		assert.Equal(t, "node2", d.claim.Node)
+	})
+
+	t.Run("concurrent claim of an expired claim written first wins", func(t *testing.T) {
+		d := &testClaimDevice{
+			claim:      hbdisk.Claim{Node: "node3", At: time.Now().Add(-diskArbitratorClaimTTL)},
+			concurrent: &hbdisk.Claim{Node: "node2", At: time.Now()},
+		}
+		holder, err := diskClaim(ctx, d, "node1")
+		require.NoError(t, err)
+		assert.Equal(t, "node2", holder)
+	})
+
+	t.Run("holder of expired claim is empty", func(t *testing.T) {
+		d := &testClaimDevice{claim: hbdisk.Claim{Node: "node2", At: time.Now().Add(-diskArbitratorClaimTTL)}}
+		holder, err := diskHolder(d)
+		require.NoError(t, err)
+		assert.Equal(t, "", holder)
+	})
+}
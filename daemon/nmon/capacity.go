@@ -0,0 +1,85 @@
+package nmon
+
+import (
+	"github.com/opensvc/om3/core/capacity"
+	"github.com/opensvc/om3/core/instance"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/daemon/msgbus"
+)
+
+type (
+	// instanceAllocation is the local instance data needed to account its
+	// resources requests in the node allocations.
+	instanceAllocation struct {
+		requests capacity.T
+		status   *instance.Status
+		monitor  *instance.Monitor
+	}
+)
+
+func (t *Manager) onInstanceConfigUpdatedAllocation(c *msgbus.InstanceConfigUpdated) {
+	t.instanceAllocation(c.Path).requests = *c.Value.Requests.DeepCopy()
+	t.updateAllocated()
+}
+
+func (t *Manager) onInstanceConfigDeletedAllocation(c *msgbus.InstanceConfigDeleted) {
+	delete(t.allocations, c.Path)
+	t.updateAllocated()
+}
+
+func (t *Manager) onInstanceStatusUpdatedAllocation(c *msgbus.InstanceStatusUpdated) {
+	t.instanceAllocation(c.Path).status = c.Value.DeepCopy()
+	t.updateAllocated()
+}
+
+func (t *Manager) onInstanceStatusDeletedAllocation(c *msgbus.InstanceStatusDeleted) {
+	if v, ok := t.allocations[c.Path]; ok {
+		v.status = nil
+		t.updateAllocated()
+	}
+}
+
+func (t *Manager) onInstanceMonitorUpdatedAllocation(c *msgbus.InstanceMonitorUpdated) {
+	if c.Node != t.localhost {
+		return
+	}
+	t.instanceAllocation(c.Path).monitor = c.Value.DeepCopy()
+	t.updateAllocated()
+}
+
+func (t *Manager) onInstanceMonitorDeletedAllocation(c *msgbus.InstanceMonitorDeleted) {
+	if v, ok := t.allocations[c.Path]; ok {
+		v.monitor = nil
+		t.updateAllocated()
+	}
+}
+
+func (t *Manager) instanceAllocation(p naming.Path) *instanceAllocation {
+	v, ok := t.allocations[p]
+	if !ok {
+		v = &instanceAllocation{}
+		t.allocations[p] = v
+	}
+	return v
+}
+
+// updateAllocated publishes the node status with the sum of the resources
+// requests of the local instances running or starting, if changed.
+func (t *Manager) updateAllocated() {
+	var allocated capacity.T
+	for _, v := range t.allocations {
+		if v.requests.IsZero() {
+			continue
+		}
+		if !instance.IsAllocating(v.status, v.monitor) {
+			continue
+		}
+		allocated = allocated.Add(v.requests)
+	}
+	if allocated.Equal(t.nodeStatus.Allocated) {
+		return
+	}
+	t.log.Debugf("allocated resources changed: %+v -> %+v", t.nodeStatus.Allocated, allocated)
+	t.nodeStatus.Allocated = allocated
+	t.publishNodeStatus()
+}
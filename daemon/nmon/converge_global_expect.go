@@ -30,6 +30,7 @@ func (t *Manager) convergeGlobalExpectFromRemote() {
 		t.change = true
 		t.state.GlobalExpect = t.nodeMonitor[mostRecentNode].GlobalExpect
 		t.state.GlobalExpectUpdatedAt = t.nodeMonitor[mostRecentNode].GlobalExpectUpdatedAt
+		t.state.RollingRestart = t.nodeMonitor[mostRecentNode].RollingRestart
 		strVal := t.nodeMonitor[mostRecentNode].GlobalExpect.String()
 		if strVal == "" {
 			strVal = "unset"
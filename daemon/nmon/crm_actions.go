@@ -76,3 +76,27 @@ func (t *Manager) crmAction(cmdArgs ...string) error {
 	t.log.Debugf("<- exec %s %s", cmdPath, cmd)
 	return nil
 }
+
+// crmDaemonRestart starts a background "om daemon restart". This command
+// stops the running daemon, so it is not waited for.
+func (t *Manager) crmDaemonRestart() error {
+	cmdEnv := []string{
+		env.OriginSetenvArg(env.ActionOriginDaemonMonitor),
+		// tell the new daemon not to merge the peer frozen states on rejoin
+		"OPENSVC_AGENT_UPGRADE=1",
+	}
+	cmd := command.New(
+		command.WithName(cmdPath),
+		command.WithArgs([]string{"daemon", "restart"}),
+		command.WithEnv(cmdEnv),
+		command.WithLogger(t.log),
+	)
+	labels := []pubsub.Label{t.labelLocalhost, {"origin", "nmon"}}
+	t.bus.Pub(&msgbus.Exec{Command: cmd.String(), Node: t.localhost, Origin: "nmon"}, labels...)
+	if err := cmd.Start(); err != nil {
+		t.bus.Pub(&msgbus.ExecFailed{Command: cmd.String(), ErrS: err.Error(), Node: t.localhost, Origin: "nmon"}, labels...)
+		t.log.Errorf("failed %s: %s", cmd, err)
+		return err
+	}
+	return nil
+}
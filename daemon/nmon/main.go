@@ -31,9 +31,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/prometheus/procfs"
 
 	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/naming"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/nodesinfo"
 	"github.com/opensvc/om3/core/object"
@@ -78,6 +80,10 @@ type (
 		rejoinTicker *time.Ticker
 		startedAt    time.Time
 
+		// rollingRestartGiveBack is true when the rolling restart drain
+		// freezes the local node, so it is unfrozen once restarted.
+		rollingRestartGiveBack bool
+
 		pendingCtx    context.Context
 		pendingCancel context.CancelFunc
 
@@ -116,6 +122,32 @@ type (
 		// localhost.
 		nodeStatus node.Status
 
+		// lostQuorum is true when the last cluster split analysis did not
+		// conclude we have quorum, including when the split is ignored
+		// because cluster.quorum is false or the node is frozen. It
+		// prevents the stonith of lost peers.
+		lostQuorum bool
+
+		// stonithQueue is the list of lost peers waiting to be fenced by
+		// the speaker, in request order.
+		stonithQueue []string
+
+		// stonithRequests is the list of stonith request ids not yet
+		// fulfilled, indexed by lost peer nodename.
+		stonithRequests map[string][]uuid.UUID
+
+		// stonithFenced is the set of lost peers the local node fenced
+		// since they were lost.
+		stonithFenced map[string]bool
+
+		// stonithRunning is the nodename of the peer being fenced. It is
+		// empty when no stonith command is running.
+		stonithRunning string
+
+		// allocations is the cache of the local instances data used to
+		// account the node allocated resources, indexed by object path.
+		allocations map[naming.Path]*instanceAllocation
+
 		wg sync.WaitGroup
 	}
 
@@ -133,6 +165,10 @@ var (
 	// arbitratorInterval is the interval duration between 2 arbitrator checks
 	arbitratorInterval = 60 * time.Second
 
+	// maintenanceWindowInterval is the interval duration between 2 node
+	// maintenance window evaluations
+	maintenanceWindowInterval = 30 * time.Second
+
 	// To ensure no actions are performed during the split analyse
 	// splitActionDelay + arbitratorCheckDuration must be lower than daemonenv.ReadyDuration
 
@@ -175,6 +211,11 @@ func NewManager(drainDuration time.Duration, subQS pubsub.QueueSizer) *Manager {
 		frozen:    true, // ensure initial frozen
 		livePeers: map[string]bool{localhost: true},
 
+		stonithRequests: make(map[string][]uuid.UUID),
+		stonithFenced:   make(map[string]bool),
+
+		allocations: make(map[naming.Path]*instanceAllocation),
+
 		cacheNodesInfo: node.NodesInfo{localhost: {}},
 		labelLocalhost: pubsub.Label{"node", localhost},
 
@@ -309,6 +350,12 @@ func (t *Manager) startSubscriptions() {
 
 	sub.AddFilter(&msgbus.ForgetPeer{})
 	sub.AddFilter(&msgbus.HbMessageTypeUpdated{})
+	sub.AddFilter(&msgbus.InstanceConfigDeleted{}, t.labelLocalhost)
+	sub.AddFilter(&msgbus.InstanceConfigUpdated{}, t.labelLocalhost)
+	sub.AddFilter(&msgbus.InstanceMonitorDeleted{}, t.labelLocalhost)
+	sub.AddFilter(&msgbus.InstanceMonitorUpdated{})
+	sub.AddFilter(&msgbus.InstanceStatusDeleted{}, t.labelLocalhost)
+	sub.AddFilter(&msgbus.InstanceStatusUpdated{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.JoinRequest{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.LeaveRequest{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.NodeConfigUpdated{}, pubsub.Label{"from", "peer"})
@@ -320,6 +367,7 @@ func (t *Manager) startSubscriptions() {
 	sub.AddFilter(&msgbus.NodeRejoin{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.NodeStatusGenUpdates{}, t.labelLocalhost)
 	sub.AddFilter(&msgbus.NodeStatusLabelsUpdated{}, pubsub.Label{"from", "peer"})
+	sub.AddFilter(&msgbus.NodeStatusUpdated{}, pubsub.Label{"from", "peer"})
 	sub.AddFilter(&msgbus.SetNodeMonitor{})
 	sub.Start()
 	t.sub = sub
@@ -373,6 +421,7 @@ func (t *Manager) worker() {
 	defer t.log.Debugf("done")
 
 	t.startedAt = time.Now()
+	t.loadRollingRestartMark()
 
 	// cluster nodes at the time the worker starts
 	initialNodes := t.config.GetStrings(key.New("cluster", "nodes"))
@@ -404,6 +453,9 @@ func (t *Manager) worker() {
 	defer statsTicker.Stop()
 	arbitratorTicker := time.NewTicker(arbitratorInterval)
 	defer arbitratorTicker.Stop()
+	maintenanceWindowTicker := time.NewTicker(maintenanceWindowInterval)
+	defer maintenanceWindowTicker.Stop()
+	t.onMaintenanceWindowConfigUpdated()
 	defer t.touchLastShutdown()
 
 	// TODO refreshSanPaths should be refreshed on events,  on ticker ?
@@ -425,6 +477,19 @@ func (t *Manager) worker() {
 				t.onJoinRequest(c)
 			case *msgbus.HbMessageTypeUpdated:
 				t.onHbMessageTypeUpdated(c)
+			case *msgbus.InstanceConfigDeleted:
+				t.onInstanceConfigDeletedAllocation(c)
+			case *msgbus.InstanceConfigUpdated:
+				t.onInstanceConfigUpdatedAllocation(c)
+			case *msgbus.InstanceMonitorDeleted:
+				t.onInstanceMonitorDeletedAllocation(c)
+			case *msgbus.InstanceMonitorUpdated:
+				t.onInstanceMonitorUpdated(c)
+				t.onInstanceMonitorUpdatedAllocation(c)
+			case *msgbus.InstanceStatusDeleted:
+				t.onInstanceStatusDeletedAllocation(c)
+			case *msgbus.InstanceStatusUpdated:
+				t.onInstanceStatusUpdatedAllocation(c)
 			case *msgbus.NodeConfigUpdated:
 				t.onPeerNodeConfigUpdated(c)
 			case *msgbus.NodeMonitorDeleted:
@@ -441,6 +506,8 @@ func (t *Manager) worker() {
 				t.onPeerNodeStatusLabelsUpdated(c)
 			case *msgbus.NodeStatusGenUpdates:
 				t.onNodeStatusGenUpdates(c)
+			case *msgbus.NodeStatusUpdated:
+				t.onPeerNodeStatusUpdated(c)
 			case *msgbus.LeaveRequest:
 				t.onLeaveRequest(c)
 			case *msgbus.NodeRejoin:
@@ -452,11 +519,17 @@ func (t *Manager) worker() {
 			switch c := i.(type) {
 			case cmdOrchestrate:
 				t.onOrchestrate(c)
+			case cmdStonithDone:
+				t.onStonithDone(c)
+			case cmdStonithRetry:
+				t.onStonithRetry(c)
 			}
 		case <-statsTicker.C:
 			t.updateStats()
 		case <-arbitratorTicker.C:
 			t.onArbitratorTicker()
+		case <-maintenanceWindowTicker.C:
+			t.onMaintenanceWindowTicker()
 		case <-t.rejoinTicker.C:
 			t.onRejoinGracePeriodExpire()
 		}
@@ -546,7 +619,9 @@ func (t *Manager) clearPending() {
 }
 
 func (t *Manager) getStats() (node.Stats, error) {
-	stats := node.Stats{}
+	stats := node.Stats{
+		NumCPU: runtime.NumCPU(),
+	}
 	if runtime.GOOS != "linux" {
 		return stats, nil
 	}
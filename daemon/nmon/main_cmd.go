@@ -8,6 +8,8 @@ import (
 	"slices"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/opensvc/om3/core/clusternode"
 	"github.com/opensvc/om3/core/node"
 	"github.com/opensvc/om3/core/rawconfig"
@@ -49,6 +51,8 @@ func (t *Manager) onClusterConfigUpdated(c *msgbus.ClusterConfigUpdated) {
 
 	// recompute rejoin ticker, perhaps RejoinGracePeriod has been changed
 	t.checkRejoinTicker()
+
+	t.onMaintenanceWindowConfigUpdated()
 }
 
 // onConfigFileUpdated reloads the config parser and emits the updated
@@ -66,11 +70,15 @@ func (t *Manager) onConfigFileUpdated(_ *msgbus.ConfigFileUpdated) {
 
 	// recompute rejoin ticker, perhaps RejoinGracePeriod has been changed
 	t.checkRejoinTicker()
+
+	t.onMaintenanceWindowConfigUpdated()
 }
 
 func (t *Manager) getNodeConfig() node.Config {
 	var (
 		keyMaintenanceGracePeriod = key.New("node", "maintenance_grace_period")
+		keyMaintenanceWindow      = key.New("node", "maintenance_window")
+		keyMaintenanceWindowAllow = key.New("node", "maintenance_window_allow")
 		keyMaxParallel            = key.New("node", "max_parallel")
 		keyReadyPeriod            = key.New("node", "ready_period")
 		keyRejoinGracePeriod      = key.New("node", "rejoin_grace_period")
@@ -87,6 +95,8 @@ func (t *Manager) getNodeConfig() node.Config {
 	if d := t.config.GetDuration(keyRejoinGracePeriod); d != nil {
 		cfg.RejoinGracePeriod = *d
 	}
+	cfg.MaintenanceWindow = t.config.GetString(keyMaintenanceWindow)
+	cfg.MaintenanceWindowAllow = t.config.GetStrings(keyMaintenanceWindowAllow)
 	cfg.MaxParallel = t.config.GetInt(keyMaxParallel)
 	cfg.Env = t.config.GetString(keyEnv)
 	cfg.SplitAction = t.config.GetString(keySplitAction)
@@ -195,6 +205,14 @@ func (t *Manager) onSetNodeMonitor(c *msgbus.SetNodeMonitor) {
 			t.change = true
 			t.state.GlobalExpect = *c.Value.GlobalExpect
 			t.state.GlobalExpectUpdatedAt = time.Now()
+			if *c.Value.GlobalExpect == node.MonitorGlobalExpectRestarted {
+				if c.Value.RollingRestart != nil {
+					t.state.RollingRestart = *c.Value.RollingRestart
+				} else {
+					t.state.RollingRestart = node.RollingRestart{ID: uuid.New(), Since: t.state.GlobalExpectUpdatedAt}
+				}
+				t.log.Infof("rolling restart %s of the nodes not yet restarted", t.state.RollingRestart.ID)
+			}
 		}
 		return nil
 	}
@@ -243,8 +261,13 @@ func (t *Manager) onForgetPeer(c *msgbus.ForgetPeer) {
 
 	if len(t.livePeers) > len(t.clusterConfig.Nodes)/2 {
 		t.log.Infof("forget %s peer %s, we still have nodes quorum %d > %d", forgetType, c.Node, len(t.livePeers), len(t.clusterConfig.Nodes)/2)
+		t.lostQuorum = false
+		t.stonithNext()
 		return
 	}
+	// From here, the lost peers are not fenced unless the arbitrator votes
+	// give us quorum.
+	t.lostQuorum = true
 	if !t.clusterConfig.Quorum {
 		t.log.Warnf("cluster is split, ignore as cluster.quorum is false")
 		return
@@ -263,6 +286,8 @@ func (t *Manager) onForgetPeer(c *msgbus.ForgetPeer) {
 	}
 	if votes > total/2 {
 		t.log.Warnf("cluster is split, we have quorum: %d+%d out of %d votes (%s + %s)", len(t.livePeers), len(arbitratorVotes), total, livePeers, arbitratorVotes)
+		t.lostQuorum = false
+		t.stonithNext()
 		return
 	}
 	action := t.nodeConfig.SplitAction
@@ -312,14 +337,28 @@ func (t *Manager) onNodeMonitorDeleted(c *msgbus.NodeMonitorDeleted) {
 	t.updateIfChange()
 }
 
+// onPeerNodeStatusUpdated orchestrates the rolling restart, which waits for
+// the restarted peers to be unfrozen.
+func (t *Manager) onPeerNodeStatusUpdated(_ *msgbus.NodeStatusUpdated) {
+	if t.state.GlobalExpect != node.MonitorGlobalExpectRestarted {
+		return
+	}
+	t.orchestrate()
+}
+
 func (t *Manager) onPeerNodeMonitorUpdated(c *msgbus.NodeMonitorUpdated) {
 	t.log.Debugf("updated nmon from node %s  -> %s", c.Node, c.Value.GlobalExpect)
 	t.nodeMonitor[c.Node] = c.Value
 	if _, ok := t.livePeers[c.Node]; !ok {
 		t.livePeers[c.Node] = true
 		t.log.Infof("new peer %s: new live peers: %v", c.Node, t.livePeers)
+		t.stonithForget(c.Node)
+		if len(t.livePeers) > len(t.clusterConfig.Nodes)/2 {
+			t.lostQuorum = false
+		}
 		if t.updateSpeaker() {
 			t.publishNodeStatus()
+			t.stonithNext()
 		}
 	}
 	t.convergeGlobalExpectFromRemote()
@@ -0,0 +1,44 @@
+package nmon
+
+import (
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/core/maintenance"
+)
+
+// onMaintenanceWindowConfigUpdated evaluates the node maintenance window
+// after a node config change, and warns about an invalid schedule.
+func (t *Manager) onMaintenanceWindowConfigUpdated() {
+	if err := t.updateMaintenanceWindow(); err != nil {
+		t.log.Warnf("maintenance window: %s", err)
+	}
+}
+
+func (t *Manager) onMaintenanceWindowTicker() {
+	if err := t.updateMaintenanceWindow(); err != nil {
+		t.log.Debugf("maintenance window: %s", err)
+	}
+}
+
+// updateMaintenanceWindow sets the active node maintenance window in the
+// node monitor state. The imon of the local instances suspend their HA
+// orchestration while the window is set, and resume it when it is unset.
+func (t *Manager) updateMaintenanceWindow() error {
+	window, err := maintenance.Active(t.nodeConfig.MaintenanceWindow, t.nodeConfig.MaintenanceWindowAllow, time.Now())
+	if window.Equal(t.state.MaintenanceWindow) {
+		return err
+	}
+	switch {
+	case window == nil:
+		t.log.Infof("maintenance window ended: resume the HA orchestration")
+	case len(window.Allow) > 0:
+		t.log.Infof("maintenance window until %s: suspend the HA orchestration, except %s", window.Until, strings.Join(window.Allow, ", "))
+	default:
+		t.log.Infof("maintenance window until %s: suspend the HA orchestration", window.Until)
+	}
+	t.state.MaintenanceWindow = window
+	t.change = true
+	t.updateIfChange()
+	return err
+}
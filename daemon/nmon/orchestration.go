@@ -28,6 +28,8 @@ func (t *Manager) orchestrate() {
 		t.orchestrateAborted()
 	case node.MonitorGlobalExpectFrozen:
 		t.orchestrateFrozen()
+	case node.MonitorGlobalExpectRestarted:
+		t.orchestrateRestarted()
 	case node.MonitorGlobalExpectThawed:
 		t.orchestrateThawed()
 	}
@@ -13,5 +13,13 @@ func (t *Manager) orchestrateAborted() {
 		t.state.LocalExpect = node.MonitorLocalExpectNone
 	}
 
+	// an aborted drain or rolling restart can leave the node drained, and
+	// a failed rolling restart leaves the node upgrade failed. The node
+	// stays frozen until an explicit unfreeze.
+	switch t.state.State {
+	case node.MonitorStateDrained, node.MonitorStateUpgradeFailed:
+		t.state.State = node.MonitorStateIdle
+	}
+
 	t.updateIfChange()
 }
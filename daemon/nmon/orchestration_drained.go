@@ -16,6 +16,10 @@ func (t *Manager) orchestrateDrained() {
 	case node.MonitorStateFrozen:
 		t.drainFromFrozen()
 	case node.MonitorStateDrained:
+		if t.state.GlobalExpect == node.MonitorGlobalExpectRestarted {
+			// the rolling restart continues from the drained state
+			return
+		}
 		t.change = true
 		t.state.State = node.MonitorStateIdle
 		t.state.LocalExpect = node.MonitorLocalExpectNone
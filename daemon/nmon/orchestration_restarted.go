@@ -0,0 +1,291 @@
+package nmon
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/file"
+)
+
+type (
+	// rollingRestartMark is the rolling restart progress of the local node
+	// persisted before its daemon restart, and loaded by the restarted
+	// daemon.
+	rollingRestartMark struct {
+		// ID is the id of the rolling restart that restarts the daemon.
+		ID uuid.UUID `json:"id"`
+
+		// GiveBack is true when the node is to be unfrozen once restarted,
+		// because it was frozen by the rolling restart drain.
+		GiveBack bool `json:"give_back"`
+	}
+)
+
+// orchestrateRestarted drives the cluster rolling restart. The live nodes
+// are processed one at a time, in the cluster nodes order:
+//
+//	drain: freeze and shutdown the local instances
+//	=> upgrade: install the new binary and restart the daemon
+//	=> rejoin
+//	=> give back: unfreeze
+//
+// A node is done when its restarted daemon reports the rolling restart id,
+// so a rolling restart resumed after an abort continues with the nodes not
+// yet restarted. The progress relies on the node monitor states only, not on
+// the node clocks.
+func (t *Manager) orchestrateRestarted() {
+	if t.isRollingRestartDone(t.localhost) {
+		t.restartedGiveBack()
+		return
+	}
+	switch t.state.State {
+	case node.MonitorStateIdle:
+		t.restartedFromIdle()
+	case node.MonitorStateDrained:
+		t.restartedFromDrained()
+	}
+}
+
+func (t *Manager) restartedFromIdle() {
+	if t.state.LocalExpect == node.MonitorLocalExpectDrained {
+		return
+	}
+	if !t.isRollingRestartTurn() {
+		return
+	}
+	if binary := t.state.RollingRestart.Binary; binary != "" {
+		if err := checkBinary(binary); err != nil {
+			t.log.Errorf("rolling restart: %s", err)
+			t.publishRollingRestartProgress("binary check failed")
+			t.transitionTo(node.MonitorStateUpgradeFailed)
+			return
+		}
+	}
+	t.rollingRestartGiveBack = t.nodeStatus.FrozenAt.IsZero()
+	t.log.Infof("rolling restart: drain")
+	t.publishRollingRestartProgress("drain")
+	t.change = true
+	t.state.LocalExpect = node.MonitorLocalExpectDrained
+	t.orchestrateDrained()
+}
+
+func (t *Manager) restartedFromDrained() {
+	t.change = true
+	t.state.LocalExpect = node.MonitorLocalExpectNone
+	t.transitionTo(node.MonitorStateUpgrade)
+	t.publishRollingRestartProgress("restart")
+	binary := t.state.RollingRestart.Binary
+	mark := rollingRestartMark{
+		ID:       t.state.RollingRestart.ID,
+		GiveBack: t.rollingRestartGiveBack,
+	}
+	go func() {
+		if binary != "" {
+			t.log.Infof("rolling restart: install %s", binary)
+			if err := installBinary(binary); err != nil {
+				t.log.Errorf("rolling restart: install %s: %s", binary, err)
+				t.cmdC <- cmdOrchestrate{state: node.MonitorStateUpgrade, newState: node.MonitorStateUpgradeFailed}
+				return
+			}
+		}
+		if err := saveRollingRestartMark(mark); err != nil {
+			t.log.Errorf("rolling restart: %s", err)
+			t.cmdC <- cmdOrchestrate{state: node.MonitorStateUpgrade, newState: node.MonitorStateUpgradeFailed}
+			return
+		}
+		t.log.Infof("rolling restart: run daemon restart")
+		if err := t.crmDaemonRestart(); err != nil {
+			t.cmdC <- cmdOrchestrate{state: node.MonitorStateUpgrade, newState: node.MonitorStateUpgradeFailed}
+		}
+	}()
+}
+
+// restartedGiveBack unfreezes the restarted node if it was frozen by the
+// rolling restart drain, and unsets the global expect when all the live
+// nodes are restarted and unfrozen.
+func (t *Manager) restartedGiveBack() {
+	if t.state.State != node.MonitorStateIdle {
+		return
+	}
+	if t.state.RollingRestartGiveBack {
+		t.log.Infof("rolling restart: give back, run action unfreeze")
+		t.publishRollingRestartProgress("give back")
+		t.transitionTo(node.MonitorStateThawing)
+		nextState := node.MonitorStateIdle
+		if err := t.crmUnfreeze(); err != nil {
+			nextState = node.MonitorStateThawedFailed
+		} else {
+			t.change = true
+			t.state.RollingRestartGiveBack = false
+			mark := rollingRestartMark{ID: t.state.RollingRestartDoneID}
+			if err := saveRollingRestartMark(mark); err != nil {
+				t.log.Warnf("rolling restart: %s", err)
+			}
+		}
+		go t.orchestrateAfterAction(node.MonitorStateThawing, nextState)
+		return
+	}
+	if _, pending := t.rollingRestartProgress(); len(pending) > 0 {
+		return
+	}
+	if t.hasRollingRestartBusyPeer() {
+		return
+	}
+	t.log.Infof("rolling restart: all nodes restarted, unset global expect")
+	t.publishRollingRestartProgress("completed")
+	t.change = true
+	t.state.GlobalExpect = node.MonitorGlobalExpectNone
+	t.clearPending()
+}
+
+// isRollingRestartDone returns true if the node daemon was restarted by the
+// rolling restart.
+func (t *Manager) isRollingRestartDone(nodename string) bool {
+	id := t.state.RollingRestart.ID
+	if id == uuid.Nil {
+		return false
+	}
+	if nodename == t.localhost {
+		return t.state.RollingRestartDoneID == id
+	}
+	nodeMonitor, ok := t.nodeMonitor[nodename]
+	return ok && nodeMonitor.RollingRestartDoneID == id
+}
+
+// rollingRestartProgress returns the live cluster nodes already restarted
+// and the live cluster nodes not yet restarted, in the cluster nodes order.
+// The lost nodes are skipped.
+func (t *Manager) rollingRestartProgress() (done, pending []string) {
+	done = make([]string, 0)
+	pending = make([]string, 0)
+	for _, nodename := range t.clusterConfig.Nodes {
+		if !t.livePeers[nodename] {
+			continue
+		}
+		if t.isRollingRestartDone(nodename) {
+			done = append(done, nodename)
+		} else {
+			pending = append(pending, nodename)
+		}
+	}
+	return
+}
+
+// isRollingRestartTurn returns true if the local node is the first live
+// node not yet restarted, and no peer is busy restarting or giving back
+// its objects.
+func (t *Manager) isRollingRestartTurn() bool {
+	_, pending := t.rollingRestartProgress()
+	if len(pending) == 0 || pending[0] != t.localhost {
+		return false
+	}
+	return !t.hasRollingRestartBusyPeer()
+}
+
+// hasRollingRestartBusyPeer returns true if a live peer is not idle, or is
+// restarted but still frozen by its rolling restart drain.
+func (t *Manager) hasRollingRestartBusyPeer() bool {
+	for nodename := range t.livePeers {
+		if nodename == t.localhost {
+			continue
+		}
+		nodeMonitor, ok := t.nodeMonitor[nodename]
+		if !ok {
+			continue
+		}
+		if nodeMonitor.State != node.MonitorStateIdle {
+			t.log.Debugf("rolling restart: wait peer %s state %s", nodename, nodeMonitor.State)
+			return true
+		}
+		if t.isRollingRestartDone(nodename) && nodeMonitor.RollingRestartGiveBack {
+			t.log.Debugf("rolling restart: wait peer %s give back", nodename)
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Manager) publishRollingRestartProgress(stage string) {
+	done, pending := t.rollingRestartProgress()
+	t.bus.Pub(&msgbus.NodeRollingRestartProgress{
+		Node:    t.localhost,
+		Stage:   stage,
+		Done:    done,
+		Pending: pending,
+		Value:   t.state.RollingRestart,
+	}, t.labelLocalhost)
+}
+
+// rollingRestartMarkFile returns the path of the file persisting the local
+// node rolling restart progress across the daemon restart.
+func rollingRestartMarkFile() string {
+	return filepath.Join(rawconfig.Paths.Var, "node", "rolling_restart.json")
+}
+
+// loadRollingRestartMark sets the local node monitor rolling restart
+// progress from the mark persisted before the daemon restart, if any.
+func (t *Manager) loadRollingRestartMark() {
+	b, err := os.ReadFile(rollingRestartMarkFile())
+	if os.IsNotExist(err) {
+		return
+	} else if err != nil {
+		t.log.Warnf("rolling restart: load mark: %s", err)
+		return
+	}
+	var mark rollingRestartMark
+	if err := json.Unmarshal(b, &mark); err != nil {
+		t.log.Warnf("rolling restart: load mark: %s", err)
+		return
+	}
+	t.state.RollingRestartDoneID = mark.ID
+	t.state.RollingRestartGiveBack = mark.GiveBack
+}
+
+func saveRollingRestartMark(mark rollingRestartMark) error {
+	b, err := json.Marshal(mark)
+	if err != nil {
+		return err
+	}
+	p := rollingRestartMarkFile()
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(p, b, 0644); err != nil {
+		return fmt.Errorf("save mark: %w", err)
+	}
+	return nil
+}
+
+// checkBinary returns an error if the om binary to install is not a
+// regular file on the local node.
+func checkBinary(p string) error {
+	if v, err := file.ExistsAndRegular(p); err != nil {
+		return fmt.Errorf("binary %s: %w", p, err)
+	} else if !v {
+		return fmt.Errorf("binary %s is not a regular file", p)
+	}
+	return nil
+}
+
+// installBinary replaces the om binary with the src file. The new binary
+// is used by the next daemon start.
+func installBinary(src string) error {
+	if src == cmdPath {
+		return nil
+	}
+	tmp := cmdPath + ".new"
+	if err := file.Copy(src, tmp); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp, 0755); err != nil {
+		return err
+	}
+	return os.Rename(tmp, cmdPath)
+}
@@ -0,0 +1,241 @@
+package nmon
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/key"
+)
+
+type (
+	// cmdStonithDone is sent to the worker by the stonith command goroutine
+	cmdStonithDone struct {
+		record node.StonithRecord
+	}
+
+	// cmdStonithRetry is sent to the worker when a failed stonith must
+	// be retried
+	cmdStonithRetry struct {
+		peer string
+	}
+)
+
+var (
+	// stonithTimeout is the maximum duration of a stonith command
+	stonithTimeout = 2 * time.Minute
+
+	// stonithRetryDelay is the delay before retrying a failed stonith
+	stonithRetryDelay = 10 * time.Second
+
+	// stonithHistoryMax is the maximum number of stonith records kept in the
+	// node status
+	stonithHistoryMax = 20
+)
+
+// onInstanceMonitorUpdated queues the stonith of the lost peers an instance
+// is waiting for before a takeover.
+//
+// All nodes maintain the stonith queue, so a new speaker can continue the
+// work, but only the speaker executes the stonith commands.
+func (t *Manager) onInstanceMonitorUpdated(c *msgbus.InstanceMonitorUpdated) {
+	for peer, id := range c.Value.StonithRequests {
+		t.stonithRequest(peer, id)
+	}
+}
+
+func (t *Manager) onStonithRetry(c cmdStonithRetry) {
+	t.stonithQueuePeer(c.peer)
+}
+
+// stonithRequest records the stonith request id of the peer, and queues a
+// stonith of the peer, unless the peer is alive, already queued, or the
+// request is already fulfilled.
+//
+// A request for a peer already fenced by the local node since it was lost
+// is fulfilled by the last successful stonith record, without fencing the
+// peer again.
+func (t *Manager) stonithRequest(peer string, id uuid.UUID) {
+	if peer == t.localhost {
+		return
+	}
+	if _, ok := t.livePeers[peer]; ok {
+		return
+	}
+	if t.isStonithDone(peer, id) || slices.Contains(t.stonithRequests[peer], id) {
+		return
+	}
+	if t.stonithFenced[peer] && t.stonithFulfill(peer, id) {
+		return
+	}
+	t.stonithRequests[peer] = append(t.stonithRequests[peer], id)
+	t.stonithQueuePeer(peer)
+}
+
+func (t *Manager) stonithQueuePeer(peer string) {
+	if t.stonithRunning == peer || slices.Contains(t.stonithQueue, peer) {
+		return
+	}
+	t.log.Infof("stonith of lost peer %s queued", peer)
+	t.stonithQueue = append(t.stonithQueue, peer)
+	t.stonithNext()
+}
+
+// stonithFulfill adds the request id to the last successful stonith record
+// of the peer in the local node status, and returns false if there is no
+// such record.
+func (t *Manager) stonithFulfill(peer string, id uuid.UUID) bool {
+	for i := len(t.nodeStatus.Stonith) - 1; i >= 0; i-- {
+		record := &t.nodeStatus.Stonith[i]
+		if record.Peer != peer || !record.Success {
+			continue
+		}
+		t.log.Infof("stonith of lost peer %s already done", peer)
+		record.Requests = append(record.Requests, id)
+		t.publishNodeStatus()
+		return true
+	}
+	return false
+}
+
+// stonithForget drops the peer from the stonith queue. It is called when the
+// peer is alive again.
+func (t *Manager) stonithForget(peer string) {
+	delete(t.stonithRequests, peer)
+	delete(t.stonithFenced, peer)
+	if !slices.Contains(t.stonithQueue, peer) {
+		return
+	}
+	t.log.Infof("lost peer %s is back: drop its queued stonith", peer)
+	t.stonithQueue = slices.DeleteFunc(t.stonithQueue, func(s string) bool {
+		return s == peer
+	})
+}
+
+// stonithNext starts the stonith command of the next queued peer, if we are
+// the speaker, we have quorum and no other stonith command is running.
+func (t *Manager) stonithNext() {
+	if t.stonithRunning != "" || len(t.stonithQueue) == 0 {
+		return
+	}
+	if !t.isSpeakerNode() {
+		return
+	}
+	if t.lostQuorum {
+		t.log.Warnf("stonith of lost peers %s delayed: we don't have quorum", t.stonithQueue)
+		return
+	}
+	peer := t.stonithQueue[0]
+	t.stonithQueue = t.stonithQueue[1:]
+	t.stonithRequests[peer] = slices.DeleteFunc(t.stonithRequests[peer], func(id uuid.UUID) bool {
+		return t.isStonithDone(peer, id)
+	})
+	if len(t.stonithRequests[peer]) == 0 {
+		t.log.Infof("stonith of lost peer %s already done", peer)
+		delete(t.stonithRequests, peer)
+		t.stonithNext()
+		return
+	}
+	argv := t.config.GetStrings(key.New("stonith#"+peer, "cmd"))
+	t.stonithRunning = peer
+	t.log.Warnf("stonith of lost peer %s: %s", peer, argv)
+	t.bus.Pub(&msgbus.NodeStonithStarted{Node: t.localhost, Peer: peer, Cmd: argv}, t.labelLocalhost)
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		record := t.stonith(peer, argv)
+		select {
+		case <-t.ctx.Done():
+		case t.cmdC <- cmdStonithDone{record: record}:
+		}
+	}()
+}
+
+// stonith executes the stonith command argv of the peer and returns the
+// execution record.
+func (t *Manager) stonith(peer string, argv []string) node.StonithRecord {
+	record := node.StonithRecord{
+		Peer:      peer,
+		StartedAt: time.Now(),
+	}
+	if len(argv) == 0 {
+		record.Error = fmt.Sprintf("no stonith#%s.cmd defined", peer)
+	} else {
+		cmd := command.New(
+			command.WithName(argv[0]),
+			command.WithArgs(argv[1:]),
+			command.WithContext(t.ctx),
+			command.WithTimeout(stonithTimeout),
+			command.WithLogger(t.log),
+		)
+		if err := cmd.Run(); err != nil {
+			record.Error = err.Error()
+		} else {
+			record.Success = true
+		}
+	}
+	record.EndedAt = time.Now()
+	return record
+}
+
+// onStonithDone records the stonith result in the node status history, so
+// the imon of the nodes waiting for this stonith can proceed with the
+// takeover, and schedules a retry on failure.
+//
+// A successful stonith fulfills all the requests received for the peer,
+// including the ones received while the command was running.
+func (t *Manager) onStonithDone(c cmdStonithDone) {
+	t.stonithRunning = ""
+	if _, ok := t.livePeers[c.record.Peer]; c.record.Success && !ok {
+		c.record.Requests = t.stonithRequests[c.record.Peer]
+		delete(t.stonithRequests, c.record.Peer)
+		t.stonithFenced[c.record.Peer] = true
+	}
+	t.nodeStatus.Stonith = append(t.nodeStatus.Stonith, c.record)
+	if n := len(t.nodeStatus.Stonith); n > stonithHistoryMax {
+		t.nodeStatus.Stonith = t.nodeStatus.Stonith[n-stonithHistoryMax:]
+	}
+	t.publishNodeStatus()
+	t.bus.Pub(&msgbus.NodeStonithFinished{Node: t.localhost, Value: c.record}, t.labelLocalhost)
+	if c.record.Success {
+		t.log.Infof("stonith of lost peer %s succeeded", c.record.Peer)
+	} else {
+		t.log.Errorf("stonith of lost peer %s failed: %s: retry in %s", c.record.Peer, c.record.Error, stonithRetryDelay)
+		t.wg.Add(1)
+		go func(peer string) {
+			defer t.wg.Done()
+			select {
+			case <-t.ctx.Done():
+				return
+			case <-time.After(stonithRetryDelay):
+			}
+			select {
+			case <-t.ctx.Done():
+			case t.cmdC <- cmdStonithRetry{peer: peer}:
+			}
+		}(c.record.Peer)
+	}
+	t.stonithNext()
+}
+
+// isStonithDone returns true if a node status reports a successful stonith
+// of the peer fulfilling the request id.
+func (t *Manager) isStonithDone(peer string, id uuid.UUID) bool {
+	if t.nodeStatus.IsStonithDone(peer, id) {
+		return true
+	}
+	for _, v := range node.StatusData.GetAll() {
+		if v.Node == t.localhost {
+			continue
+		}
+		if v.Value.IsStonithDone(peer, id) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,286 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/event"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/objectselector"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	// endpoint posts the events selected by a notify#<name> section to its
+	// url. The events are queued, and posted in order by the endpoint
+	// goroutine.
+	endpoint struct {
+		name    string
+		config  cluster.ConfigNotify
+		filters filters
+		templ   *template.Template
+		client  *http.Client
+		log     *plog.Logger
+
+		ctx    context.Context
+		cancel context.CancelFunc
+		wg     sync.WaitGroup
+		queue  chan *event.Event
+
+		// mu protects status and changed, updated by the endpoint
+		// goroutine and read by the manager.
+		mu      sync.Mutex
+		status  daemonsubsystem.NotifyEndpoint
+		changed bool
+	}
+)
+
+var (
+	// queueSize is the maximum number of events waiting for delivery on
+	// an endpoint. The events are dropped when the queue is full.
+	queueSize = 1000
+
+	// maxRetryDelay caps the delay between two delivery retries.
+	maxRetryDelay = time.Minute
+)
+
+func newEndpoint(name string, config cluster.ConfigNotify, log *plog.Logger) (*endpoint, error) {
+	t := &endpoint{
+		name:   name,
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		log:    log.Attr("notify", name).WithPrefix(log.Prefix() + name + ": "),
+		queue:  make(chan *event.Event, queueSize),
+		status: daemonsubsystem.NotifyEndpoint{
+			Host:  urlHost(config.URL),
+			State: "idle",
+		},
+	}
+	if u, err := url.Parse(config.URL); err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid url: unsupported scheme '%s'", u.Scheme)
+	}
+	if l, err := parseFilters(config.Events); err != nil {
+		return nil, fmt.Errorf("invalid events: %w", err)
+	} else {
+		t.filters = l
+	}
+	if config.Selector != "" {
+		if err := objectselector.New(config.Selector, objectselector.WithPaths(naming.Paths{})).CheckFilters(); err != nil {
+			return nil, fmt.Errorf("invalid selector: %w", err)
+		}
+	}
+	if config.Template != "" {
+		if templ, err := template.New(name).Parse(config.Template); err != nil {
+			return nil, fmt.Errorf("invalid template: %w", err)
+		} else {
+			t.templ = templ
+		}
+	}
+	return t, nil
+}
+
+// urlHost returns the scheme and host of the url <s>.
+func urlHost(s string) string {
+	u, err := url.Parse(s)
+	if err != nil {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+func (t *endpoint) start(ctx context.Context) {
+	t.ctx, t.cancel = context.WithCancel(ctx)
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		for {
+			select {
+			case <-t.ctx.Done():
+				return
+			case ev := <-t.queue:
+				t.deliver(ev)
+			}
+		}
+	}()
+}
+
+func (t *endpoint) stop() {
+	t.cancel()
+	t.wg.Wait()
+}
+
+// selects returns true if the endpoint posts the message <msg>.
+func (t *endpoint) selects(msg pubsub.Messager) bool {
+	if !t.filters.match(msg) {
+		return false
+	}
+	if t.config.Selector == "" {
+		return true
+	}
+	s, ok := msg.GetLabels()["path"]
+	if !ok {
+		// not an object event
+		return true
+	}
+	p, err := naming.ParsePath(s)
+	if err != nil {
+		return false
+	}
+	selected, err := objectselector.New(
+		t.config.Selector,
+		objectselector.WithPaths(naming.Paths{p}),
+		objectselector.WithLocal(true),
+		objectselector.WithConfigFilterDisabled(),
+	).Expand()
+	return err == nil && len(selected) > 0
+}
+
+// enqueue queues the event <ev> for delivery, or drops it if the queue is
+// full.
+func (t *endpoint) enqueue(ev *event.Event) {
+	select {
+	case t.queue <- ev:
+	default:
+		t.mu.Lock()
+		if t.status.Dropped == 0 {
+			t.log.Warnf("delivery queue is full, drop events")
+		}
+		t.status.Dropped++
+		t.changed = true
+		t.mu.Unlock()
+	}
+}
+
+// deliver posts the event <ev>, and retries with backoff on failure.
+func (t *endpoint) deliver(ev *event.Event) {
+	b, err := t.payload(ev)
+	if err != nil {
+		t.onFailed(fmt.Errorf("payload: %w", err))
+		return
+	}
+	delay := t.config.RetryDelay
+	for attempt := 0; ; attempt++ {
+		err = t.post(b)
+		if err == nil {
+			t.onSent()
+			return
+		}
+		if attempt >= t.config.Retries {
+			t.onFailed(err)
+			return
+		}
+		t.log.Debugf("post event %d attempt %d: %s", ev.ID, attempt+1, err)
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, maxRetryDelay)
+	}
+}
+
+// payload returns the posted payload for the event <ev>: the json event, or
+// the template executed with the event fields.
+func (t *endpoint) payload(ev *event.Event) ([]byte, error) {
+	b, err := json.Marshal(ev)
+	if err != nil || t.templ == nil {
+		return b, err
+	}
+	var data any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err := t.templ.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// contentType returns the Content-Type header of the posted payloads. The
+// templated payloads have no default content type, as the template may not
+// produce json.
+func (t *endpoint) contentType() string {
+	switch {
+	case t.config.ContentType != "":
+		return t.config.ContentType
+	case t.templ == nil:
+		return "application/json"
+	default:
+		return ""
+	}
+}
+
+func (t *endpoint) post(b []byte) error {
+	req, err := http.NewRequestWithContext(t.ctx, http.MethodPost, t.config.URL, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	if contentType := t.contentType(); contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		// don't log the url, as it may embed credentials
+		if uErr, ok := err.(*url.Error); ok {
+			err = uErr.Err
+		}
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func (t *endpoint) onSent() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.status.State == "failing" {
+		t.log.Infof("delivery recovered")
+	}
+	t.status.State = "ok"
+	t.status.Sent++
+	t.status.LastSentAt = time.Now()
+	t.changed = true
+}
+
+func (t *endpoint) onFailed(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.status.State != "failing" {
+		t.log.Warnf("delivery failed: %s", err)
+	}
+	t.status.State = "failing"
+	t.status.Failed++
+	t.status.LastFailedAt = time.Now()
+	t.status.LastError = err.Error()
+	t.changed = true
+}
+
+// getStatus returns the endpoint status, and true if it changed since the
+// last call.
+func (t *endpoint) getStatus() (daemonsubsystem.NotifyEndpoint, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	changed := t.changed
+	t.changed = false
+	status := t.status
+	status.Queued = len(t.queue)
+	return status, changed
+}
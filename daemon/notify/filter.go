@@ -0,0 +1,98 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/opensvc/om3/core/event"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	// filter selects the messages of a kind, with labels.
+	filter struct {
+		// kind is the message kind, or "" to match all kinds.
+		kind   string
+		labels []pubsub.Label
+	}
+
+	filters []filter
+)
+
+var (
+	// clusterKinds is the kinds of the messages describing the cluster view.
+	// All nodes publish them, so only the speaker node posts them.
+	clusterKinds = map[string]bool{
+		"ClusterConfigUpdated": true,
+		"ClusterStatusUpdated": true,
+		"ObjectStatusDeleted":  true,
+		"ObjectStatusUpdated":  true,
+	}
+)
+
+// parseFilter returns the filter from s, with the "<kind>[,<label>=<value>]*"
+// format.
+func parseFilter(s string) (filter, error) {
+	var f filter
+	kindLabels := strings.Split(s, ",")
+	if kind := kindLabels[0]; kind != "" {
+		if _, err := msgbus.KindToT(kind); err != nil {
+			return f, err
+		}
+		f.kind = kind
+	}
+	for _, labelElem := range kindLabels[1:] {
+		k, v, ok := strings.Cut(labelElem, "=")
+		if !ok {
+			return f, fmt.Errorf("invalid filter expression: %s", s)
+		}
+		f.labels = append(f.labels, pubsub.Label{k, v})
+	}
+	return f, nil
+}
+
+func parseFilters(l []string) (filters, error) {
+	var fs filters
+	for _, s := range l {
+		if s == "" {
+			continue
+		}
+		f, err := parseFilter(s)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, f)
+	}
+	return fs, nil
+}
+
+// match returns true if the message <msg> has the filter kind and labels.
+func (f filter) match(msg pubsub.Messager) bool {
+	if f.kind != "" {
+		if k, ok := msg.(event.Kinder); !ok || k.Kind() != f.kind {
+			return false
+		}
+	}
+	labels := msg.GetLabels()
+	for _, label := range f.labels {
+		if v, ok := labels[label[0]]; !ok || v != label[1] {
+			return false
+		}
+	}
+	return true
+}
+
+// match returns true if one of the filters matches the message <msg>, or if
+// there is no filter.
+func (t filters) match(msg pubsub.Messager) bool {
+	if len(t) == 0 {
+		return true
+	}
+	for _, f := range t {
+		if f.match(msg) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+// Package notify posts the daemon events to the webhooks defined in the
+// notify#<name> sections of the cluster configuration.
+//
+// The events describing the cluster view or the drop of a lost peer are
+// posted by the speaker node only, and the other events by the node they are
+// published on, so the webhooks don't receive duplicates.
+package notify
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/event"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/daemon/daemonsubsystem"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	Manager struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		bus    *pubsub.Bus
+		log    *plog.Logger
+		wg     sync.WaitGroup
+
+		sub   *pubsub.Subscription
+		subQS pubsub.QueueSizer
+
+		localhost string
+
+		// endpoints is the running endpoints, indexed by section name.
+		endpoints map[string]*endpoint
+
+		// eventID is the id of the last posted event.
+		eventID uint64
+
+		// status is the daemon notify subsystem status
+		//    - state: "running" when started, or ""
+		//    - configured_at: is the time of the last endpoints change
+		//    - updated_at: is the time of the last status publication
+		status daemonsubsystem.Notify
+	}
+)
+
+var (
+	// publishInterval is the minimum interval between two publications of
+	// the subsystem status on delivery status changes.
+	publishInterval = time.Second
+)
+
+func NewManager(subQS pubsub.QueueSizer) *Manager {
+	return &Manager{
+		log: plog.NewDefaultLogger().
+			Attr("pkg", "daemon/notify").
+			WithPrefix("daemon: notify: "),
+		subQS:     subQS,
+		localhost: hostname.Hostname(),
+		endpoints: make(map[string]*endpoint),
+		status: daemonsubsystem.Notify{
+			Status:    daemonsubsystem.Status{ID: "notify", CreatedAt: time.Now()},
+			Endpoints: make(map[string]daemonsubsystem.NotifyEndpoint),
+		},
+	}
+}
+
+// Start launches the notify worker goroutine
+func (t *Manager) Start(parent context.Context) error {
+	t.log.Infof("starting")
+	t.ctx, t.cancel = context.WithCancel(parent)
+	t.bus = pubsub.BusFromContext(t.ctx)
+	t.status.State = "running"
+
+	// the endpoints select their events, so subscribe to all messages
+	t.sub = t.bus.Sub("daemon.notify", t.subQS)
+	t.sub.AddFilter(nil)
+	t.sub.Start()
+
+	t.configure(cluster.ConfigData.Get())
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		defer func() {
+			if err := t.sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Errorf("subscription stop: %s", err)
+			}
+			for _, e := range t.endpoints {
+				e.stop()
+			}
+			t.status.State = ""
+			t.publishUpdate()
+		}()
+		t.worker()
+	}()
+	t.log.Infof("started")
+	return nil
+}
+
+func (t *Manager) Stop() error {
+	t.log.Infof("stopping")
+	defer t.log.Infof("stopped")
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+func (t *Manager) worker() {
+	ticker := time.NewTicker(publishInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+			t.refreshStatus()
+		case i := <-t.sub.C:
+			msg, ok := i.(pubsub.Messager)
+			if !ok {
+				continue
+			}
+			if c, ok := i.(*msgbus.ClusterConfigUpdated); ok && c.Node == t.localhost {
+				t.configure(&c.Value)
+			}
+			t.onMessage(msg)
+		}
+	}
+}
+
+// configure starts, restarts or stops the endpoints on notify#<name>
+// section changes.
+func (t *Manager) configure(c *cluster.Config) {
+	var changed bool
+	for name, e := range t.endpoints {
+		if config, ok := c.Notify[name]; ok && reflect.DeepEqual(config, e.config) {
+			continue
+		}
+		t.log.Infof("stop %s", name)
+		e.stop()
+		delete(t.endpoints, name)
+		delete(t.status.Endpoints, name)
+		changed = true
+	}
+	for name, config := range c.Notify {
+		if _, ok := t.endpoints[name]; ok {
+			continue
+		}
+		e, err := newEndpoint(name, config, t.log)
+		if err != nil {
+			t.log.Warnf("ignore %s: %s", name, err)
+			continue
+		}
+		t.log.Infof("start %s posting to %s", name, e.status.Host)
+		e.start(t.ctx)
+		t.endpoints[name] = e
+		t.status.Endpoints[name], _ = e.getStatus()
+		changed = true
+	}
+	if changed {
+		t.status.ConfiguredAt = time.Now()
+		t.publishUpdate()
+	}
+}
+
+// onMessage queues the message <msg> to the endpoints selecting it, if
+// the local node is responsible for posting it.
+func (t *Manager) onMessage(msg pubsub.Messager) {
+	if len(t.endpoints) == 0 || !t.isPoster(msg) {
+		return
+	}
+	var ev *event.Event
+	for _, e := range t.endpoints {
+		if !e.selects(msg) {
+			continue
+		}
+		if ev == nil {
+			t.eventID++
+			if ev = event.ToEvent(msg, t.eventID); ev == nil {
+				return
+			}
+			if ev.At.IsZero() {
+				ev.At = time.Now()
+			}
+		}
+		e.enqueue(ev)
+	}
+}
+
+// isPoster returns true if the local node is responsible for posting the
+// message <msg>: the messages relayed from peers are posted by their origin
+// node, and the messages describing the cluster view or the drop of a lost
+// peer by the speaker node.
+func (t *Manager) isPoster(msg pubsub.Messager) bool {
+	kinder, ok := msg.(event.Kinder)
+	if !ok {
+		return false
+	}
+	kind := kinder.Kind()
+	if kind == "DaemonNotifyUpdated" {
+		// don't notify our own status changes
+		return false
+	}
+	labels := msg.GetLabels()
+	if clusterKinds[kind] || labels[msgbus.LabelDropPeer[0]] == msgbus.LabelDropPeer[1] {
+		return t.isSpeaker()
+	}
+	if labels["from"] == "peer" {
+		return false
+	}
+	return true
+}
+
+func (t *Manager) isSpeaker() bool {
+	if v := node.StatusData.Get(t.localhost); v != nil {
+		return v.IsLeader
+	}
+	return false
+}
+
+// refreshStatus publishes the subsystem status if an endpoint delivery
+// status changed.
+func (t *Manager) refreshStatus() {
+	var changed bool
+	for name, e := range t.endpoints {
+		if status, ok := e.getStatus(); ok || status.Queued != t.status.Endpoints[name].Queued {
+			t.status.Endpoints[name] = status
+			changed = true
+		}
+	}
+	if changed {
+		t.publishUpdate()
+	}
+}
+
+func (t *Manager) publishUpdate() {
+	t.status.UpdatedAt = time.Now()
+	daemonsubsystem.DataNotify.Set(t.localhost, t.status.DeepCopy())
+	t.bus.Pub(&msgbus.DaemonNotifyUpdated{Node: t.localhost, Value: *t.status.DeepCopy()}, pubsub.Label{"node", t.localhost})
+}
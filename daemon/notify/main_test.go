@@ -0,0 +1,214 @@
+package notify
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/cluster"
+	"github.com/opensvc/om3/core/event"
+	"github.com/opensvc/om3/core/node"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+func newInstanceStatusUpdated(path, node string) *msgbus.InstanceStatusUpdated {
+	msg := &msgbus.InstanceStatusUpdated{Node: node}
+	msg.AddLabels(pubsub.Label{"path", path}, pubsub.Label{"node", node})
+	return msg
+}
+
+func TestFilters(t *testing.T) {
+	_, err := parseFilters([]string{"NotAKind"})
+	require.Error(t, err)
+
+	_, err = parseFilters([]string{"InstanceStatusUpdated,path"})
+	require.Error(t, err)
+
+	fs, err := parseFilters([]string{"InstanceStatusUpdated,node=n1", ",path=foo"})
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		msg      pubsub.Messager
+		expected bool
+	}{
+		"kind and labels match":     {newInstanceStatusUpdated("bar", "n1"), true},
+		"labels match without kind": {newInstanceStatusUpdated("foo", "n2"), true},
+		"label mismatch":            {newInstanceStatusUpdated("bar", "n2"), false},
+		"kind mismatch":             {&msgbus.NodeMonitorDeleted{Node: "n1"}, false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tc.expected, fs.match(tc.msg))
+		})
+	}
+
+	require.True(t, filters(nil).match(&msgbus.NodeMonitorDeleted{}), "no filter matches all")
+}
+
+func TestEndpointSelects(t *testing.T) {
+	e, err := newEndpoint("test", cluster.ConfigNotify{URL: "http://localhost", Selector: "foo*"}, plog.NewDefaultLogger())
+	require.NoError(t, err)
+	require.True(t, e.selects(newInstanceStatusUpdated("foo1", "n1")))
+	require.False(t, e.selects(newInstanceStatusUpdated("bar", "n1")))
+	require.True(t, e.selects(&msgbus.NodeMonitorDeleted{Node: "n1"}), "non object events are selected")
+}
+
+func TestNewEndpointErrors(t *testing.T) {
+	cases := map[string]cluster.ConfigNotify{
+		"unsupported scheme": {URL: "ftp://localhost"},
+		"invalid events":     {URL: "http://localhost", Events: []string{"NotAKind"}},
+		"invalid template":   {URL: "http://localhost", Template: "{{ .kind"},
+	}
+	for name, config := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := newEndpoint("test", config, plog.NewDefaultLogger())
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestEndpointDeliver(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts int
+		bodies   []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+	}))
+	defer server.Close()
+
+	config := cluster.ConfigNotify{
+		URL:        server.URL,
+		Template:   `{"text": "{{ .kind }} {{ .data.node }}"}`,
+		Retries:    1,
+		RetryDelay: time.Millisecond,
+		Timeout:    time.Second,
+	}
+	e, err := newEndpoint("test", config, plog.NewDefaultLogger())
+	require.NoError(t, err)
+	e.start(context.Background())
+	defer e.stop()
+
+	e.enqueue(event.ToEvent(&msgbus.NodeMonitorDeleted{Node: "n1"}, 1))
+	require.Eventually(t, func() bool {
+		status, _ := e.getStatus()
+		return status.Sent == 1
+	}, time.Second, 10*time.Millisecond)
+
+	mu.Lock()
+	require.Equal(t, 2, attempts, "the failed post is retried")
+	require.Equal(t, []string{`{"text": "NodeMonitorDeleted n1"}`}, bodies)
+	mu.Unlock()
+
+	status, changed := e.getStatus()
+	require.False(t, changed)
+	require.Equal(t, "ok", status.State)
+	require.Zero(t, status.Failed)
+}
+
+func TestEndpointDeliverFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	config := cluster.ConfigNotify{URL: server.URL, Retries: 2, RetryDelay: time.Millisecond, Timeout: time.Second}
+	e, err := newEndpoint("test", config, plog.NewDefaultLogger())
+	require.NoError(t, err)
+	e.start(context.Background())
+	defer e.stop()
+
+	e.enqueue(event.ToEvent(&msgbus.NodeMonitorDeleted{Node: "n1"}, 1))
+	require.Eventually(t, func() bool {
+		status, _ := e.getStatus()
+		return status.Failed == 1
+	}, time.Second, 10*time.Millisecond)
+
+	status, _ := e.getStatus()
+	require.Equal(t, "failing", status.State)
+	require.Contains(t, status.LastError, "500")
+	require.Zero(t, status.Sent)
+}
+
+func TestEndpointContentType(t *testing.T) {
+	cases := map[string]struct {
+		config   cluster.ConfigNotify
+		expected string
+	}{
+		"json event":           {cluster.ConfigNotify{URL: "http://localhost"}, "application/json"},
+		"template":             {cluster.ConfigNotify{URL: "http://localhost", Template: "{{ .kind }}"}, ""},
+		"template and setting": {cluster.ConfigNotify{URL: "http://localhost", Template: "{{ .kind }}", ContentType: "text/plain"}, "text/plain"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, err := newEndpoint("test", tc.config, plog.NewDefaultLogger())
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, e.contentType())
+		})
+	}
+}
+
+func TestIsPoster(t *testing.T) {
+	localhost := "n1"
+	newMsg := func(msg pubsub.Messager, labels ...pubsub.Label) pubsub.Messager {
+		msg.AddLabels(labels...)
+		return msg
+	}
+	fromPeer := pubsub.Label{"from", "peer"}
+	cases := map[string]struct {
+		msg        pubsub.Messager
+		speaker    bool
+		nonSpeaker bool
+	}{
+		"local event": {
+			msg:        newMsg(&msgbus.NodeMonitorDeleted{Node: localhost}, pubsub.Label{"node", localhost}),
+			speaker:    true,
+			nonSpeaker: true,
+		},
+		"relayed peer event": {
+			msg: newMsg(&msgbus.NodeMonitorDeleted{Node: "n2"}, pubsub.Label{"node", "n2"}, fromPeer),
+		},
+		"cluster event": {
+			msg:     newMsg(&msgbus.ObjectStatusUpdated{}),
+			speaker: true,
+		},
+		"dropped peer event": {
+			msg:     newMsg(&msgbus.ForgetPeer{Node: "n2"}, pubsub.Label{"node", "n2"}, fromPeer, msgbus.LabelDropPeer),
+			speaker: true,
+		},
+		"dropped peer instance event": {
+			msg:     newMsg(&msgbus.InstanceStatusDeleted{Node: "n2"}, pubsub.Label{"node", "n2"}, fromPeer, msgbus.LabelDropPeer),
+			speaker: true,
+		},
+		"own status": {
+			msg: newMsg(&msgbus.DaemonNotifyUpdated{Node: localhost}, pubsub.Label{"node", localhost}),
+		},
+	}
+	m := &Manager{localhost: localhost}
+	defer node.StatusData.Unset(localhost)
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			node.StatusData.Set(localhost, &node.Status{IsLeader: true})
+			require.Equal(t, tc.speaker, m.isPoster(tc.msg), "speaker")
+			node.StatusData.Set(localhost, &node.Status{IsLeader: false})
+			require.Equal(t, tc.nonSpeaker, m.isPoster(tc.msg), "non speaker")
+		})
+	}
+}
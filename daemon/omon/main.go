@@ -65,6 +65,10 @@ type (
 		// srcEvent is the source event that triggered the object status update
 		srcEvent any
 
+		// scalerSlicesWatched is the set of scaler slice paths we have
+		// subscribed object status updates for.
+		scalerSlicesWatched map[naming.Path]bool
+
 		ctx context.Context
 		log *plog.Logger
 
@@ -97,6 +101,7 @@ func Start(ctx context.Context, subQS pubsub.QueueSizer, p naming.Path, cfg inst
 			Pool:            cfg.Pool,
 			PlacementPolicy: cfg.PlacementPolicy,
 			Priority:        cfg.Priority,
+			Scale:           cfg.Scale,
 			Size:            cfg.Size,
 			Topology:        cfg.Topology,
 		},
@@ -110,6 +115,8 @@ func Start(ctx context.Context, subQS pubsub.QueueSizer, p naming.Path, cfg inst
 
 		instConfig: make(map[string]instance.Config),
 
+		scalerSlicesWatched: make(map[naming.Path]bool),
+
 		ctx: ctx,
 
 		pubLabel: []pubsub.Label{{"path", p.String()}, {"node", localhost}},
@@ -175,6 +182,7 @@ func (t *Manager) worker() {
 	if !t.instStatus[t.localhost].UpdatedAt.IsZero() {
 		t.srcEvent = &msgbus.InstanceStatusUpdated{Path: t.path, Node: t.localhost, Value: t.instStatus[t.localhost]}
 	}
+	t.watchScalerSlices()
 
 	t.updateStatus()
 
@@ -193,6 +201,7 @@ func (t *Manager) worker() {
 			t.imonCancel()
 			t.imonCancel = nil
 		}
+		t.unwatchScalerSlices(0)
 		t.delete()
 	}()
 	for {
@@ -264,9 +273,11 @@ func (t *Manager) worker() {
 				t.status.Pool = c.Value.Pool
 				t.status.PlacementPolicy = c.Value.PlacementPolicy
 				t.status.Priority = c.Value.Priority
+				t.status.Scale = c.Value.Scale
 				t.status.Size = c.Value.Size
 				t.status.Topology = c.Value.Topology
 				t.srcEvent = c
+				t.watchScalerSlices()
 
 				t.instConfig[c.Node] = c.Value
 
@@ -284,6 +295,16 @@ func (t *Manager) worker() {
 				}
 				t.updateStatus()
 
+			case *msgbus.ObjectStatusUpdated:
+				if c.Path.IsScalerSliceOf(t.path) {
+					t.onScalerSliceStatusUpdated(c)
+				}
+
+			case *msgbus.ObjectStatusDeleted:
+				if c.Path.IsScalerSliceOf(t.path) {
+					t.onScalerSliceStatusDeleted(c)
+				}
+
 			case *msgbus.InstanceConfigDeleted:
 				if c.Node == t.localhost && t.imonCancel != nil {
 					t.log.Infof("local instance config deleted: cancel associated imon")
@@ -403,13 +424,121 @@ func (t *Manager) updateStatus() {
 		}
 	}
 
+	updatePlacementViolations := func() {
+		t.status.PlacementViolations = nil
+		for node, instMonitor := range t.instMonitor {
+			if len(instMonitor.PlacementViolations) == 0 {
+				continue
+			}
+			if t.status.PlacementViolations == nil {
+				t.status.PlacementViolations = make(map[string][]string)
+			}
+			t.status.PlacementViolations[node] = append([]string{}, instMonitor.PlacementViolations...)
+		}
+	}
+
+	updateScaler := func() {
+		if t.status.Scale == nil {
+			t.status.ScalerSlices = nil
+			return
+		}
+		// the scaler object instances are never started, the object avail
+		// and overall status are the aggregation of the slices avail status.
+		var up int
+		for i := 0; i < *t.status.Scale; i++ {
+			if t.status.ScalerSlices[t.path.ScalerSlice(i).String()] == status.Up {
+				up++
+			}
+		}
+		switch {
+		case *t.status.Scale == 0:
+			t.status.Avail = status.NotApplicable
+		case up == 0:
+			t.status.Avail = status.Down
+		case up < *t.status.Scale:
+			t.status.Avail = status.Warn
+		default:
+			t.status.Avail = status.Up
+		}
+		t.status.Overall = t.status.Avail
+	}
+
 	updateAvailOverall()
+	updateScaler()
 	updateProvisioned()
 	updateFrozen()
 	updatePlacementState()
+	updatePlacementViolations()
 	t.update()
 }
 
+// watchScalerSlices subscribes to the object status updates of the slices
+// of a scaler object, and initializes their cached avail status. The slices
+// beyond the scale are unwatched.
+func (t *Manager) watchScalerSlices() {
+	var scale int
+	if t.status.Scale != nil {
+		scale = *t.status.Scale
+	}
+	t.unwatchScalerSlices(scale)
+	for i := 0; i < scale; i++ {
+		slicePath := t.path.ScalerSlice(i)
+		if t.scalerSlicesWatched[slicePath] {
+			continue
+		}
+		t.log.Debugf("watch scaler slice %s status", slicePath)
+		labelPath := pubsub.Label{"path", slicePath.String()}
+		t.sub.AddFilter(&msgbus.ObjectStatusUpdated{}, labelPath)
+		t.sub.AddFilter(&msgbus.ObjectStatusDeleted{}, labelPath)
+		t.scalerSlicesWatched[slicePath] = true
+		if st := object.StatusData.Get(slicePath); st != nil {
+			t.setScalerSliceAvail(slicePath, st.Avail)
+		}
+	}
+}
+
+// unwatchScalerSlices unsubscribes from the object status updates of the
+// watched slices with an index greater or equal to <from>, and drops their
+// cached avail status.
+func (t *Manager) unwatchScalerSlices(from int) {
+	for slicePath := range t.scalerSlicesWatched {
+		if slicePath.ScalerSliceIndex() < from {
+			continue
+		}
+		t.log.Debugf("unwatch scaler slice %s status", slicePath)
+		labelPath := pubsub.Label{"path", slicePath.String()}
+		t.sub.DelFilter(&msgbus.ObjectStatusUpdated{}, labelPath)
+		t.sub.DelFilter(&msgbus.ObjectStatusDeleted{}, labelPath)
+		delete(t.scalerSlicesWatched, slicePath)
+		delete(t.status.ScalerSlices, slicePath.String())
+	}
+}
+
+func (t *Manager) onScalerSliceStatusUpdated(c *msgbus.ObjectStatusUpdated) {
+	if t.status.ScalerSlices[c.Path.String()] == c.Value.Avail {
+		return
+	}
+	t.srcEvent = c
+	t.setScalerSliceAvail(c.Path, c.Value.Avail)
+	t.updateStatus()
+}
+
+func (t *Manager) onScalerSliceStatusDeleted(c *msgbus.ObjectStatusDeleted) {
+	if _, ok := t.status.ScalerSlices[c.Path.String()]; !ok {
+		return
+	}
+	t.srcEvent = c
+	delete(t.status.ScalerSlices, c.Path.String())
+	t.updateStatus()
+}
+
+func (t *Manager) setScalerSliceAvail(p naming.Path, avail status.T) {
+	if t.status.ScalerSlices == nil {
+		t.status.ScalerSlices = make(map[string]status.T)
+	}
+	t.status.ScalerSlices[p.String()] = avail
+}
+
 func (t *Manager) delete() {
 	object.StatusData.Unset(t.path)
 	t.bus.Pub(&msgbus.ObjectStatusDeleted{Path: t.path, Node: t.localhost},
@@ -0,0 +1,266 @@
+package rbac
+
+import (
+	"github.com/danwakefield/fnmatch"
+
+	"github.com/opensvc/om3/core/naming"
+)
+
+type (
+	// Operation is the name of an api operation checked by the handlers,
+	// like "instance.start".
+	Operation string
+
+	// CustomRole is a role defined in the cluster configuration, as a set
+	// of allowed operations on a set of objects.
+	CustomRole struct {
+		// Allow is the allowed operation patterns.
+		Allow []string
+
+		// Namespaces is the namespace patterns of the objects the role
+		// applies to. The role applies to all namespaces if empty.
+		Namespaces []string
+
+		// Selector is the path patterns of the objects the role applies
+		// to. The role applies to all objects if empty.
+		Selector []string
+	}
+
+	// CustomRoles is the custom roles indexed by name.
+	CustomRoles map[string]CustomRole
+
+	// Permission is a set of operations allowed by a grant.
+	Permission struct {
+		// Grant is the grant giving the permission.
+		Grant Grant
+
+		// Operations is the allowed operation patterns.
+		Operations []string
+
+		// Namespaces is the namespace patterns of the objects the
+		// operations are allowed on. All namespaces if empty.
+		Namespaces []string
+
+		// Selector is the path patterns of the objects the operations are
+		// allowed on. All objects if empty.
+		Selector []string
+	}
+)
+
+const (
+	OpInstanceBoot         Operation = "instance.boot"
+	OpInstanceDelete       Operation = "instance.delete"
+	OpInstanceFreeze       Operation = "instance.freeze"
+	OpInstancePRStart      Operation = "instance.prstart"
+	OpInstancePRStop       Operation = "instance.prstop"
+	OpInstanceProvision    Operation = "instance.provision"
+	OpInstancePushResInfo  Operation = "instance.push_resinfo"
+	OpInstanceRestart      Operation = "instance.restart"
+	OpInstanceRun          Operation = "instance.run"
+	OpInstanceShutdown     Operation = "instance.shutdown"
+	OpInstanceStart        Operation = "instance.start"
+	OpInstanceStartStandby Operation = "instance.startstandby"
+	OpInstanceStateFile    Operation = "instance.state_file"
+	OpInstanceStatus       Operation = "instance.status"
+	OpInstanceStop         Operation = "instance.stop"
+	OpInstanceUnfreeze     Operation = "instance.unfreeze"
+	OpInstanceUnprovision  Operation = "instance.unprovision"
+	OpObjectAbort          Operation = "object.abort"
+	OpObjectConfigGet      Operation = "object.config.get"
+	OpObjectConfigUpdate   Operation = "object.config.update"
+	OpObjectDelete         Operation = "object.delete"
+	OpObjectDisable        Operation = "object.disable"
+	OpObjectEnable         Operation = "object.enable"
+	OpObjectFreeze         Operation = "object.freeze"
+	OpObjectGiveback       Operation = "object.giveback"
+	OpObjectKVStoreGet     Operation = "object.kvstore.get"
+	OpObjectKVStoreUpdate  Operation = "object.kvstore.update"
+	OpObjectProvision      Operation = "object.provision"
+	OpObjectPurge          Operation = "object.purge"
+	OpObjectRestart        Operation = "object.restart"
+	OpObjectStart          Operation = "object.start"
+	OpObjectStop           Operation = "object.stop"
+	OpObjectSwitch         Operation = "object.switch"
+	OpObjectUnfreeze       Operation = "object.unfreeze"
+	OpObjectUnprovision    Operation = "object.unprovision"
+
+	OpDaemonJoin           Operation = "daemon.join"
+	OpDaemonLeave          Operation = "daemon.leave"
+	OpNodeConfigGet        Operation = "node.config.get"
+	OpNodeConfigUpdate     Operation = "node.config.update"
+	OpNodeDRBDConfigUpdate Operation = "node.drbd.config.update"
+	OpNodeFreeze           Operation = "node.freeze"
+	OpNodeLogs             Operation = "node.logs"
+	OpNodePushAsset        Operation = "node.push_asset"
+	OpNodePushDisk         Operation = "node.push_disk"
+	OpNodePushPatch        Operation = "node.push_patch"
+	OpNodePushPkg          Operation = "node.push_pkg"
+	OpNodeScanCapabilities Operation = "node.scan_capabilities"
+	OpNodeSysreport        Operation = "node.sysreport"
+	OpNodeUnfreeze         Operation = "node.unfreeze"
+)
+
+var (
+	operatorOperations = []Operation{
+		OpInstanceFreeze,
+		OpInstancePRStart,
+		OpInstancePRStop,
+		OpInstancePushResInfo,
+		OpInstanceRestart,
+		OpInstanceRun,
+		OpInstanceShutdown,
+		OpInstanceStart,
+		OpInstanceStartStandby,
+		OpInstanceStatus,
+		OpInstanceStop,
+		OpInstanceUnfreeze,
+		OpObjectAbort,
+		OpObjectFreeze,
+		OpObjectGiveback,
+		OpObjectRestart,
+		OpObjectStart,
+		OpObjectStop,
+		OpObjectSwitch,
+		OpObjectUnfreeze,
+	}
+
+	adminOperations = append([]Operation{
+		OpInstanceBoot,
+		OpInstanceDelete,
+		OpInstanceProvision,
+		OpInstanceUnprovision,
+		OpObjectConfigGet,
+		OpObjectConfigUpdate,
+		OpObjectDelete,
+		OpObjectDisable,
+		OpObjectEnable,
+		OpObjectKVStoreGet,
+		OpObjectKVStoreUpdate,
+		OpObjectProvision,
+		OpObjectPurge,
+		OpObjectUnprovision,
+	}, operatorOperations...)
+
+	// roleOperations is the operations allowed by the builtin roles on the
+	// objects of their grant namespace. The root role allows all
+	// operations.
+	roleOperations = map[Role][]Operation{
+		RoleAdmin:    adminOperations,
+		RoleOperator: operatorOperations,
+		RoleGuest:    {OpObjectConfigGet},
+	}
+
+	// nodeRoleOperations is the node operations allowed by the builtin
+	// roles granted without namespace.
+	nodeRoleOperations = map[Role][]Operation{
+		RoleJoin:  {OpDaemonJoin},
+		RoleLeave: {OpDaemonLeave},
+	}
+)
+
+// IsBuiltinRole returns true if <s> is the name of a builtin role.
+func IsBuiltinRole(s string) bool {
+	_, ok := roleMap[s]
+	return ok
+}
+
+// Permissions returns the permissions of the <grants>, using the <roles>
+// definitions for the grants of custom roles.
+//
+// A custom role grant scoped to a namespace, like "<role>:<namespace>",
+// applies only to the objects of this namespace, if allowed by the role
+// namespaces.
+func (t Grants) Permissions(roles CustomRoles) []Permission {
+	l := make([]Permission, 0)
+	for _, grant := range t {
+		name, scope := grant.Split()
+		if Role(name) == RoleRoot {
+			l = append(l, Permission{Grant: grant, Operations: []string{"*"}})
+			continue
+		}
+		if ops, ok := nodeRoleOperations[Role(name)]; ok {
+			if scope != "" {
+				continue
+			}
+			perm := Permission{Grant: grant}
+			for _, op := range ops {
+				perm.Operations = append(perm.Operations, string(op))
+			}
+			l = append(l, perm)
+			continue
+		}
+		if ops, ok := roleOperations[Role(name)]; ok {
+			if scope == "" {
+				continue
+			}
+			perm := Permission{Grant: grant, Namespaces: []string{scope}}
+			for _, op := range ops {
+				perm.Operations = append(perm.Operations, string(op))
+			}
+			l = append(l, perm)
+			continue
+		}
+		role, ok := roles[name]
+		if !ok || IsBuiltinRole(name) {
+			continue
+		}
+		perm := Permission{
+			Grant:      grant,
+			Operations: role.Allow,
+			Namespaces: role.Namespaces,
+			Selector:   role.Selector,
+		}
+		if scope != "" {
+			if len(role.Namespaces) > 0 && !matchAny(role.Namespaces, scope) {
+				continue
+			}
+			perm.Namespaces = []string{scope}
+		}
+		l = append(l, perm)
+	}
+	return l
+}
+
+// Allows returns true if one of the <grants> permissions allows the
+// operation <op> on the object <p>. The node operations use a zero <p>.
+func (t Grants) Allows(roles CustomRoles, op Operation, p naming.Path) bool {
+	for _, perm := range t.Permissions(roles) {
+		if perm.Allows(op, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows returns true if the permission allows the operation <op> on the
+// object <p>. A node operation, with a zero <p>, is only allowed by a
+// permission not restricted to namespaces or objects.
+func (t Permission) Allows(op Operation, p naming.Path) bool {
+	if !matchAny(t.Operations, string(op)) {
+		return false
+	}
+	if p.IsZero() {
+		return len(t.Namespaces) == 0 && len(t.Selector) == 0
+	}
+	if len(t.Namespaces) > 0 && !matchAny(t.Namespaces, p.Namespace) {
+		return false
+	}
+	if len(t.Selector) > 0 {
+		for _, pattern := range t.Selector {
+			if p.Match(pattern) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func matchAny(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if fnmatch.Match(pattern, s, 0) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/core/naming"
+)
+
+func TestGrantsAllows(t *testing.T) {
+	roles := CustomRoles{
+		"deployer": {
+			Allow:      []string{"instance.start", "instance.stop", "instance.*freeze"},
+			Namespaces: []string{"prod*"},
+			Selector:   []string{"*/svc/web*"},
+		},
+		"nodeops": {
+			Allow: []string{"node.*"},
+		},
+		"objops": {
+			Allow: []string{"object.start", "object.stop", "object.*freeze"},
+		},
+	}
+	web := naming.Path{Namespace: "prod1", Kind: naming.KindSvc, Name: "web1"}
+	db := naming.Path{Namespace: "prod1", Kind: naming.KindSvc, Name: "db1"}
+	testWeb := naming.Path{Namespace: "test", Kind: naming.KindSvc, Name: "web1"}
+	cases := map[string]struct {
+		grants   []string
+		op       Operation
+		path     naming.Path
+		expected bool
+	}{
+		"root allows all":                     {[]string{"root"}, OpNodeFreeze, naming.Path{}, true},
+		"operator allows start":               {[]string{"operator:prod1"}, OpInstanceStart, web, true},
+		"operator denies other namespace":     {[]string{"operator:test"}, OpInstanceStart, web, false},
+		"operator denies config update":       {[]string{"operator:prod1"}, OpObjectConfigUpdate, web, false},
+		"operator allows object start":        {[]string{"operator:prod1"}, OpObjectStart, web, true},
+		"operator denies object purge":        {[]string{"operator:prod1"}, OpObjectPurge, web, false},
+		"guest denies object stop":            {[]string{"guest:prod1"}, OpObjectStop, web, false},
+		"guest allows config get":             {[]string{"guest:prod1"}, OpObjectConfigGet, web, true},
+		"admin allows config update":          {[]string{"admin:prod1"}, OpObjectConfigUpdate, web, true},
+		"unscoped admin denies":               {[]string{"admin"}, OpInstanceStart, web, false},
+		"custom allows start":                 {[]string{"deployer"}, OpInstanceStart, web, true},
+		"custom allows pattern":               {[]string{"deployer"}, OpInstanceUnfreeze, web, true},
+		"custom denies not allowed":           {[]string{"deployer"}, OpObjectConfigUpdate, web, false},
+		"custom denies selector":              {[]string{"deployer"}, OpInstanceStart, db, false},
+		"custom denies role namespace":        {[]string{"deployer"}, OpInstanceStart, testWeb, false},
+		"custom scoped allows":                {[]string{"deployer:prod1"}, OpInstanceStart, web, true},
+		"custom scoped denies role namespace": {[]string{"deployer:test"}, OpInstanceStart, testWeb, false},
+		"custom restricted denies node op":    {[]string{"deployer"}, OpInstanceStart, naming.Path{}, false},
+		"custom allows node op":               {[]string{"nodeops"}, OpNodeFreeze, naming.Path{}, true},
+		"custom scoped denies node op":        {[]string{"nodeops:ns1"}, OpNodeFreeze, naming.Path{}, false},
+		"custom object actions allow freeze":  {[]string{"objops"}, OpObjectFreeze, web, true},
+		"custom object actions deny config":   {[]string{"objops"}, OpObjectConfigUpdate, web, false},
+		"join allows daemon join":             {[]string{"join"}, OpDaemonJoin, naming.Path{}, true},
+		"join denies daemon leave":            {[]string{"join"}, OpDaemonLeave, naming.Path{}, false},
+		"undefined custom role denies":        {[]string{"undefined"}, OpInstanceStart, web, false},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, c.expected, NewGrants(c.grants...).Allows(roles, c.op, c.path))
+		})
+	}
+}
+
+func TestGrantsPermissionsIgnoresBuiltinRedefinition(t *testing.T) {
+	roles := CustomRoles{"guest": {Allow: []string{"*"}}}
+	l := NewGrants("guest").Permissions(roles)
+	require.Len(t, l, 0)
+}
@@ -1,19 +1,48 @@
+/*
+Package relay implements the relay heartbeat store.
+
+The relay slots store the last message posted by each node of the clusters
+using this daemon as a hb.relay heartbeat. The slots are persisted in a
+directory, so a daemon restart does not make all relay heartbeats stale, and
+optionally replicated to peer relays, so the nodes can fail over to another
+relay.
+*/
 package relay
 
 import (
 	"strings"
 	"sync"
 	"time"
+
+	"github.com/opensvc/om3/daemon/api"
 )
 
 type (
 	Slot struct {
-		Value    any
+		Value    api.RelayMessage
 		timer    *time.Timer
 		Username string
+
+		// ReceivedAt is the time the slot was stored on this relay.
+		ReceivedAt time.Time
+
+		// ReplicatedFrom is the peer relay the slot was replicated from.
+		// It is empty for a slot posted by a node.
+		ReplicatedFrom string
 	}
 	M struct {
 		*sync.Map
+
+		// dir is the slot files directory. The slots are not persisted if
+		// empty.
+		dir string
+
+		// replicaC receives the keys of the slots posted by nodes, for the
+		// replicator to send to the peer relays.
+		replicaC chan string
+
+		// mu serializes the slot updates and their persistence.
+		mu sync.Mutex
 	}
 )
 
@@ -46,6 +75,10 @@ func (m *M) List(username string) []Slot {
 
 func (m *M) Load(username, clusterID, nodename string) (Slot, bool) {
 	key := makeRelayKey(username, clusterID, nodename)
+	return m.load(key)
+}
+
+func (m *M) load(key string) (Slot, bool) {
 	value, ok := m.Map.Load(key)
 	if !ok {
 		return Slot{}, false
@@ -53,18 +86,60 @@ func (m *M) Load(username, clusterID, nodename string) (Slot, bool) {
 	return value.(Slot), true
 }
 
-func (m *M) Store(username, clusterID, nodename string, value any) {
+// Store stores the message posted by a node, and queues it for replication
+// to the peer relays. The returned error is a persistence error: the slot is
+// stored in memory anyway.
+func (m *M) Store(username, clusterID, nodename string, value api.RelayMessage) error {
 	key := makeRelayKey(username, clusterID, nodename)
-	m.stopTimer(key)
-	slot := Slot{
-		Value: value,
-		timer: time.AfterFunc(MaxAge, func() {
-			//fmt.Printf("drop key %s, aged %s\n", key, MaxAge)
-			m.Map.Delete(key)
-		}),
-		Username: username,
+	m.mu.Lock()
+	err := m.store(key, Slot{Value: value, Username: username, ReceivedAt: time.Now()})
+	replicaC := m.replicaC
+	m.mu.Unlock()
+	if replicaC != nil {
+		select {
+		case replicaC <- key:
+		default:
+			// the replicator is late. The node will post again soon.
+		}
 	}
+	return err
+}
+
+// StoreReplica stores a message replicated from the <from> peer relay, if
+// it is more recent than the stored message. It returns true if the message
+// is stored.
+//
+// The replicated messages are not replicated again, so the peer relays must
+// all replicate to each other.
+func (m *M) StoreReplica(value api.RelayMessage, from string) (bool, error) {
+	key := makeRelayKey(value.Username, value.ClusterID, value.Nodename)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if slot, ok := m.load(key); ok && !isMoreRecent(value, slot.Value) {
+		return false, nil
+	}
+	err := m.store(key, Slot{Value: value, Username: value.Username, ReceivedAt: time.Now(), ReplicatedFrom: from})
+	return true, err
+}
+
+func (m *M) store(key string, slot Slot) error {
+	m.stopTimer(key)
+	slot.timer = m.newTimer(key, MaxAge)
 	m.Map.Store(key, slot)
+	return m.persist(key, slot)
+}
+
+func (m *M) newTimer(key string, d time.Duration) *time.Timer {
+	return time.AfterFunc(d, func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if slot, ok := m.load(key); ok && time.Since(slot.ReceivedAt) < MaxAge {
+			// the slot was updated while this timer was firing
+			return
+		}
+		m.Map.Delete(key)
+		m.unpersist(key)
+	})
 }
 
 func (m *M) stopTimer(key string) {
@@ -76,6 +151,20 @@ func (m *M) stopTimer(key string) {
 	slot.timer.Stop()
 }
 
+// isMoreRecent returns true if the <value> message was posted after the
+// <stored> message.
+//
+// The messages are ordered by the sequence number stamped by the posting
+// node, because the UpdatedAt times are stamped by different relays, whose
+// clocks may be skewed. The messages posted by nodes not stamping their
+// messages fall back to the UpdatedAt order.
+func isMoreRecent(value, stored api.RelayMessage) bool {
+	if value.Seq == 0 || stored.Seq == 0 {
+		return value.UpdatedAt.After(stored.UpdatedAt)
+	}
+	return value.Seq > stored.Seq
+}
+
 func makeRelayKey(username, clusterID, nodename string) string {
 	return strings.Join([]string{username, clusterID, nodename}, "/")
 }
@@ -0,0 +1,128 @@
+package relay
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/opensvc/om3/daemon/api"
+)
+
+func newMessage(nodename string, updatedAt time.Time) api.RelayMessage {
+	return api.RelayMessage{
+		ClusterID:   "c1-id",
+		ClusterName: "c1",
+		Msg:         "msg from " + nodename,
+		Nodename:    nodename,
+		UpdatedAt:   updatedAt,
+		Username:    "relay",
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	m := M{Map: &sync.Map{}}
+	require.NoError(t, m.LoadDir(dir))
+	require.NoError(t, m.Store("relay", "c1-id", "n1", newMessage("n1", now)))
+	stored, err := m.StoreReplica(newMessage("n2", now), "relay2")
+	require.NoError(t, err)
+	require.True(t, stored)
+	m.Stop()
+
+	t.Logf("load the persisted slots in a new store")
+	m = M{Map: &sync.Map{}}
+	require.NoError(t, m.LoadDir(dir))
+	defer m.Stop()
+	require.Len(t, m.List(""), 2)
+
+	slot, ok := m.Load("relay", "c1-id", "n1")
+	require.True(t, ok)
+	require.Equal(t, "msg from n1", slot.Value.Msg)
+	require.True(t, now.Equal(slot.Value.UpdatedAt))
+	require.Empty(t, slot.ReplicatedFrom)
+
+	slot, ok = m.Load("relay", "c1-id", "n2")
+	require.True(t, ok)
+	require.Equal(t, "relay2", slot.ReplicatedFrom)
+}
+
+func TestLoadDirDropsExpiredSlots(t *testing.T) {
+	dir := t.TempDir()
+	m := M{Map: &sync.Map{}}
+	require.NoError(t, m.LoadDir(dir))
+	require.NoError(t, m.persist("relay/c1-id/n1", Slot{
+		Value:      newMessage("n1", time.Now().Add(-2*MaxAge)),
+		ReceivedAt: time.Now().Add(-2 * MaxAge),
+	}))
+
+	require.NoError(t, m.LoadDir(dir))
+	defer m.Stop()
+	require.Empty(t, m.List(""))
+	_, ok := m.Load("relay", "c1-id", "n1")
+	require.False(t, ok)
+}
+
+func TestStoreReplica(t *testing.T) {
+	m := M{Map: &sync.Map{}}
+	defer m.Stop()
+	now := time.Now()
+
+	require.NoError(t, m.Store("relay", "c1-id", "n1", newMessage("n1", now)))
+
+	stored, err := m.StoreReplica(newMessage("n1", now.Add(-time.Second)), "relay2")
+	require.NoError(t, err)
+	require.False(t, stored, "an older replica must not replace the stored message")
+
+	stored, err = m.StoreReplica(newMessage("n1", now.Add(time.Second)), "relay2")
+	require.NoError(t, err)
+	require.True(t, stored, "a more recent replica must replace the stored message")
+
+	slot, ok := m.Load("relay", "c1-id", "n1")
+	require.True(t, ok)
+	require.Equal(t, "relay2", slot.ReplicatedFrom)
+}
+
+func TestStoreReplicaOrdersBySeq(t *testing.T) {
+	m := M{Map: &sync.Map{}}
+	defer m.Stop()
+	now := time.Now()
+
+	t.Logf("store a message received by a relay with a clock running ahead")
+	ahead := newMessage("n1", now.Add(time.Hour))
+	ahead.Seq = 10
+	stored, err := m.StoreReplica(ahead, "relay2")
+	require.NoError(t, err)
+	require.True(t, stored)
+
+	fresh := newMessage("n1", now)
+	fresh.Seq = 11
+	stored, err = m.StoreReplica(fresh, "relay3")
+	require.NoError(t, err)
+	require.True(t, stored, "a replica with a higher seq must replace the stored message, whatever its relay clock")
+
+	stale := newMessage("n1", now.Add(2*time.Hour))
+	stale.Seq = 9
+	stored, err = m.StoreReplica(stale, "relay2")
+	require.NoError(t, err)
+	require.False(t, stored, "a replica with a lower seq must not replace the stored message")
+
+	slot, ok := m.Load("relay", "c1-id", "n1")
+	require.True(t, ok)
+	require.Equal(t, int64(11), slot.Value.Seq)
+}
+
+func TestStoreQueuesReplication(t *testing.T) {
+	m := M{Map: &sync.Map{}, replicaC: make(chan string, 1)}
+	defer m.Stop()
+
+	require.NoError(t, m.Store("relay", "c1-id", "n1", newMessage("n1", time.Now())))
+	require.Equal(t, "relay/c1-id/n1", <-m.replicaC)
+
+	_, err := m.StoreReplica(newMessage("n2", time.Now()), "relay2")
+	require.NoError(t, err)
+	require.Len(t, m.replicaC, 0, "replicas must not be replicated again")
+}
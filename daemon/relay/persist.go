@@ -0,0 +1,111 @@
+package relay
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/opensvc/om3/daemon/api"
+)
+
+type (
+	// slotFile is the persisted slot data.
+	slotFile struct {
+		Value          api.RelayMessage `json:"value"`
+		ReceivedAt     time.Time        `json:"received_at"`
+		ReplicatedFrom string           `json:"replicated_from,omitempty"`
+	}
+)
+
+const (
+	slotFileSuffix = ".json"
+)
+
+// LoadDir enables the slots persistence in <dir>, and loads the slots
+// persisted in <dir> not older than MaxAge.
+func (m *M) LoadDir(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dir = dir
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	var errs error
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, slotFileSuffix) {
+			continue
+		}
+		key, err := url.PathUnescape(strings.TrimSuffix(name, slotFileSuffix))
+		if err != nil {
+			continue
+		}
+		p := filepath.Join(dir, name)
+		b, err := os.ReadFile(p)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		var data slotFile
+		if err := json.Unmarshal(b, &data); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("%s: %w", p, err))
+			continue
+		}
+		age := time.Since(data.ReceivedAt)
+		if age >= MaxAge {
+			_ = os.Remove(p)
+			continue
+		}
+		m.stopTimer(key)
+		m.Map.Store(key, Slot{
+			Value:          data.Value,
+			Username:       data.Value.Username,
+			ReceivedAt:     data.ReceivedAt,
+			ReplicatedFrom: data.ReplicatedFrom,
+			timer:          m.newTimer(key, MaxAge-age),
+		})
+	}
+	return errs
+}
+
+func (m *M) slotFile(key string) string {
+	return filepath.Join(m.dir, url.PathEscape(key)+slotFileSuffix)
+}
+
+// persist writes the <slot> file. The file is written to a temporary file
+// then renamed, so a daemon crash can't leave a partially written file.
+func (m *M) persist(key string, slot Slot) error {
+	if m.dir == "" {
+		return nil
+	}
+	b, err := json.Marshal(slotFile{
+		Value:          slot.Value,
+		ReceivedAt:     slot.ReceivedAt,
+		ReplicatedFrom: slot.ReplicatedFrom,
+	})
+	if err != nil {
+		return err
+	}
+	p := m.slotFile(key)
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, p)
+}
+
+func (m *M) unpersist(key string) {
+	if m.dir == "" {
+		return
+	}
+	_ = os.Remove(m.slotFile(key))
+}
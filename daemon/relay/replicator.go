@@ -0,0 +1,270 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/opensvc/om3/core/client"
+	"github.com/opensvc/om3/core/naming"
+	"github.com/opensvc/om3/core/object"
+	"github.com/opensvc/om3/core/rawconfig"
+	"github.com/opensvc/om3/daemon/msgbus"
+	"github.com/opensvc/om3/util/hostname"
+	"github.com/opensvc/om3/util/key"
+	"github.com/opensvc/om3/util/plog"
+	"github.com/opensvc/om3/util/pubsub"
+)
+
+type (
+	// Replicator loads the persisted relay slots on start, and replicates
+	// the slots posted by the nodes to the peer relays set in the
+	// relay.peers node keyword.
+	Replicator struct {
+		ctx    context.Context
+		cancel context.CancelFunc
+		bus    *pubsub.Bus
+		log    *plog.Logger
+		wg     sync.WaitGroup
+
+		sub   *pubsub.Subscription
+		subQS pubsub.QueueSizer
+
+		localhost string
+
+		// config is the replication config currently applied.
+		config replicatorConfig
+
+		// peers is the replication queues, indexed by peer relay url.
+		peers map[string]chan string
+
+		// peerCancel stops the peer replication goroutines.
+		peerCancel context.CancelFunc
+		peerWG     sync.WaitGroup
+	}
+
+	replicatorConfig struct {
+		peers    []string
+		username string
+		password string
+		insecure bool
+	}
+)
+
+var (
+	// replicaQueueSize is the size of the replication queues. When a queue
+	// is full, the replication of the slot is skipped: the node will post
+	// again soon.
+	replicaQueueSize = 1000
+
+	// replicaTimeout is the timeout of a replica post to a peer relay.
+	replicaTimeout = 5 * time.Second
+)
+
+// Dir returns the relay slots persistence directory.
+func Dir() string {
+	return filepath.Join(rawconfig.Paths.Var, "relay")
+}
+
+func NewReplicator(subQS pubsub.QueueSizer) *Replicator {
+	return &Replicator{
+		localhost: hostname.Hostname(),
+		log: plog.NewDefaultLogger().
+			Attr("pkg", "daemon/relay").
+			WithPrefix("daemon: relay: "),
+		subQS: subQS,
+		peers: make(map[string]chan string),
+	}
+}
+
+// Start loads the persisted relay slots and launches the replication worker
+// goroutine.
+func (t *Replicator) Start(parent context.Context) error {
+	t.ctx, t.cancel = context.WithCancel(parent)
+	t.bus = pubsub.BusFromContext(t.ctx)
+
+	if err := Map.LoadDir(Dir()); err != nil {
+		t.log.Warnf("load persisted slots: %s", err)
+	}
+	if n := len(Map.List("")); n > 0 {
+		t.log.Infof("loaded %d persisted slots", n)
+	}
+	replicaC := make(chan string, replicaQueueSize)
+	Map.mu.Lock()
+	Map.replicaC = replicaC
+	Map.mu.Unlock()
+
+	t.sub = t.bus.Sub("daemon.relay", t.subQS)
+	t.sub.AddFilter(&msgbus.ConfigFileUpdated{}, pubsub.Label{"path", "cluster"})
+	t.sub.AddFilter(&msgbus.ConfigFileUpdated{}, pubsub.Label{"path", ""})
+	t.sub.Start()
+
+	t.wg.Add(1)
+	go func() {
+		defer t.wg.Done()
+		defer func() {
+			if err := t.sub.Stop(); err != nil && !errors.Is(err, context.Canceled) {
+				t.log.Warnf("subscription stop: %s", err)
+			}
+		}()
+		t.onConfigUpdated()
+		t.worker(replicaC)
+	}()
+	return nil
+}
+
+func (t *Replicator) Stop() error {
+	t.cancel()
+	t.wg.Wait()
+	return nil
+}
+
+func (t *Replicator) worker(replicaC <-chan string) {
+	defer t.stopPeers()
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case i := <-t.sub.C:
+			switch i.(type) {
+			case *msgbus.ConfigFileUpdated:
+				t.onConfigUpdated()
+			}
+		case key := <-replicaC:
+			for _, peerC := range t.peers {
+				select {
+				case peerC <- key:
+				default:
+				}
+			}
+		}
+	}
+}
+
+func (t *Replicator) onConfigUpdated() {
+	config, err := t.loadConfig()
+	if err != nil {
+		t.log.Errorf("load replication config: %s", err)
+		return
+	}
+	if slices.Equal(config.peers, t.config.peers) &&
+		config.username == t.config.username &&
+		config.password == t.config.password &&
+		config.insecure == t.config.insecure {
+		return
+	}
+	t.stopPeers()
+	t.config = config
+	if len(config.peers) == 0 {
+		t.log.Infof("replication disabled")
+		return
+	}
+	t.log.Infof("replicate to %s", config.peers)
+	var ctx context.Context
+	ctx, t.peerCancel = context.WithCancel(t.ctx)
+	for _, peer := range config.peers {
+		cli, err := client.New(
+			client.WithURL(peer),
+			client.WithUsername(config.username),
+			client.WithPassword(config.password),
+			client.WithInsecureSkipVerify(config.insecure),
+			client.WithTimeout(replicaTimeout),
+		)
+		if err != nil {
+			t.log.Errorf("replicate to %s: new client: %s", peer, err)
+			continue
+		}
+		peerC := make(chan string, replicaQueueSize)
+		t.peers[peer] = peerC
+		t.peerWG.Add(1)
+		go t.peerWorker(ctx, peer, cli, peerC)
+	}
+}
+
+func (t *Replicator) stopPeers() {
+	if t.peerCancel != nil {
+		t.peerCancel()
+		t.peerWG.Wait()
+		t.peerCancel = nil
+	}
+	t.peers = make(map[string]chan string)
+}
+
+// peerWorker posts the queued slots to the <peer> relay. The replication
+// errors are logged on the first error and on recovery only, to not flood
+// the logs when a peer relay is down.
+func (t *Replicator) peerWorker(ctx context.Context, peer string, cli *client.T, peerC <-chan string) {
+	defer t.peerWG.Done()
+	var lastErr error
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case key := <-peerC:
+			slot, ok := Map.load(key)
+			if !ok {
+				continue
+			}
+			err := t.replicate(ctx, cli, slot)
+			switch {
+			case err != nil && lastErr == nil:
+				t.log.Warnf("replicate to %s: %s", peer, err)
+			case err == nil && lastErr != nil:
+				t.log.Infof("replicate to %s: recovered", peer)
+			}
+			lastErr = err
+		}
+	}
+}
+
+func (t *Replicator) replicate(ctx context.Context, cli *client.T, slot Slot) error {
+	value := slot.Value
+	value.Relay = t.localhost
+	ctx, cancel := context.WithTimeout(ctx, replicaTimeout)
+	defer cancel()
+	resp, err := cli.PostRelayReplica(ctx, value)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+// loadConfig returns the replication config read from the node merged
+// config relay section.
+func (t *Replicator) loadConfig() (replicatorConfig, error) {
+	var config replicatorConfig
+	n, err := object.NewNode(object.WithVolatile(true))
+	if err != nil {
+		return config, err
+	}
+	c := n.MergedConfig()
+	config.peers = c.GetStrings(key.New("relay", "peers"))
+	if len(config.peers) == 0 {
+		return config, nil
+	}
+	config.username = c.GetString(key.New("relay", "username"))
+	config.insecure = c.GetBool(key.New("relay", "insecure"))
+	secPath, err := naming.ParsePath(c.GetString(key.New("relay", "password")))
+	if err != nil {
+		return config, err
+	}
+	sec, err := object.NewSec(secPath, object.WithVolatile(true))
+	if err != nil {
+		return config, err
+	}
+	b, err := sec.DecodeKey("password")
+	if err != nil {
+		return config, err
+	}
+	config.password = string(b)
+	return config, nil
+}
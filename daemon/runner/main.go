@@ -56,8 +56,8 @@ func (t *T) startSubscriptions() *pubsub.Subscription {
 	return sub
 }
 
-// TODO: expose queue len via prometheus
 func (t *T) run() {
+	defer t.updateMetrics()
 	for {
 		running := t.running.Load()
 		if running >= int32(t.maxRunning) {
@@ -74,7 +74,7 @@ func (t *T) run() {
 		//t.log.Debugf("priority run dequeue from p%d: %d running %d waiting", item.priority, running, t.queue.Len())
 		go func() {
 			item.errC <- item.f()
-			t.running.Add(-1)
+			runningGauge.Set(float64(t.running.Add(-1)))
 		}()
 	}
 }
@@ -125,6 +125,7 @@ func (t *T) do(ctx context.Context) {
 		case item := <-t.stage:
 			// serialize pushes
 			t.queue.Push(item)
+			t.updateMetrics()
 		case <-ticker.C:
 			t.run()
 		case <-ctx.Done():
@@ -200,6 +201,12 @@ func (t *T) SetInterval(d time.Duration) {
 	t.interval = d
 }
 
+// updateMetrics sets the queue length and running gauges.
+func (t *T) updateMetrics() {
+	queuedGauge.Set(float64(t.queue.Len()))
+	runningGauge.Set(float64(t.running.Load()))
+}
+
 func (t *T) publishUpdate() {
 	t.status.UpdatedAt = time.Now()
 	localhost := hostname.Hostname()
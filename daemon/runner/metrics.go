@@ -0,0 +1,20 @@
+package runner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	queuedGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "opensvc_runner_imon_queued",
+			Help: "The number of imon actions waiting for a runner slot",
+		})
+
+	runningGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "opensvc_runner_imon_running",
+			Help: "The number of imon actions running",
+		})
+)
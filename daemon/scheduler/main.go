@@ -172,10 +172,15 @@ func (t *T) createJob(e schedule.Entry) {
 			// prevent drift if the gap is small
 			begin = next
 		}
+		var err error
 		if e.RequireCollector && !collector.Alive.Load() {
 			logger.Debugf("the collector is not alive")
-		} else if err := t.action(e); err != nil {
-			logger.Errorf("%s: on exec %s: %s", obj, e.Key, err)
+		} else {
+			err = t.action(e)
+			observeJob(obj, e.Action, time.Since(begin), err)
+			if err != nil {
+				logger.Errorf("%s: on exec %s: %s", obj, e.Key, err)
+			}
 		}
 
 		// remember last run, to not run the job too soon after a daemon restart
@@ -0,0 +1,34 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	jobDurationHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "opensvc_scheduler_job_duration_seconds",
+			Help:    "The duration of the scheduled jobs executions",
+			Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 3600},
+		},
+		[]string{"action"})
+
+	jobFailuresTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "opensvc_scheduler_job_failures_total",
+			Help: "The total number of failed scheduled jobs executions",
+		},
+		[]string{"path", "action"})
+)
+
+// observeJob records the duration of a scheduled job execution, and counts
+// its failure. The <obj> is "node" for the node jobs.
+func observeJob(obj, action string, duration time.Duration, err error) {
+	jobDurationHistogram.WithLabelValues(action).Observe(duration.Seconds())
+	if err != nil {
+		jobFailuresTotal.WithLabelValues(obj, action).Inc()
+	}
+}
@@ -30,6 +30,10 @@ func (t T) IsSCSI() (bool, error) {
 	return false, ErrNotApplicable
 }
 
+func (t T) LogicalBlockSize() (int, error) {
+	return 0, ErrNotApplicable
+}
+
 func (t T) Model() (string, error) {
 	return "", ErrNotApplicable
 }
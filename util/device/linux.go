@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/rs/zerolog"
@@ -53,6 +54,20 @@ func (t T) sysfsFile() (string, error) {
 	return fmt.Sprintf("/sys/block/%s", canon), nil
 }
 
+// LogicalBlockSize returns the size in bytes of the device logical blocks,
+// the unit of the SCSI commands addressing.
+func (t T) LogicalBlockSize() (int, error) {
+	p, err := t.sysfsFile()
+	if err != nil {
+		return 0, err
+	}
+	b, err := os.ReadFile(p + "/queue/logical_block_size")
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(strings.TrimSpace(string(b)))
+}
+
 func (t T) sysfsFileRO() (string, error) {
 	p, err := t.sysfsFile()
 	if err != nil {
@@ -2,6 +2,8 @@
 
 package pg
 
+import "errors"
+
 // ApplyProc creates the cgroup, set caps, and add the specified process
 func (c Config) ApplyProc(pid int) error {
 	return nil
@@ -10,3 +12,8 @@ func (c Config) ApplyProc(pid int) error {
 func (c Config) Delete() (bool, error) {
 	return false, nil
 }
+
+// Usage returns the resource usage of the pg processes.
+func (c Config) Usage() (Usage, error) {
+	return Usage{}, errors.ErrUnsupported
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/containerd/cgroups"
 	cgroupsv2 "github.com/containerd/cgroups/v2"
@@ -120,6 +121,56 @@ func (c Config) Delete() (bool, error) {
 	return changed, nil
 }
 
+// Usage returns the resource usage of the pg processes.
+func (c Config) Usage() (Usage, error) {
+	if usage, ok := c.usageV2(); ok {
+		return usage, nil
+	}
+	return c.usageV1()
+}
+
+func (c Config) usageV2() (Usage, bool) {
+	var usage Usage
+	control, err := cgroupsv2.LoadManager(UnifiedPath(), c.ID)
+	if err != nil {
+		return usage, false
+	}
+	if _, err := control.Controllers(); err != nil {
+		// path does not exist
+		return usage, false
+	}
+	metrics, err := control.Stat()
+	if err != nil {
+		return usage, false
+	}
+	if metrics.CPU != nil {
+		usage.CPUTime = time.Duration(metrics.CPU.UsageUsec) * time.Microsecond
+	}
+	if metrics.Memory != nil {
+		usage.Memory = metrics.Memory.Usage
+	}
+	return usage, true
+}
+
+func (c Config) usageV1() (Usage, error) {
+	var usage Usage
+	control, err := cgroups.Load(cgroups.V1, cgroups.StaticPath(c.ID))
+	if err != nil {
+		return usage, fmt.Errorf("load pg %s: %w", c.ID, err)
+	}
+	metrics, err := control.Stat(cgroups.IgnoreNotExist)
+	if err != nil {
+		return usage, fmt.Errorf("stat pg %s: %w", c.ID, err)
+	}
+	if metrics.CPU != nil && metrics.CPU.Usage != nil {
+		usage.CPUTime = time.Duration(metrics.CPU.Usage.Total)
+	}
+	if metrics.Memory != nil && metrics.Memory.Usage != nil {
+		usage.Memory = metrics.Memory.Usage.Usage
+	}
+	return usage, nil
+}
+
 func (c Config) deleteV2() (bool, error) {
 	control, err := cgroupsv2.LoadManager(UnifiedPath(), c.ID)
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/opensvc/om3/util/xmap"
 )
@@ -39,6 +40,15 @@ type (
 		Changed bool
 	}
 	Mgr map[string]*entry
+
+	// Usage is the resource usage of the processes of a pg.
+	Usage struct {
+		// CPUTime is the cumulated cpu time consumed by the pg processes.
+		CPUTime time.Duration
+
+		// Memory is the memory usage of the pg processes, in bytes.
+		Memory uint64
+	}
 )
 
 const (
@@ -117,6 +117,9 @@ type (
 		queuedMax  uint64
 		queuedSize uint64
 		queued     atomic.Uint64
+
+		// dropped is the number of messages not delivered to the subscriber
+		dropped atomic.Uint64
 	}
 
 	cmdPub struct {
@@ -185,6 +188,10 @@ type (
 		// maximum queue size.
 		// Default value (zero) disable panic on full queue feature.
 		panicOnFullQueueGraceTime time.Duration
+
+		// dropped is the number of messages not delivered by the bus
+		// subscriptions, including the stopped ones.
+		dropped atomic.Uint64
 	}
 
 	stringer interface {
@@ -459,6 +466,8 @@ func (b *Bus) Start(ctx context.Context) {
 					b.onSubCmd(c)
 				case cmdUnsub:
 					b.onUnsubCmd(c)
+				case cmdStats:
+					b.onStatsCmd(c)
 				case cmdBufferPublications:
 					if c.enabled && !cmdPubBuffered {
 						cmdPubBuffered = true
@@ -953,6 +962,7 @@ func (sub *Subscription) drain() {
 		select {
 		case <-sub.q:
 			sub.queued.Add(-uint64Incr)
+			sub.onDrop()
 		case <-ticker.C:
 			return
 		}
@@ -1107,6 +1117,7 @@ func (sub *Subscription) Start() {
 				case sub.bus.beginNotify <- sub.id:
 				}
 				if err := sub.push(i); err != nil {
+					sub.onDrop()
 					// the subscription got push error, cancel it and ask for unsubscribe
 					sub.bus.log.Warnf("%s error: %s. stop subscription", sub, err)
 					go sub.bus.Pub(&SubscriptionError{Name: sub.name, ID: sub.id, ErrS: err.Error()})
@@ -229,3 +229,43 @@ func Test_labelMap_Key(t *testing.T) {
 		})
 	}
 }
+
+func TestStats(t *testing.T) {
+	bus := newRun(t.Name())
+	defer bus.Stop()
+
+	sub := bus.Sub("idle subscriber", WithQueueSize(10))
+	sub.AddFilter(&msgT{})
+	sub.Start()
+	defer func() { _ = sub.Stop() }()
+
+	slowSub := bus.Sub("slow subscriber", Timeout(10*time.Millisecond), WithQueueSize(2))
+	slowSub.AddFilter(&msgT{})
+	slowSub.Start()
+
+	for i := 0; i < 4; i++ {
+		bus.Pub(&msgT{v: i})
+	}
+
+	require.Eventually(t, func() bool {
+		stats := bus.Stats()
+		if stats.Dropped == 0 {
+			return false
+		}
+		for _, s := range stats.Subscriptions {
+			if s.Name == "slow subscriber" {
+				// the slow subscription is expected stopped on push timeout
+				return false
+			}
+		}
+		return true
+	}, time.Second, 10*time.Millisecond, "the slow subscription messages are expected dropped")
+
+	stats := bus.Stats()
+	require.Len(t, stats.Subscriptions, 1)
+	require.Equal(t, "idle subscriber", stats.Subscriptions[0].Name)
+	require.Equal(t, "idle", stats.Subscriptions[0].Family)
+	require.Equal(t, uint64(4), stats.Subscriptions[0].Queued)
+	require.Equal(t, uint64(10), stats.Subscriptions[0].QueueSize)
+	require.Equal(t, uint64(0), stats.Subscriptions[0].Dropped)
+}
@@ -0,0 +1,83 @@
+package pubsub
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// Stats describes the usage of the bus subscription queues.
+	Stats struct {
+		Subscriptions []SubscriptionStats
+
+		// Dropped is the number of messages not delivered by the bus
+		// subscriptions, including the stopped ones.
+		Dropped uint64
+	}
+
+	// SubscriptionStats describes the usage of a subscription queue.
+	SubscriptionStats struct {
+		ID     uuid.UUID
+		Name   string
+		Family string
+
+		// Queued is the number of messages waiting for the subscriber.
+		Queued uint64
+
+		// QueuedMax is the current high threshold of the queued messages,
+		// increased when the queue usage grows.
+		QueuedMax uint64
+
+		// QueueSize is the subscription queue capacity.
+		QueueSize uint64
+
+		// Dropped is the number of messages not delivered to the
+		// subscriber.
+		Dropped uint64
+	}
+
+	cmdStats struct {
+		resp chan<- Stats
+	}
+)
+
+// Stats returns the usage of the bus subscription queues, sorted by
+// subscription name.
+func (b *Bus) Stats() Stats {
+	respC := make(chan Stats, 1)
+	select {
+	case b.cmdC <- cmdStats{resp: respC}:
+	case <-b.ctx.Done():
+		return Stats{Dropped: b.dropped.Load()}
+	}
+	return <-respC
+}
+
+func (b *Bus) onStatsCmd(c cmdStats) {
+	stats := Stats{
+		Subscriptions: make([]SubscriptionStats, 0, len(b.subs)),
+		Dropped:       b.dropped.Load(),
+	}
+	for _, sub := range b.subs {
+		stats.Subscriptions = append(stats.Subscriptions, SubscriptionStats{
+			ID:        sub.id,
+			Name:      sub.name,
+			Family:    sub.family,
+			Queued:    sub.queued.Load() + uint64(len(sub.C)),
+			QueuedMax: sub.queuedMax,
+			QueueSize: sub.queuedSize,
+			Dropped:   sub.dropped.Load(),
+		})
+	}
+	sort.Slice(stats.Subscriptions, func(i, j int) bool {
+		return stats.Subscriptions[i].Name < stats.Subscriptions[j].Name
+	})
+	c.resp <- stats
+}
+
+// onDrop accounts a message not delivered to the subscriber.
+func (sub *Subscription) onDrop() {
+	sub.dropped.Add(1)
+	sub.bus.dropped.Add(1)
+}
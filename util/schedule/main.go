@@ -285,6 +285,20 @@ func (tr timerange) Delay(tm time.Time) time.Duration {
 	return time.Duration(float64(remaining) * rand.Float64())
 }
 
+// Remaining returns the delay from <tm> to the end of the Timerange
+func (tr timerange) Remaining(tm time.Time) time.Duration {
+	begin := tr.begin
+	end := tr.end
+	seconds := tm.Sub(time.Date(tm.Year(), tm.Month(), tm.Day(), 0, 0, 0, 0, tm.Location()))
+	if tr.begin > tr.end {
+		end += time.Hour * 24
+	}
+	if seconds < begin {
+		seconds += time.Hour * 24
+	}
+	return end - seconds
+}
+
 func (t timeranges) Including(tm time.Time) timeranges {
 	trs := make(timeranges, 0)
 	for _, tr := range t {
@@ -471,20 +485,6 @@ func (t *Expr) TestWithLast(tm time.Time, last time.Time) (time.Duration, error)
 		return nil
 	}
 
-	// timerangeRemainingDelay returns the delay from now to the end of the timerange
-	timerangeRemainingDelay := func(tr timerange) time.Duration {
-		begin := tr.begin
-		end := tr.end
-		seconds := tm.Sub(time.Date(tm.Year(), tm.Month(), tm.Day(), 0, 0, 0, 0, tm.Location()))
-		if tr.begin > tr.end {
-			end += time.Hour * 24
-		}
-		if seconds < begin {
-			seconds += time.Hour * 24
-		}
-		return end - seconds
-	}
-
 	//
 	// isInTimeranges validates the timerange constraints of a schedule.
 	// Iterates multiple allowed timeranges.
@@ -511,7 +511,7 @@ func (t *Expr) TestWithLast(tm time.Time, last time.Time) (time.Duration, error)
 			} else if err != nil {
 				return 0, err
 			} else if d.exclude {
-				return timerangeRemainingDelay(tr), nil
+				return tr.Remaining(tm), nil
 			}
 			if err := isInTimerangeInterval(tr); errors.Is(err, ErrNotAllowed) {
 				ec.Add(err)
@@ -563,6 +563,37 @@ func (t *Expr) Test(tm time.Time) (time.Duration, error) {
 	return t.TestWithLast(tm, time.Time{})
 }
 
+// Window returns the end of the allowed timerange including <tm>.
+//
+// Unlike Test, the timerange intervals are ignored, so a schedule
+// expression can be used to describe time windows.
+//
+// Returns ErrExcluded if <tm> is in an excluded timerange, and
+// ErrNotAllowed if <tm> is in no allowed timerange.
+func (t *Expr) Window(tm time.Time) (time.Time, error) {
+	if err := t.makeDataset(); err != nil {
+		return time.Time{}, err
+	}
+	if len(t.dataset) == 0 {
+		return time.Time{}, fmt.Errorf("%w: no schedule", ErrNotAllowed)
+	}
+	for _, d := range t.dataset {
+		if !d.IsInMonths(tm) || !d.IsInWeeks(tm) || !d.IsInDays(tm) {
+			continue
+		}
+		for _, tr := range d.timeranges {
+			if !tr.Includes(tm) {
+				continue
+			}
+			if d.exclude {
+				return time.Time{}, fmt.Errorf("%w: schedule element '%s'", ErrExcluded, d.raw)
+			}
+			return tm.Add(tr.Remaining(tm)), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: not in a schedule window", ErrNotAllowed)
+}
+
 func newExprDataset() Schedules {
 	return make(Schedules, 0)
 }
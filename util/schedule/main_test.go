@@ -466,3 +466,47 @@ func TestNext(t *testing.T) {
 		})
 	}
 }
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expression string
+		Time       string
+		End        string
+	}{
+		{"in a timerange", "02:00-04:00", "2015-02-27 Z03:00:00", "2015-02-27 Z04:00:00"},
+		{"in a timerange with interval", "02:00-04:00@10m", "2015-02-27 Z03:00:00", "2015-02-27 Z04:00:00"},
+		{"before midnight in a timerange crossing midnight", "22:00-02:00", "2015-02-27 Z23:00:00", "2015-02-28 Z02:00:00"},
+		{"after midnight in a timerange crossing midnight", "22:00-02:00", "2015-02-27 Z01:00:00", "2015-02-27 Z02:00:00"},
+		{"in allowed days", "08:00-18:00 sat,sun", "2015-02-28 Z10:00:00", "2015-02-28 Z18:00:00"},
+	}
+	for _, data := range tests {
+		t.Run(data.Name, func(t *testing.T) {
+			tm, err := time.Parse(timeLayout, data.Time)
+			require.NoError(t, err)
+			expectedEnd, err := time.Parse(timeLayout, data.End)
+			require.NoError(t, err)
+			end, err := New(data.Expression).Window(tm)
+			require.NoError(t, err)
+			require.Equal(t, expectedEnd, end)
+		})
+	}
+
+	t.Run("not in a timerange", func(t *testing.T) {
+		tm, _ := time.Parse(timeLayout, "2015-02-27 Z05:00:00")
+		_, err := New("02:00-04:00").Window(tm)
+		require.ErrorIs(t, err, ErrNotAllowed)
+	})
+
+	t.Run("not in allowed days", func(t *testing.T) {
+		tm, _ := time.Parse(timeLayout, "2015-02-27 Z10:00:00")
+		_, err := New("* sat,sun").Window(tm)
+		require.ErrorIs(t, err, ErrNotAllowed)
+	})
+
+	t.Run("in an excluded timerange", func(t *testing.T) {
+		tm, _ := time.Parse(timeLayout, "2015-02-27 Z12:30:00")
+		_, err := New(`["!12:00-13:00", "*"]`).Window(tm)
+		require.ErrorIs(t, err, ErrExcluded)
+	})
+}
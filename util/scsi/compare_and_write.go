@@ -0,0 +1,72 @@
+package scsi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/opensvc/om3/util/command"
+	"github.com/opensvc/om3/util/device"
+)
+
+var (
+	// ErrMiscompare is returned by CompareAndWrite when the device data
+	// differs from the expected data. The device data is not changed.
+	ErrMiscompare = errors.New("the device data differs from the compare data")
+
+	// sgCompareAndWriteMiscompareExitCode is the sg_compare_and_write exit
+	// code reporting a miscompare.
+	sgCompareAndWriteMiscompareExitCode = 14
+)
+
+// CompareAndWrite replaces the <old> data of the device <dev> at the logical
+// block address <lba> by the <new> data, if the device data still is <old>.
+// It returns ErrMiscompare if the device data differs from <old>.
+//
+// The SCSI COMPARE AND WRITE command is atomic: when concurrent commands
+// expect the same <old> data, only one of them changes the device data, even
+// if they are sent by different nodes.
+//
+// <old> and <new> must have the same length, a multiple of the device logical
+// block size.
+func CompareAndWrite(ctx context.Context, dev device.T, lba int64, old, new []byte) error {
+	blockSize, err := dev.LogicalBlockSize()
+	if err != nil {
+		return fmt.Errorf("%s logical block size: %w", dev, err)
+	}
+	if len(old) != len(new) {
+		return fmt.Errorf("%s compare and write: the compare and write data lengths differ", dev)
+	}
+	if len(old) == 0 || len(old)%blockSize != 0 {
+		return fmt.Errorf("%s compare and write: the data length %d is not a multiple of the block size %d", dev, len(old), blockSize)
+	}
+	f, err := os.CreateTemp("", "sg_compare_and_write.")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(f.Name()) }()
+	_, err = f.Write(append(append([]byte{}, old...), new...))
+	if err := errors.Join(err, f.Close()); err != nil {
+		return err
+	}
+	cmd := command.New(
+		command.WithContext(ctx),
+		command.WithName("sg_compare_and_write"),
+		command.WithVarArgs(
+			"--in="+f.Name(),
+			fmt.Sprintf("--lba=%d", lba),
+			fmt.Sprintf("--num=%d", len(old)/blockSize),
+			fmt.Sprintf("--xferlen=%d", 2*len(old)),
+			dev.Path(),
+		),
+		command.WithBufferedStderr(),
+	)
+	if err := cmd.Run(); err != nil {
+		if cmd.ExitCode() == sgCompareAndWriteMiscompareExitCode {
+			return ErrMiscompare
+		}
+		return fmt.Errorf("%s compare and write: %w: %s", dev, err, cmd.Stderr())
+	}
+	return nil
+}